@@ -3,6 +3,7 @@ package attr
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -27,3 +28,26 @@ type Value interface {
 	// IsUnknown returns true if the value is not yet known.
 	IsUnknown() bool
 }
+
+// ValueWithSemanticEquals extends the Value interface to include a
+// SemanticEquals method, used to determine whether two values have the same
+// meaning even though they are not byte for byte equal, such as JSON
+// documents with differing whitespace or case-insensitive names.
+//
+// When a new value is semantically equal to the prior value, the framework
+// keeps the prior value. This happens when planning, comparing the proposed
+// new state to the prior state, and after Create, Read, and Update, comparing
+// the new state to the planned or prior state. This prevents spurious plan
+// differences and errors about inconsistent results after apply. Terraform
+// requires planned values to match the configuration unless the attribute is
+// Computed, so when planning only entire values of Computed attributes are
+// kept, not collection elements.
+type ValueWithSemanticEquals interface {
+	Value
+
+	// SemanticEquals returns true if the Value is semantically equal to the
+	// prior Value passed as an argument. The framework only calls this
+	// method when both values are known and not null, and are not already
+	// equal. The prior Value is always of the same Type.
+	SemanticEquals(context.Context, Value) (bool, diag.Diagnostics)
+}
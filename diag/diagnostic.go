@@ -1,7 +1,7 @@
 package diag
 
 import (
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // Diagnostic is an interface for providing enhanced feedback.
@@ -14,7 +14,8 @@ import (
 // implementations.
 //
 // To add path information to an existing diagnostic, see the WithPath()
-// function.
+// function. To create an error diagnostic from a Go error, see the
+// NewErrorDiagnosticFromError() function.
 type Diagnostic interface {
 	// Severity returns the desired level of feedback for the diagnostic.
 	Severity() Severity
@@ -48,5 +49,18 @@ type DiagnosticWithPath interface {
 	//
 	// If present, this enables the display of source configuration context for
 	// supporting implementations such as Terraform CLI commands.
-	Path() *tftypes.AttributePath
+	Path() path.Path
+}
+
+// DiagnosticWithError is a diagnostic created from a Go error.
+//
+// The error can be inspected with the errors.Is() and errors.As() functions,
+// which allows the framework to handle certain errors, such as
+// tfsdk.ErrResourceNotFound during resource reads. Diagnostics wrapped with
+// the WithPath() function keep the error of the wrapped diagnostic.
+type DiagnosticWithError interface {
+	Diagnostic
+
+	// Err returns the Go error the diagnostic was created from.
+	Err() error
 }
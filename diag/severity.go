@@ -1,6 +1,9 @@
 package diag
 
-import "github.com/hashicorp/terraform-plugin-go/tfprotov6"
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
 
 // Severity represents the level of feedback for a diagnostic.
 //
@@ -53,3 +56,18 @@ func (s Severity) ToTfprotov6DiagnosticSeverity() tfprotov6.DiagnosticSeverity {
 		return tfprotov6.DiagnosticSeverityInvalid
 	}
 }
+
+// ToTfprotov5DiagnosticSeverity converts the severity into the tfprotov5 type.
+//
+// Usage of this method outside the framework is not supported nor considered
+// for backwards compatibility promises.
+func (s Severity) ToTfprotov5DiagnosticSeverity() tfprotov5.DiagnosticSeverity {
+	switch s {
+	case SeverityError:
+		return tfprotov5.DiagnosticSeverityError
+	case SeverityWarning:
+		return tfprotov5.DiagnosticSeverityWarning
+	default:
+		return tfprotov5.DiagnosticSeverityInvalid
+	}
+}
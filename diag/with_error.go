@@ -0,0 +1,54 @@
+package diag
+
+var _ DiagnosticWithError = withError{}
+
+// withError wraps a diagnostic with the Go error it was created from.
+type withError struct {
+	Diagnostic
+
+	err error
+}
+
+// Equal returns true if the other diagnostic is wholly equivalent.
+func (d withError) Equal(other Diagnostic) bool {
+	o, ok := other.(withError)
+
+	if !ok {
+		return false
+	}
+
+	if d.err == nil || o.err == nil {
+		if d.err != o.err {
+			return false
+		}
+	} else if d.err.Error() != o.err.Error() {
+		return false
+	}
+
+	if d.Diagnostic == nil {
+		return d.Diagnostic == o.Diagnostic
+	}
+
+	return d.Diagnostic.Equal(o.Diagnostic)
+}
+
+// Err returns the Go error the diagnostic was created from.
+func (d withError) Err() error {
+	return d.err
+}
+
+// NewErrorDiagnosticFromError returns a new error severity diagnostic with the
+// given summary and the given Go error message as the detail. The error is
+// available with the Err() method of the DiagnosticWithError.
+func NewErrorDiagnosticFromError(summary string, err error) DiagnosticWithError {
+	var detail string
+
+	if err != nil {
+		detail = err.Error()
+	}
+
+	return withError{
+		Diagnostic: NewErrorDiagnostic(summary, detail),
+		err:        err,
+	}
+}
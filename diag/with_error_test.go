@@ -0,0 +1,133 @@
+package diag_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestDiagnosticWithErrorEqual(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("test error")
+
+	testCases := map[string]struct {
+		diag     diag.DiagnosticWithError
+		other    diag.Diagnostic
+		expected bool
+	}{
+		"matching": {
+			diag:     diag.NewErrorDiagnosticFromError("test summary", testErr),
+			other:    diag.NewErrorDiagnosticFromError("test summary", testErr),
+			expected: true,
+		},
+		"matching-error-message": {
+			diag:     diag.NewErrorDiagnosticFromError("test summary", testErr),
+			other:    diag.NewErrorDiagnosticFromError("test summary", errors.New("test error")),
+			expected: true,
+		},
+		"nil": {
+			diag:     diag.NewErrorDiagnosticFromError("test summary", testErr),
+			other:    nil,
+			expected: false,
+		},
+		"nil-error": {
+			diag:     diag.NewErrorDiagnosticFromError("test summary", testErr),
+			other:    diag.NewErrorDiagnosticFromError("test summary", nil),
+			expected: false,
+		},
+		"different-error": {
+			diag:     diag.NewErrorDiagnosticFromError("test summary", testErr),
+			other:    diag.NewErrorDiagnosticFromError("test summary", errors.New("different error")),
+			expected: false,
+		},
+		"different-summary": {
+			diag:     diag.NewErrorDiagnosticFromError("test summary", testErr),
+			other:    diag.NewErrorDiagnosticFromError("different summary", testErr),
+			expected: false,
+		},
+		"different-type": {
+			diag:     diag.NewErrorDiagnosticFromError("test summary", testErr),
+			other:    diag.NewErrorDiagnostic("test summary", "test error"),
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diag.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("Unexpected response: got: %t, wanted: %t", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNewErrorDiagnosticFromError(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("test error")
+
+	got := diag.NewErrorDiagnosticFromError("test summary", fmt.Errorf("test wrapping: %w", testErr))
+
+	if got.Severity() != diag.SeverityError {
+		t.Errorf("Unexpected severity: %s", got.Severity())
+	}
+
+	if got.Summary() != "test summary" {
+		t.Errorf("Unexpected summary: %s", got.Summary())
+	}
+
+	if got.Detail() != "test wrapping: test error" {
+		t.Errorf("Unexpected detail: %s", got.Detail())
+	}
+
+	if !errors.Is(got.Err(), testErr) {
+		t.Errorf("Expected wrapped error, got: %s", got.Err())
+	}
+}
+
+func TestWithPathErr(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("test error")
+
+	testCases := map[string]struct {
+		diag     diag.Diagnostic
+		expected error
+	}{
+		"error": {
+			diag:     diag.NewErrorDiagnosticFromError("test summary", testErr),
+			expected: testErr,
+		},
+		"no-error": {
+			diag:     diag.NewErrorDiagnostic("test summary", "test detail"),
+			expected: nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := diag.WithPath(path.Root("test"), tc.diag).(diag.DiagnosticWithError)
+
+			if !ok {
+				t.Fatalf("Expected DiagnosticWithError")
+			}
+
+			if got.Err() != tc.expected {
+				t.Errorf("Unexpected error: got: %v, wanted: %v", got.Err(), tc.expected)
+			}
+		})
+	}
+}
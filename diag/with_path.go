@@ -1,16 +1,19 @@
 package diag
 
 import (
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
-var _ DiagnosticWithPath = withPath{}
+var (
+	_ DiagnosticWithError = withPath{}
+	_ DiagnosticWithPath  = withPath{}
+)
 
 // withPath wraps a diagnostic with path information.
 type withPath struct {
 	Diagnostic
 
-	path *tftypes.AttributePath
+	path path.Path
 }
 
 // Equal returns true if the other diagnostic is wholly equivalent.
@@ -32,13 +35,25 @@ func (d withPath) Equal(other Diagnostic) bool {
 	return d.Diagnostic.Equal(o.Diagnostic)
 }
 
+// Err returns the Go error the wrapped diagnostic was created from, if the
+// wrapped diagnostic implements DiagnosticWithError, otherwise nil.
+func (d withPath) Err() error {
+	dWithError, ok := d.Diagnostic.(DiagnosticWithError)
+
+	if !ok {
+		return nil
+	}
+
+	return dWithError.Err()
+}
+
 // Path returns the diagnostic path.
-func (d withPath) Path() *tftypes.AttributePath {
+func (d withPath) Path() path.Path {
 	return d.path
 }
 
 // WithPath wraps a diagnostic with path information or overwrites the path.
-func WithPath(path *tftypes.AttributePath, d Diagnostic) DiagnosticWithPath {
+func WithPath(path path.Path, d Diagnostic) DiagnosticWithPath {
 	wp, ok := d.(withPath)
 
 	if !ok {
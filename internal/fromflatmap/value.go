@@ -0,0 +1,314 @@
+package fromflatmap
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// UnknownValue is the flatmap representation of an unknown value, which
+// Terraform CLI 0.11 and earlier used as a placeholder in planned states.
+const UnknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// Value returns the tftypes.Value equivalent of a flatmap, decoded using the
+// given object type, which is typically the type of a schema.
+//
+// Lists, sets, and tuples are expected to have a "#" key with the number of
+// elements and maps a "%" key with the number of elements, following the
+// terraform-plugin-sdk conventions. When these keys are missing, the
+// collection is null. Objects are decoded using their attribute names as key
+// prefixes, so blocks written with the list nesting mode are expected as
+// lists. The dynamic pseudo-type cannot be decoded, as the flatmap format
+// does not contain type information.
+func Value(ctx context.Context, flatmap map[string]string, typ tftypes.Type) (tftypes.Value, error) {
+	objectType, ok := typ.(tftypes.Object)
+
+	if !ok {
+		return tftypes.Value{}, fmt.Errorf("flatmap can only be decoded using an object type, got: %s", typ)
+	}
+
+	return objectValue(ctx, flatmap, "", objectType)
+}
+
+// value returns the tftypes.Value of the given type at the flatmap key.
+func value(ctx context.Context, flatmap map[string]string, key string, typ tftypes.Type) (tftypes.Value, error) {
+	switch typ := typ.(type) {
+	case tftypes.List:
+		return listValue(ctx, flatmap, key+".", typ)
+	case tftypes.Map:
+		return mapValue(ctx, flatmap, key+".", typ)
+	case tftypes.Object:
+		return objectValue(ctx, flatmap, key+".", typ)
+	case tftypes.Set:
+		return setValue(ctx, flatmap, key+".", typ)
+	case tftypes.Tuple:
+		return tupleValue(ctx, flatmap, key+".", typ)
+	}
+
+	if typ.Is(tftypes.DynamicPseudoType) {
+		return tftypes.Value{}, fmt.Errorf("cannot decode %s from flatmap, as the dynamic pseudo-type is not supported", key)
+	}
+
+	return primitiveValue(flatmap, key, typ)
+}
+
+// primitiveValue returns the tftypes.Value of a string, number, or bool type
+// at the flatmap key.
+func primitiveValue(flatmap map[string]string, key string, typ tftypes.Type) (tftypes.Value, error) {
+	rawValue, ok := flatmap[key]
+
+	if !ok {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if rawValue == UnknownValue {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		return tftypes.NewValue(typ, rawValue), nil
+	case typ.Is(tftypes.Number):
+		number, _, err := big.ParseFloat(rawValue, 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("invalid number value for %s: %w", key, err)
+		}
+
+		return tftypes.NewValue(typ, number), nil
+	case typ.Is(tftypes.Bool):
+		b, err := strconv.ParseBool(rawValue)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("invalid bool value for %s: %w", key, err)
+		}
+
+		return tftypes.NewValue(typ, b), nil
+	default:
+		return tftypes.Value{}, fmt.Errorf("cannot decode %s from flatmap, unsupported type: %s", key, typ)
+	}
+}
+
+// objectValue returns the tftypes.Value of an object type, whose attributes
+// are under the flatmap key prefix.
+func objectValue(ctx context.Context, flatmap map[string]string, prefix string, typ tftypes.Object) (tftypes.Value, error) {
+	attributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+
+	for name, attributeType := range typ.AttributeTypes {
+		attributeValue, err := value(ctx, flatmap, prefix+name, attributeType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		attributes[name] = attributeValue
+	}
+
+	return tftypes.NewValue(typ, attributes), nil
+}
+
+// listValue returns the tftypes.Value of a list type, whose count and
+// elements are under the flatmap key prefix.
+func listValue(ctx context.Context, flatmap map[string]string, prefix string, typ tftypes.List) (tftypes.Value, error) {
+	count, ok, err := collectionCount(flatmap, prefix+"#")
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	if !ok {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if count < 0 {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	elements := make([]tftypes.Value, 0, count)
+
+	for i := 0; i < count; i++ {
+		element, err := value(ctx, flatmap, prefix+strconv.Itoa(i), typ.ElementType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// tupleValue returns the tftypes.Value of a tuple type, whose count and
+// elements are under the flatmap key prefix.
+func tupleValue(ctx context.Context, flatmap map[string]string, prefix string, typ tftypes.Tuple) (tftypes.Value, error) {
+	count, ok, err := collectionCount(flatmap, prefix+"#")
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	if !ok {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if count < 0 {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	if count != len(typ.ElementTypes) {
+		return tftypes.Value{}, fmt.Errorf("invalid tuple length for %s: expected %d, got %d", strings.TrimSuffix(prefix, "."), len(typ.ElementTypes), count)
+	}
+
+	elements := make([]tftypes.Value, 0, count)
+
+	for i, elementType := range typ.ElementTypes {
+		element, err := value(ctx, flatmap, prefix+strconv.Itoa(i), elementType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// setValue returns the tftypes.Value of a set type, whose count and elements
+// are under the flatmap key prefix. Set element keys are arbitrary, typically
+// a hash of the element value, so they are only used to group keys.
+func setValue(ctx context.Context, flatmap map[string]string, prefix string, typ tftypes.Set) (tftypes.Value, error) {
+	count, ok, err := collectionCount(flatmap, prefix+"#")
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	if !ok {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if count < 0 {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	elements := make([]tftypes.Value, 0, count)
+
+	for _, elementKey := range elementKeys(flatmap, prefix, "#", false) {
+		element, err := value(ctx, flatmap, prefix+elementKey, typ.ElementType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// mapValue returns the tftypes.Value of a map type, whose count and elements
+// are under the flatmap key prefix.
+func mapValue(ctx context.Context, flatmap map[string]string, prefix string, typ tftypes.Map) (tftypes.Value, error) {
+	count, ok, err := collectionCount(flatmap, prefix+"%")
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	if !ok {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if count < 0 {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	elements := make(map[string]tftypes.Value, count)
+
+	// Map keys of primitive elements can contain periods, so the whole
+	// remaining flatmap key is the map key.
+	wholeKey := true
+
+	switch typ.ElementType.(type) {
+	case tftypes.List, tftypes.Map, tftypes.Object, tftypes.Set, tftypes.Tuple:
+		wholeKey = false
+	}
+
+	for _, elementKey := range elementKeys(flatmap, prefix, "%", wholeKey) {
+		element, err := value(ctx, flatmap, prefix+elementKey, typ.ElementType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements[elementKey] = element
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// collectionCount returns the number of elements from the flatmap count key
+// and whether the key was present. The count is -1 if it is unknown.
+func collectionCount(flatmap map[string]string, countKey string) (int, bool, error) {
+	rawCount, ok := flatmap[countKey]
+
+	if !ok {
+		return 0, false, nil
+	}
+
+	if rawCount == UnknownValue {
+		return -1, true, nil
+	}
+
+	count, err := strconv.Atoi(rawCount)
+
+	if err != nil {
+		return 0, true, fmt.Errorf("invalid count value for %s: %w", countKey, err)
+	}
+
+	return count, true, nil
+}
+
+// elementKeys returns the sorted, unique element keys under the flatmap key
+// prefix, excluding the count key. If wholeKey is false, only the key segment
+// up to the next period is used, as nested values have further segments.
+func elementKeys(flatmap map[string]string, prefix string, countKey string, wholeKey bool) []string {
+	seen := make(map[string]struct{})
+
+	for key := range flatmap {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		elementKey := key[len(prefix):]
+
+		if elementKey == countKey {
+			continue
+		}
+
+		if !wholeKey {
+			if i := strings.Index(elementKey, "."); i != -1 {
+				elementKey = elementKey[:i]
+			}
+		}
+
+		seen[elementKey] = struct{}{}
+	}
+
+	keys := make([]string, 0, len(seen))
+
+	for key := range seen {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
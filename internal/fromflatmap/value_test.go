@@ -0,0 +1,357 @@
+package fromflatmap_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromflatmap"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValue(t *testing.T) {
+	t.Parallel()
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested_string": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"bool":   tftypes.Bool,
+			"list":   tftypes.List{ElementType: tftypes.String},
+			"map":    tftypes.Map{ElementType: tftypes.String},
+			"number": tftypes.Number,
+			"object": testNestedType,
+			"set":    tftypes.Set{ElementType: testNestedType},
+			"string": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		flatmap       map[string]string
+		typ           tftypes.Type
+		expected      tftypes.Value
+		expectedError string
+	}{
+		"empty": {
+			flatmap: map[string]string{},
+			typ:     testType,
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"bool":   tftypes.NewValue(tftypes.Bool, nil),
+				"list":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"map":    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"number": tftypes.NewValue(tftypes.Number, nil),
+				"object": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"nested_string": tftypes.NewValue(tftypes.String, nil),
+				}),
+				"set":    tftypes.NewValue(tftypes.Set{ElementType: testNestedType}, nil),
+				"string": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"populated": {
+			flatmap: map[string]string{
+				"bool":                   "true",
+				"list.#":                 "2",
+				"list.0":                 "one",
+				"list.1":                 "two",
+				"map.%":                  "2",
+				"map.key1":               "value1",
+				"map.key.with.periods":   "value2",
+				"number":                 "1.5",
+				"object.nested_string":   "nested",
+				"set.#":                  "2",
+				"set.1234.nested_string": "first",
+				"set.5678.nested_string": "second",
+				"string":                 "test",
+			},
+			typ: testType,
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"bool": tftypes.NewValue(tftypes.Bool, true),
+				"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, "two"),
+				}),
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"key1":             tftypes.NewValue(tftypes.String, "value1"),
+					"key.with.periods": tftypes.NewValue(tftypes.String, "value2"),
+				}),
+				"number": tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+				"object": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"nested_string": tftypes.NewValue(tftypes.String, "nested"),
+				}),
+				"set": tftypes.NewValue(tftypes.Set{ElementType: testNestedType}, []tftypes.Value{
+					tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+						"nested_string": tftypes.NewValue(tftypes.String, "first"),
+					}),
+					tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+						"nested_string": tftypes.NewValue(tftypes.String, "second"),
+					}),
+				}),
+				"string": tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		"collections-empty": {
+			flatmap: map[string]string{
+				"list.#": "0",
+				"map.%":  "0",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"list": tftypes.List{ElementType: tftypes.String},
+					"map":  tftypes.Map{ElementType: tftypes.String},
+				},
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"list": tftypes.List{ElementType: tftypes.String},
+					"map":  tftypes.Map{ElementType: tftypes.String},
+				},
+			}, map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+				"map":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{}),
+			}),
+		},
+		"nested-lists": {
+			flatmap: map[string]string{
+				"block.#":                "1",
+				"block.0.list.#":         "1",
+				"block.0.list.0.string":  "nested",
+				"block.0.map.%":          "1",
+				"block.0.map.key.string": "mapped",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"block": tftypes.List{
+						ElementType: tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"list": tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"string": tftypes.String,
+										},
+									},
+								},
+								"map": tftypes.Map{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"string": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"block": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"list": tftypes.List{
+										ElementType: tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"string": tftypes.String,
+											},
+										},
+									},
+									"map": tftypes.Map{
+										ElementType: tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"string": tftypes.String,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"block": tftypes.NewValue(
+						tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"list": tftypes.List{
+										ElementType: tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"string": tftypes.String,
+											},
+										},
+									},
+									"map": tftypes.Map{
+										ElementType: tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"string": tftypes.String,
+											},
+										},
+									},
+								},
+							},
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"list": tftypes.List{
+											ElementType: tftypes.Object{
+												AttributeTypes: map[string]tftypes.Type{
+													"string": tftypes.String,
+												},
+											},
+										},
+										"map": tftypes.Map{
+											ElementType: tftypes.Object{
+												AttributeTypes: map[string]tftypes.Type{
+													"string": tftypes.String,
+												},
+											},
+										},
+									},
+								},
+								map[string]tftypes.Value{
+									"list": tftypes.NewValue(
+										tftypes.List{
+											ElementType: tftypes.Object{
+												AttributeTypes: map[string]tftypes.Type{
+													"string": tftypes.String,
+												},
+											},
+										},
+										[]tftypes.Value{
+											tftypes.NewValue(
+												tftypes.Object{
+													AttributeTypes: map[string]tftypes.Type{
+														"string": tftypes.String,
+													},
+												},
+												map[string]tftypes.Value{
+													"string": tftypes.NewValue(tftypes.String, "nested"),
+												},
+											),
+										},
+									),
+									"map": tftypes.NewValue(
+										tftypes.Map{
+											ElementType: tftypes.Object{
+												AttributeTypes: map[string]tftypes.Type{
+													"string": tftypes.String,
+												},
+											},
+										},
+										map[string]tftypes.Value{
+											"key": tftypes.NewValue(
+												tftypes.Object{
+													AttributeTypes: map[string]tftypes.Type{
+														"string": tftypes.String,
+													},
+												},
+												map[string]tftypes.Value{
+													"string": tftypes.NewValue(tftypes.String, "mapped"),
+												},
+											),
+										},
+									),
+								},
+							),
+						},
+					),
+				},
+			),
+		},
+		"unknown": {
+			flatmap: map[string]string{
+				"list.#": fromflatmap.UnknownValue,
+				"string": fromflatmap.UnknownValue,
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"list":   tftypes.List{ElementType: tftypes.String},
+					"string": tftypes.String,
+				},
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"list":   tftypes.List{ElementType: tftypes.String},
+					"string": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"list":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+				"string": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"invalid-bool": {
+			flatmap: map[string]string{
+				"bool": "not-a-bool",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"bool": tftypes.Bool,
+				},
+			},
+			expectedError: "invalid bool value for bool: strconv.ParseBool: parsing \"not-a-bool\": invalid syntax",
+		},
+		"invalid-count": {
+			flatmap: map[string]string{
+				"list.#": "not-a-number",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"list": tftypes.List{ElementType: tftypes.String},
+				},
+			},
+			expectedError: "invalid count value for list.#: strconv.Atoi: parsing \"not-a-number\": invalid syntax",
+		},
+		"invalid-dynamic": {
+			flatmap: map[string]string{
+				"dynamic": "test",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"dynamic": tftypes.DynamicPseudoType,
+				},
+			},
+			expectedError: "cannot decode dynamic from flatmap, as the dynamic pseudo-type is not supported",
+		},
+		"invalid-type": {
+			flatmap:       map[string]string{},
+			typ:           tftypes.String,
+			expectedError: "flatmap can only be decoded using an object type, got: tftypes.String",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromflatmap.Value(context.Background(), testCase.flatmap, testCase.typ)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package fromproto5_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func testNewRawState(t *testing.T, jsonMap map[string]interface{}) *tfprotov5.RawState {
+	t.Helper()
+
+	rawStateJSON, err := json.Marshal(jsonMap)
+
+	if err != nil {
+		t.Fatalf("unable to create RawState JSON: %s", err)
+	}
+
+	return &tfprotov5.RawState{
+		JSON: rawStateJSON,
+	}
+}
@@ -0,0 +1,38 @@
+package fromproto5
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// ReadDataSourceRequest returns the *fwserver.ReadDataSourceRequest
+// equivalent of a *tfprotov5.ReadDataSourceRequest.
+func ReadDataSourceRequest(ctx context.Context, proto5 *tfprotov5.ReadDataSourceRequest, dataSourceType tfsdk.DataSourceType, dataSourceSchema *tfsdk.Schema, providerMetaSchema *tfsdk.Schema) (*fwserver.ReadDataSourceRequest, diag.Diagnostics) {
+	if proto5 == nil {
+		return nil, nil
+	}
+
+	var diags diag.Diagnostics
+
+	fw := &fwserver.ReadDataSourceRequest{
+		DataSourceType: dataSourceType,
+	}
+
+	config, configDiags := Config(ctx, proto5.Config, dataSourceSchema)
+
+	diags.Append(configDiags...)
+
+	fw.Config = config
+
+	providerMeta, providerMetaDiags := ProviderMeta(ctx, proto5.ProviderMeta, providerMetaSchema)
+
+	diags.Append(providerMetaDiags...)
+
+	fw.ProviderMeta = providerMeta
+
+	return fw, diags
+}
@@ -0,0 +1,52 @@
+package fromproto5
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// UpgradeResourceStateRequest returns the *fwserver.UpgradeResourceStateRequest
+// equivalent of a *tfprotov5.UpgradeResourceStateRequest.
+func UpgradeResourceStateRequest(ctx context.Context, proto5 *tfprotov5.UpgradeResourceStateRequest, resourceType tfsdk.ResourceType, resourceSchema *tfsdk.Schema) (*fwserver.UpgradeResourceStateRequest, diag.Diagnostics) {
+	if proto5 == nil {
+		return nil, nil
+	}
+
+	var diags diag.Diagnostics
+
+	// Panic prevention here to simplify the calling implementations.
+	// This should not happen, but just in case.
+	if resourceSchema == nil {
+		diags.AddError(
+			"Unable to Create Empty State",
+			"An unexpected error was encountered when creating the empty state. "+
+				"This is always an issue in the Terraform Provider SDK used to implement the provider and should be reported to the provider developers.\n\n"+
+				"Please report this to the provider developer:\n\n"+
+				"Missing schema.",
+		)
+
+		return nil, diags
+	}
+
+	fw := &fwserver.UpgradeResourceStateRequest{
+		ResourceSchema: *resourceSchema,
+		ResourceType:   resourceType,
+		Version:        proto5.Version,
+	}
+
+	// The framework currently uses the protocol version 6 type for RawState.
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/340
+	if proto5.RawState != nil {
+		fw.RawState = &tfprotov6.RawState{
+			Flatmap: proto5.RawState.Flatmap,
+			JSON:    proto5.RawState.JSON,
+		}
+	}
+
+	return fw, diags
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -125,11 +126,18 @@ func TestApplyResourceChangeRequest(t *testing.T) {
 		},
 		"plannedprivate": {
 			input: &tfprotov6.ApplyResourceChangeRequest{
-				PlannedPrivate: []byte("{}"),
+				PlannedPrivate: []byte(`{".frameworkKey":"eyJrIjogInYifQ==","providerKey":"eyJrZXkiOiAidmFsdWUifQ=="}`),
 			},
 			resourceSchema: testFwSchema,
 			expected: &fwserver.ApplyResourceChangeRequest{
-				PlannedPrivate: []byte("{}"),
+				PlannedPrivate: &privatestate.Data{
+					Framework: map[string][]byte{
+						".frameworkKey": []byte(`{"k": "v"}`),
+					},
+					Provider: privatestate.MustProviderData(context.Background(), map[string][]byte{
+						"providerKey": []byte(`{"key": "value"}`),
+					}),
+				},
 				ResourceSchema: *testFwSchema,
 			},
 		},
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -19,7 +20,6 @@ func ReadResourceRequest(ctx context.Context, proto6 *tfprotov6.ReadResourceRequ
 	var diags diag.Diagnostics
 
 	fw := &fwserver.ReadResourceRequest{
-		Private:      proto6.Private,
 		ResourceType: resourceType,
 	}
 
@@ -35,5 +35,11 @@ func ReadResourceRequest(ctx context.Context, proto6 *tfprotov6.ReadResourceRequ
 
 	fw.ProviderMeta = providerMeta
 
+	privateData, privateDataDiags := privatestate.NewData(ctx, proto6.Private)
+
+	diags.Append(privateDataDiags...)
+
+	fw.Private = privateData
+
 	return fw, diags
 }
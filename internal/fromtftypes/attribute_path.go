@@ -0,0 +1,53 @@
+package fromtftypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AttributePath returns the path.Path equivalent of a
+// *tftypes.AttributePath.
+func AttributePath(ctx context.Context, tfType *tftypes.AttributePath) (path.Path, error) {
+	fw := path.Empty()
+
+	for _, tfTypeStep := range tfType.Steps() {
+		fwStep, err := AttributePathStep(ctx, tfTypeStep)
+
+		if err != nil {
+			return path.Empty(), fmt.Errorf("unable to convert attribute path %s: %w", tfType, err)
+		}
+
+		switch step := fwStep.(type) {
+		case path.PathStepAttributeName:
+			fw = fw.AtName(string(step))
+		case path.PathStepElementKeyInt:
+			fw = fw.AtListIndex(int(step))
+		case path.PathStepElementKeyString:
+			fw = fw.AtMapKey(string(step))
+		case path.PathStepElementKeyValue:
+			fw = fw.AtSetValue(step.Value)
+		}
+	}
+
+	return fw, nil
+}
+
+// AttributePathStep returns the path.PathStep equivalent of a
+// tftypes.AttributePathStep.
+func AttributePathStep(_ context.Context, tfType tftypes.AttributePathStep) (path.PathStep, error) {
+	switch step := tfType.(type) {
+	case tftypes.AttributeName:
+		return path.PathStepAttributeName(string(step)), nil
+	case tftypes.ElementKeyInt:
+		return path.PathStepElementKeyInt(int64(step)), nil
+	case tftypes.ElementKeyString:
+		return path.PathStepElementKeyString(string(step)), nil
+	case tftypes.ElementKeyValue:
+		return path.PathStepElementKeyValue{Value: tftypes.Value(step)}, nil
+	default:
+		return nil, fmt.Errorf("unknown tftypes.AttributePathStep: %#v", step)
+	}
+}
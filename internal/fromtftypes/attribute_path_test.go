@@ -0,0 +1,67 @@
+package fromtftypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAttributePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfType   *tftypes.AttributePath
+		expected path.Path
+	}{
+		"nil": {
+			tfType:   nil,
+			expected: path.Empty(),
+		},
+		"empty": {
+			tfType:   tftypes.NewAttributePath(),
+			expected: path.Empty(),
+		},
+		"AttributeName": {
+			tfType:   tftypes.NewAttributePath().WithAttributeName("test"),
+			expected: path.Root("test"),
+		},
+		"AttributeName-ElementKeyInt": {
+			tfType:   tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(1),
+			expected: path.Root("test").AtListIndex(1),
+		},
+		"AttributeName-ElementKeyString": {
+			tfType:   tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			expected: path.Root("test").AtMapKey("key"),
+		},
+		"AttributeName-ElementKeyValue": {
+			tfType:   tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+			expected: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+		},
+		"AttributeName-ElementKeyInt-AttributeName": {
+			tfType:   tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("nested"),
+			expected: path.Root("test").AtListIndex(0).AtName("nested"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromtftypes.AttributePath(context.Background(), testCase.tfType)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -52,21 +52,25 @@ func AttributeModifyPlan(ctx context.Context, a tfsdk.Attribute, req tfsdk.Modif
 			RequiresReplace: requiresReplace,
 		}
 
-		logging.FrameworkDebug(
-			ctx,
-			"Calling provider defined AttributePlanModifier",
-			map[string]interface{}{
-				logging.KeyDescription: planModifier.Description(ctx),
-			},
-		)
-		planModifier.Modify(ctx, req, modifyResp)
-		logging.FrameworkDebug(
-			ctx,
-			"Called provider defined AttributePlanModifier",
-			map[string]interface{}{
-				logging.KeyDescription: planModifier.Description(ctx),
-			},
-		)
+		callProviderDefined(ctx, &modifyResp.Diagnostics, "AttributePlanModifier", req.AttributePath, func() {
+			description := planModifier.Description(ctx)
+
+			logging.FrameworkDebug(
+				ctx,
+				"Calling provider defined AttributePlanModifier",
+				map[string]interface{}{
+					logging.KeyDescription: description,
+				},
+			)
+			planModifier.Modify(ctx, req, modifyResp)
+			logging.FrameworkDebug(
+				ctx,
+				"Called provider defined AttributePlanModifier",
+				map[string]interface{}{
+					logging.KeyDescription: description,
+				},
+			)
+		})
 
 		req.AttributePlan = modifyResp.AttributePlan
 		resp.Diagnostics.Append(modifyResp.Diagnostics...)
@@ -112,10 +116,11 @@ func AttributeModifyPlan(ctx context.Context, a tfsdk.Attribute, req tfsdk.Modif
 		for idx := range l.Elems {
 			for name, attr := range a.Attributes.GetAttributes() {
 				attrReq := tfsdk.ModifyAttributePlanRequest{
-					AttributePath: req.AttributePath.WithElementKeyInt(idx).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtListIndex(idx).AtName(name),
 					Config:        req.Config,
 					Plan:          resp.Plan,
 					ProviderMeta:  req.ProviderMeta,
+					ProviderData:  req.ProviderData,
 					State:         req.State,
 				}
 
@@ -151,10 +156,11 @@ func AttributeModifyPlan(ctx context.Context, a tfsdk.Attribute, req tfsdk.Modif
 
 			for name, attr := range a.Attributes.GetAttributes() {
 				attrReq := tfsdk.ModifyAttributePlanRequest{
-					AttributePath: req.AttributePath.WithElementKeyValue(tfValue).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtSetValue(tfValue).AtName(name),
 					Config:        req.Config,
 					Plan:          resp.Plan,
 					ProviderMeta:  req.ProviderMeta,
+					ProviderData:  req.ProviderData,
 					State:         req.State,
 				}
 
@@ -178,10 +184,11 @@ func AttributeModifyPlan(ctx context.Context, a tfsdk.Attribute, req tfsdk.Modif
 		for key := range m.Elems {
 			for name, attr := range a.Attributes.GetAttributes() {
 				attrReq := tfsdk.ModifyAttributePlanRequest{
-					AttributePath: req.AttributePath.WithElementKeyString(key).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtMapKey(key).AtName(name),
 					Config:        req.Config,
 					Plan:          resp.Plan,
 					ProviderMeta:  req.ProviderMeta,
+					ProviderData:  req.ProviderData,
 					State:         req.State,
 				}
 
@@ -208,10 +215,11 @@ func AttributeModifyPlan(ctx context.Context, a tfsdk.Attribute, req tfsdk.Modif
 
 		for name, attr := range a.Attributes.GetAttributes() {
 			attrReq := tfsdk.ModifyAttributePlanRequest{
-				AttributePath: req.AttributePath.WithAttributeName(name),
+				AttributePath: req.AttributePath.AtName(name),
 				Config:        req.Config,
 				Plan:          resp.Plan,
 				ProviderMeta:  req.ProviderMeta,
+				ProviderData:  req.ProviderData,
 				State:         req.State,
 			}
 
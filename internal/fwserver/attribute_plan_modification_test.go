@@ -7,6 +7,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/planmodifiers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -22,7 +23,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 	}{
 		"config-error": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -79,7 +80,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 			expectedResp: ModifySchemaPlanResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Configuration Read Error",
 						"An unexpected error was encountered trying to read an attribute from the configuration. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 							"can't use tftypes.String<\"testvalue\"> as value of List with ElementType types.primitive, can only use tftypes.String values",
@@ -106,7 +107,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"config-error-previous-error": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -174,7 +175,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 						"This was a previous error",
 					),
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Configuration Read Error",
 						"An unexpected error was encountered trying to read an attribute from the configuration. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 							"can't use tftypes.String<\"testvalue\"> as value of List with ElementType types.primitive, can only use tftypes.String values",
@@ -201,7 +202,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"plan-error": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -258,7 +259,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 			expectedResp: ModifySchemaPlanResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Plan Read Error",
 						"An unexpected error was encountered trying to read an attribute from the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 							"can't use tftypes.String<\"testvalue\"> as value of List with ElementType types.primitive, can only use tftypes.String values",
@@ -285,7 +286,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"plan-error-previous-error": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -353,7 +354,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 						"This was a previous error",
 					),
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Plan Read Error",
 						"An unexpected error was encountered trying to read an attribute from the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 							"can't use tftypes.String<\"testvalue\"> as value of List with ElementType types.primitive, can only use tftypes.String values",
@@ -380,7 +381,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"state-error": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -437,7 +438,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 			expectedResp: ModifySchemaPlanResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"State Read Error",
 						"An unexpected error was encountered trying to read an attribute from the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 							"can't use tftypes.String<\"testvalue\"> as value of List with ElementType types.primitive, can only use tftypes.String values",
@@ -464,7 +465,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"state-error-previous-error": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -532,7 +533,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 						"This was a previous error",
 					),
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"State Read Error",
 						"An unexpected error was encountered trying to read an attribute from the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 							"can't use tftypes.String<\"testvalue\"> as value of List with ElementType types.primitive, can only use tftypes.String values",
@@ -559,7 +560,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"no-plan-modifiers": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -635,7 +636,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"attribute-plan": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -727,7 +728,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"attribute-plan-previous-error": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -832,7 +833,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"requires-replacement": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -913,14 +914,14 @@ func TestAttributeModifyPlan(t *testing.T) {
 						},
 					},
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test"),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
 				},
 			},
 		},
 		"requires-replacement-previous-error": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1014,14 +1015,14 @@ func TestAttributeModifyPlan(t *testing.T) {
 						},
 					},
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test"),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
 				},
 			},
 		},
 		"requires-replacement-passthrough": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1105,14 +1106,14 @@ func TestAttributeModifyPlan(t *testing.T) {
 						},
 					},
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test"),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
 				},
 			},
 		},
 		"requires-replacement-unset": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1200,7 +1201,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"warnings": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1301,7 +1302,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"warnings-previous-error": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1413,7 +1414,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"error": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1509,9 +1510,100 @@ func TestAttributeModifyPlan(t *testing.T) {
 				},
 			},
 		},
+		"panic": {
+			req: tfsdk.ModifyAttributePlanRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "TESTDIAG"),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								Type:     types.StringType,
+								Required: true,
+								PlanModifiers: []tfsdk.AttributePlanModifier{
+									planmodifiers.TestPanicModifier{},
+								},
+							},
+						},
+					},
+				},
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "TESTDIAG"),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								Type:     types.StringType,
+								Required: true,
+								PlanModifiers: []tfsdk.AttributePlanModifier{
+									planmodifiers.TestPanicModifier{},
+								},
+							},
+						},
+					},
+				},
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "TESTDIAG"),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								Type:     types.StringType,
+								Required: true,
+								PlanModifiers: []tfsdk.AttributePlanModifier{
+									planmodifiers.TestPanicModifier{},
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: ModifySchemaPlanResponse{},
+			expectedResp: ModifySchemaPlanResponse{
+				Diagnostics: diag.Diagnostics{
+					testPanicDiagnostic(path.Root("test"), "AttributePlanModifier"),
+				},
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "TESTDIAG"),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								Type:     types.StringType,
+								Required: true,
+								PlanModifiers: []tfsdk.AttributePlanModifier{
+									planmodifiers.TestPanicModifier{},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"error-previous-error": {
 			req: tfsdk.ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1625,7 +1717,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			attribute, err := tc.req.Config.Schema.AttributeAtPath(tc.req.AttributePath)
+			attribute, err := tc.req.Config.Schema.AttributeAtPath(context.Background(), tc.req.AttributePath)
 
 			if err != nil {
 				t.Fatalf("Unexpected error getting %s", err)
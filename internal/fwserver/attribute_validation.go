@@ -3,10 +3,15 @@ package fwserver
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // AttributeValidate performs all Attribute validation.
@@ -58,23 +63,29 @@ func AttributeValidate(ctx context.Context, a tfsdk.Attribute, req tfsdk.Validat
 	req.AttributeConfig = attributeConfig
 
 	for _, validator := range a.Validators {
-		logging.FrameworkDebug(
-			ctx,
-			"Calling provider defined AttributeValidator",
-			map[string]interface{}{
-				logging.KeyDescription: validator.Description(ctx),
-			},
-		)
-		validator.Validate(ctx, req, resp)
-		logging.FrameworkDebug(
-			ctx,
-			"Called provider defined AttributeValidator",
-			map[string]interface{}{
-				logging.KeyDescription: validator.Description(ctx),
-			},
-		)
+		callProviderDefined(ctx, &resp.Diagnostics, "AttributeValidator", req.AttributePath, func() {
+			description := validator.Description(ctx)
+
+			logging.FrameworkDebug(
+				ctx,
+				"Calling provider defined AttributeValidator",
+				map[string]interface{}{
+					logging.KeyDescription: description,
+				},
+			)
+			validator.Validate(ctx, req, resp)
+			logging.FrameworkDebug(
+				ctx,
+				"Called provider defined AttributeValidator",
+				map[string]interface{}{
+					logging.KeyDescription: description,
+				},
+			)
+		})
 	}
 
+	AttributeValidateElements(ctx, a, req, resp)
+
 	AttributeValidateNestedAttributes(ctx, a, req, resp)
 
 	if a.DeprecationMessage != "" && attributeConfig != nil {
@@ -99,6 +110,183 @@ func AttributeValidate(ctx context.Context, a tfsdk.Attribute, req tfsdk.Validat
 	}
 }
 
+// AttributeValidateElements performs all ElementValidators and KeyValidators
+// validation on collection elements.
+//
+// TODO: Clean up this abstraction back into an internal Attribute type method.
+// The extra Attribute parameter is a carry-over of creating the proto6server
+// package from the tfsdk package and not wanting to export the method.
+// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/215
+func AttributeValidateElements(ctx context.Context, a tfsdk.Attribute, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	if len(a.ElementValidators) == 0 && len(a.KeyValidators) == 0 {
+		return
+	}
+
+	if req.AttributeConfig == nil {
+		return
+	}
+
+	typeWithElementType, ok := a.Type.(attr.TypeWithElementType)
+
+	if !ok {
+		err := fmt.Errorf("attribute type (%T) does not have elements at path: %s", a.Type, req.AttributePath)
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Attribute Validation Error",
+			"Attribute validation cannot walk elements. Report this to the provider developer:\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	tfValue, err := req.AttributeConfig.ToTerraformValue(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Attribute Validation Error",
+			"Attribute validation cannot convert value. Report this to the provider developer:\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	if tfValue.IsNull() || !tfValue.IsKnown() {
+		return
+	}
+
+	elementType := typeWithElementType.ElementType()
+
+	switch {
+	case tfValue.Type().Is(tftypes.List{}):
+		var elements []tftypes.Value
+
+		if err := tfValue.As(&elements); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.AttributePath,
+				"Attribute Validation Error",
+				"Attribute validation cannot convert value. Report this to the provider developer:\n\n"+err.Error(),
+			)
+
+			return
+		}
+
+		for idx, element := range elements {
+			attributeValidateElement(ctx, a.ElementValidators, elementType, req.AttributePath.AtListIndex(idx), element, req, resp)
+		}
+	case tfValue.Type().Is(tftypes.Set{}):
+		var elements []tftypes.Value
+
+		if err := tfValue.As(&elements); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.AttributePath,
+				"Attribute Validation Error",
+				"Attribute validation cannot convert value. Report this to the provider developer:\n\n"+err.Error(),
+			)
+
+			return
+		}
+
+		for _, element := range elements {
+			attributeValidateElement(ctx, a.ElementValidators, elementType, req.AttributePath.AtSetValue(element), element, req, resp)
+		}
+	case tfValue.Type().Is(tftypes.Map{}):
+		var elements map[string]tftypes.Value
+
+		if err := tfValue.As(&elements); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.AttributePath,
+				"Attribute Validation Error",
+				"Attribute validation cannot convert value. Report this to the provider developer:\n\n"+err.Error(),
+			)
+
+			return
+		}
+
+		for _, key := range sortedMapKeys(elements) {
+			elementPath := req.AttributePath.AtMapKey(key)
+
+			attributeValidateElement(ctx, a.KeyValidators, types.StringType, elementPath, tftypes.NewValue(tftypes.String, key), req, resp)
+			attributeValidateElement(ctx, a.ElementValidators, elementType, elementPath, elements[key], req, resp)
+		}
+	}
+}
+
+// attributeValidateElement calls the validators with the element value and
+// element path.
+func attributeValidateElement(ctx context.Context, validators []tfsdk.AttributeValidator, elementType attr.Type, elementPath path.Path, element tftypes.Value, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	if len(validators) == 0 {
+		return
+	}
+
+	var elementValue attr.Value
+	var err error
+	var diags diag.Diagnostics
+
+	callProviderDefined(ctx, &diags, "Type ValueFromTerraform", elementPath, func() {
+		elementValue, err = elementType.ValueFromTerraform(ctx, element)
+	})
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			elementPath,
+			"Attribute Validation Error",
+			"Attribute validation cannot convert element value. Report this to the provider developer:\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	elementReq := tfsdk.ValidateAttributeRequest{
+		AttributePath:   elementPath,
+		AttributeConfig: elementValue,
+		Config:          req.Config,
+		ProviderData:    req.ProviderData,
+	}
+
+	for _, validator := range validators {
+		callProviderDefined(ctx, &resp.Diagnostics, "AttributeValidator", elementPath, func() {
+			description := validator.Description(ctx)
+
+			logging.FrameworkDebug(
+				ctx,
+				"Calling provider defined AttributeValidator",
+				map[string]interface{}{
+					logging.KeyDescription: description,
+				},
+			)
+			validator.Validate(ctx, elementReq, resp)
+			logging.FrameworkDebug(
+				ctx,
+				"Called provider defined AttributeValidator",
+				map[string]interface{}{
+					logging.KeyDescription: description,
+				},
+			)
+		})
+	}
+}
+
+// sortedMapKeys returns the keys of the map in sorted order, so element
+// diagnostics are returned in a consistent order.
+func sortedMapKeys(m map[string]tftypes.Value) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
 // AttributeValidateNestedAttributes performs all nested Attributes validation.
 //
 // TODO: Clean up this abstraction back into an internal Attribute type method.
@@ -126,11 +314,16 @@ func AttributeValidateNestedAttributes(ctx context.Context, a tfsdk.Attribute, r
 			return
 		}
 
+		if !l.Null && !l.Unknown {
+			resp.Diagnostics.Append(nestedAttributesValidateItems(req.AttributePath, a.Attributes, len(l.Elems))...)
+		}
+
 		for idx := range l.Elems {
 			for nestedName, nestedAttr := range a.Attributes.GetAttributes() {
 				nestedAttrReq := tfsdk.ValidateAttributeRequest{
-					AttributePath: req.AttributePath.WithElementKeyInt(idx).WithAttributeName(nestedName),
+					AttributePath: req.AttributePath.AtListIndex(idx).AtName(nestedName),
 					Config:        req.Config,
+					ProviderData:  req.ProviderData,
 				}
 				nestedAttrResp := &tfsdk.ValidateAttributeResponse{
 					Diagnostics: resp.Diagnostics,
@@ -155,6 +348,10 @@ func AttributeValidateNestedAttributes(ctx context.Context, a tfsdk.Attribute, r
 			return
 		}
 
+		if !s.Null && !s.Unknown {
+			resp.Diagnostics.Append(nestedAttributesValidateItems(req.AttributePath, a.Attributes, len(s.Elems))...)
+		}
+
 		for _, value := range s.Elems {
 			tfValue, err := value.ToTerraformValue(ctx)
 			if err != nil {
@@ -170,8 +367,9 @@ func AttributeValidateNestedAttributes(ctx context.Context, a tfsdk.Attribute, r
 
 			for nestedName, nestedAttr := range a.Attributes.GetAttributes() {
 				nestedAttrReq := tfsdk.ValidateAttributeRequest{
-					AttributePath: req.AttributePath.WithElementKeyValue(tfValue).WithAttributeName(nestedName),
+					AttributePath: req.AttributePath.AtSetValue(tfValue).AtName(nestedName),
 					Config:        req.Config,
+					ProviderData:  req.ProviderData,
 				}
 				nestedAttrResp := &tfsdk.ValidateAttributeResponse{
 					Diagnostics: resp.Diagnostics,
@@ -196,11 +394,16 @@ func AttributeValidateNestedAttributes(ctx context.Context, a tfsdk.Attribute, r
 			return
 		}
 
+		if !m.Null && !m.Unknown {
+			resp.Diagnostics.Append(nestedAttributesValidateItems(req.AttributePath, a.Attributes, len(m.Elems))...)
+		}
+
 		for key := range m.Elems {
 			for nestedName, nestedAttr := range a.Attributes.GetAttributes() {
 				nestedAttrReq := tfsdk.ValidateAttributeRequest{
-					AttributePath: req.AttributePath.WithElementKeyString(key).WithAttributeName(nestedName),
+					AttributePath: req.AttributePath.AtMapKey(key).AtName(nestedName),
 					Config:        req.Config,
+					ProviderData:  req.ProviderData,
 				}
 				nestedAttrResp := &tfsdk.ValidateAttributeResponse{
 					Diagnostics: resp.Diagnostics,
@@ -228,8 +431,9 @@ func AttributeValidateNestedAttributes(ctx context.Context, a tfsdk.Attribute, r
 		if !o.Null && !o.Unknown {
 			for nestedName, nestedAttr := range a.Attributes.GetAttributes() {
 				nestedAttrReq := tfsdk.ValidateAttributeRequest{
-					AttributePath: req.AttributePath.WithAttributeName(nestedName),
+					AttributePath: req.AttributePath.AtName(nestedName),
 					Config:        req.Config,
+					ProviderData:  req.ProviderData,
 				}
 				nestedAttrResp := &tfsdk.ValidateAttributeResponse{
 					Diagnostics: resp.Diagnostics,
@@ -251,3 +455,28 @@ func AttributeValidateNestedAttributes(ctx context.Context, a tfsdk.Attribute, r
 		return
 	}
 }
+
+// nestedAttributesValidateItems returns diagnostics if the number of
+// configured elements is outside the MinItems and MaxItems of the nested
+// attributes.
+func nestedAttributesValidateItems(attributePath path.Path, n tfsdk.NestedAttributes, items int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if minItems := n.GetMinItems(); minItems > 0 && int64(items) < minItems {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute must contain at least %d elements, got: %d.", minItems, items),
+		)
+	}
+
+	if maxItems := n.GetMaxItems(); maxItems > 0 && int64(items) > maxItems {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute must contain at most %d elements, got: %d.", maxItems, items),
+		)
+	}
+
+	return diags
+}
@@ -2,11 +2,13 @@ package fwserver
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -21,7 +23,7 @@ func TestAttributeValidate(t *testing.T) {
 	}{
 		"no-attributes-or-type": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -42,7 +44,7 @@ func TestAttributeValidate(t *testing.T) {
 			resp: tfsdk.ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Invalid Attribute Definition",
 						"Attribute must define either Attributes or Type. This is always a problem with the provider and should be reported to the provider developer.",
 					),
@@ -51,7 +53,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"both-attributes-and-type": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -79,7 +81,7 @@ func TestAttributeValidate(t *testing.T) {
 			resp: tfsdk.ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Invalid Attribute Definition",
 						"Attribute cannot define both Attributes and Type. This is always a problem with the provider and should be reported to the provider developer.",
 					),
@@ -88,7 +90,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"missing-required-optional-and-computed": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -109,7 +111,7 @@ func TestAttributeValidate(t *testing.T) {
 			resp: tfsdk.ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Invalid Attribute Definition",
 						"Attribute missing Required, Optional, or Computed definition. This is always a problem with the provider and should be reported to the provider developer.",
 					),
@@ -118,7 +120,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"config-error": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -140,7 +142,7 @@ func TestAttributeValidate(t *testing.T) {
 			resp: tfsdk.ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Configuration Read Error",
 						"An unexpected error was encountered trying to read an attribute from the configuration. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 							"can't use tftypes.String<\"testvalue\"> as value of List with ElementType types.primitive, can only use tftypes.String values",
@@ -150,7 +152,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"no-validation": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -173,7 +175,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"deprecation-message-known": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -196,7 +198,7 @@ func TestAttributeValidate(t *testing.T) {
 			resp: tfsdk.ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeWarningDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Attribute Deprecated",
 						"Use something else instead.",
 					),
@@ -205,7 +207,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"deprecation-message-null": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -229,7 +231,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"deprecation-message-unknown": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -252,7 +254,7 @@ func TestAttributeValidate(t *testing.T) {
 			resp: tfsdk.ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeWarningDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Attribute Deprecated",
 						"Use something else instead.",
 					),
@@ -261,7 +263,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"warnings": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -293,7 +295,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"errors": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -323,9 +325,71 @@ func TestAttributeValidate(t *testing.T) {
 				},
 			},
 		},
+		"validator-panic": {
+			req: tfsdk.ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "testvalue"),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								Type:     types.StringType,
+								Required: true,
+								Validators: []tfsdk.AttributeValidator{
+									testPanicAttributeValidator{},
+									testWarningAttributeValidator{},
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: tfsdk.ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					testPanicDiagnostic(path.Root("test"), "AttributeValidator"),
+					testWarningDiagnostic2,
+				},
+			},
+		},
+		"validator-description-panic": {
+			req: tfsdk.ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "testvalue"),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								Type:     types.StringType,
+								Required: true,
+								Validators: []tfsdk.AttributeValidator{
+									testPanicDescriptionAttributeValidator{},
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: tfsdk.ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					testPanicDiagnostic(path.Root("test"), "AttributeValidator"),
+				},
+			},
+		},
 		"type-with-validate-error": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -346,13 +410,13 @@ func TestAttributeValidate(t *testing.T) {
 			},
 			resp: tfsdk.ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
-					testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test")),
+					testtypes.TestErrorDiagnostic(path.Root("test")),
 				},
 			},
 		},
 		"type-with-validate-warning": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -373,13 +437,227 @@ func TestAttributeValidate(t *testing.T) {
 			},
 			resp: tfsdk.ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
-					testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test")),
+					testtypes.TestWarningDiagnostic(path.Root("test")),
+				},
+			},
+		},
+		"element-validators-list": {
+			req: tfsdk.ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.List{ElementType: tftypes.String},
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+							tftypes.NewValue(tftypes.String, "first"),
+							tftypes.NewValue(tftypes.String, "second"),
+						}),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								Type:     types.ListType{ElemType: types.StringType},
+								Optional: true,
+								ElementValidators: []tfsdk.AttributeValidator{
+									testStringValueAttributeValidator{},
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: tfsdk.ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(0),
+						"String Value",
+						"first",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(1),
+						"String Value",
+						"second",
+					),
+				},
+			},
+		},
+		"element-validators-list-null": {
+			req: tfsdk.ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.List{ElementType: tftypes.String},
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								Type:     types.ListType{ElemType: types.StringType},
+								Optional: true,
+								ElementValidators: []tfsdk.AttributeValidator{
+									testStringValueAttributeValidator{},
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: tfsdk.ValidateAttributeResponse{},
+		},
+		"element-validators-list-unknown": {
+			req: tfsdk.ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.List{ElementType: tftypes.String},
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								Type:     types.ListType{ElemType: types.StringType},
+								Optional: true,
+								ElementValidators: []tfsdk.AttributeValidator{
+									testStringValueAttributeValidator{},
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: tfsdk.ValidateAttributeResponse{},
+		},
+		"element-validators-set": {
+			req: tfsdk.ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.Set{ElementType: tftypes.String},
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+							tftypes.NewValue(tftypes.String, "first"),
+						}),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								Type:     types.SetType{ElemType: types.StringType},
+								Optional: true,
+								ElementValidators: []tfsdk.AttributeValidator{
+									testStringValueAttributeValidator{},
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: tfsdk.ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "first")),
+						"String Value",
+						"first",
+					),
+				},
+			},
+		},
+		"element-validators-map": {
+			req: tfsdk.ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.Map{ElementType: tftypes.String},
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+							"x": tftypes.NewValue(tftypes.String, "first"),
+							"y": tftypes.NewValue(tftypes.String, "second"),
+						}),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								Type:     types.MapType{ElemType: types.StringType},
+								Optional: true,
+								ElementValidators: []tfsdk.AttributeValidator{
+									testStringValueAttributeValidator{},
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: tfsdk.ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtMapKey("x"),
+						"String Value",
+						"first",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtMapKey("y"),
+						"String Value",
+						"second",
+					),
+				},
+			},
+		},
+		"key-validators-map": {
+			req: tfsdk.ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.Map{ElementType: tftypes.String},
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+							"x": tftypes.NewValue(tftypes.String, "first"),
+							"y": tftypes.NewValue(tftypes.String, "second"),
+						}),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								Type:     types.MapType{ElemType: types.StringType},
+								Optional: true,
+								KeyValidators: []tfsdk.AttributeValidator{
+									testStringValueAttributeValidator{},
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: tfsdk.ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtMapKey("x"),
+						"String Value",
+						"x",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtMapKey("y"),
+						"String Value",
+						"y",
+					),
 				},
 			},
 		},
 		"nested-attr-list-no-validation": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -436,7 +714,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"nested-attr-list-validation": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -500,7 +778,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"nested-attr-map-no-validation": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -557,7 +835,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"nested-attr-map-validation": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -621,7 +899,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"nested-attr-set-no-validation": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -678,7 +956,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"nested-attr-set-validation": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -742,7 +1020,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"nested-attr-single-no-validation": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -786,7 +1064,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"nested-attr-single-validation": {
 			req: tfsdk.ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -834,6 +1112,243 @@ func TestAttributeValidate(t *testing.T) {
 				},
 			},
 		},
+		"nested-attr-list-minitems": {
+			req: tfsdk.ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.List{ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_attr": tftypes.String,
+									},
+								}},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.List{ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_attr": tftypes.String,
+									},
+								}},
+								[]tftypes.Value{
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "one"),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+									"nested_attr": {
+										Type:     types.StringType,
+										Required: true,
+									},
+								}, tfsdk.ListNestedAttributesOptions{MinItems: 2}),
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+			resp: tfsdk.ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute must contain at least 2 elements, got: 1.",
+					),
+				},
+			},
+		},
+		"nested-attr-list-minitems-null": {
+			req: tfsdk.ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.List{ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_attr": tftypes.String,
+									},
+								}},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.List{ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_attr": tftypes.String,
+									},
+								}},
+								nil,
+							),
+						},
+					),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+									"nested_attr": {
+										Type:     types.StringType,
+										Required: true,
+									},
+								}, tfsdk.ListNestedAttributesOptions{MinItems: 2}),
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+			resp: tfsdk.ValidateAttributeResponse{},
+		},
+		"nested-attr-map-maxitems": {
+			req: tfsdk.ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Map{ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_attr": tftypes.String,
+									},
+								}},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.Map{ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_attr": tftypes.String,
+									},
+								}},
+								map[string]tftypes.Value{
+									"one": tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "one"),
+										},
+									),
+									"two": tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "two"),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+									"nested_attr": {
+										Type:     types.StringType,
+										Required: true,
+									},
+								}, tfsdk.MapNestedAttributesOptions{MaxItems: 1}),
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+			resp: tfsdk.ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute must contain at most 1 elements, got: 2.",
+					),
+				},
+			},
+		},
+		"nested-attr-set-minitems-maxitems": {
+			req: tfsdk.ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Set{ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_attr": tftypes.String,
+									},
+								}},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.Set{ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_attr": tftypes.String,
+									},
+								}},
+								[]tftypes.Value{
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "one"),
+										},
+									),
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "two"),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+									"nested_attr": {
+										Type:     types.StringType,
+										Required: true,
+									},
+								}, tfsdk.SetNestedAttributesOptions{MinItems: 1, MaxItems: 2}),
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+			resp: tfsdk.ValidateAttributeResponse{},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -842,7 +1357,7 @@ func TestAttributeValidate(t *testing.T) {
 			t.Parallel()
 
 			var got tfsdk.ValidateAttributeResponse
-			attribute, err := tc.req.Config.Schema.AttributeAtPath(tc.req.AttributePath)
+			attribute, err := tc.req.Config.Schema.AttributeAtPath(context.Background(), tc.req.AttributePath)
 
 			if err != nil {
 				t.Fatalf("Unexpected error getting %s", err)
@@ -896,6 +1411,55 @@ func (v testErrorAttributeValidator) Validate(ctx context.Context, req tfsdk.Val
 	}
 }
 
+// testPanicAttributeValidator panics during Validate, to verify the panic
+// is recovered into an error diagnostic.
+type testPanicAttributeValidator struct {
+	tfsdk.AttributeValidator
+}
+
+func (v testPanicAttributeValidator) Description(ctx context.Context) string {
+	return "validation that always panics"
+}
+
+func (v testPanicAttributeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v testPanicAttributeValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	panic("test panic")
+}
+
+// testPanicDescriptionAttributeValidator panics during Description, which
+// is called for logging before Validate.
+type testPanicDescriptionAttributeValidator struct {
+	tfsdk.AttributeValidator
+}
+
+func (v testPanicDescriptionAttributeValidator) Description(ctx context.Context) string {
+	panic("test panic")
+}
+
+func (v testPanicDescriptionAttributeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v testPanicDescriptionAttributeValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	resp.Diagnostics.Append(testErrorDiagnostic1)
+}
+
+// testPanicDiagnostic returns the diagnostic expected when a "test panic"
+// panic is recovered from provider defined logic outside of a request.
+func testPanicDiagnostic(attributePath path.Path, description string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Provider Panic",
+		"An unexpected panic was recovered while calling provider defined "+description+". "+
+			"This is always a problem with the provider and should be reported to the provider developers. "+
+			"The stack trace has been written to the Terraform logs.\n\n"+
+			"Panic: test panic",
+	)
+}
+
 type testWarningAttributeValidator struct {
 	tfsdk.AttributeValidator
 }
@@ -915,3 +1479,30 @@ func (v testWarningAttributeValidator) Validate(ctx context.Context, req tfsdk.V
 		resp.Diagnostics.Append(testWarningDiagnostic2)
 	}
 }
+
+// testStringValueAttributeValidator returns an error diagnostic at the
+// attribute path containing the string value, to verify the request path
+// and value.
+type testStringValueAttributeValidator struct {
+	tfsdk.AttributeValidator
+}
+
+func (v testStringValueAttributeValidator) Description(ctx context.Context) string {
+	return "validation that always returns an error with the string value"
+}
+
+func (v testStringValueAttributeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v testStringValueAttributeValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := req.AttributeConfig.(types.String)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(req.AttributePath, "Unexpected Value Type", fmt.Sprintf("%T", req.AttributeConfig))
+
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(req.AttributePath, "String Value", value.Value)
+}
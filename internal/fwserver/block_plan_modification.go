@@ -49,7 +49,9 @@ func BlockModifyPlan(ctx context.Context, b tfsdk.Block, req tfsdk.ModifyAttribu
 			RequiresReplace: requiresReplace,
 		}
 
-		planModifier.Modify(ctx, req, modifyResp)
+		callProviderDefined(ctx, &modifyResp.Diagnostics, "AttributePlanModifier", req.AttributePath, func() {
+			planModifier.Modify(ctx, req, modifyResp)
+		})
 
 		req.AttributePlan = modifyResp.AttributePlan
 		resp.Diagnostics.Append(modifyResp.Diagnostics...)
@@ -91,10 +93,11 @@ func BlockModifyPlan(ctx context.Context, b tfsdk.Block, req tfsdk.ModifyAttribu
 		for idx := range l.Elems {
 			for name, attr := range b.Attributes {
 				attrReq := tfsdk.ModifyAttributePlanRequest{
-					AttributePath: req.AttributePath.WithElementKeyInt(idx).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtListIndex(idx).AtName(name),
 					Config:        req.Config,
 					Plan:          resp.Plan,
 					ProviderMeta:  req.ProviderMeta,
+					ProviderData:  req.ProviderData,
 					State:         req.State,
 				}
 
@@ -103,10 +106,11 @@ func BlockModifyPlan(ctx context.Context, b tfsdk.Block, req tfsdk.ModifyAttribu
 
 			for name, block := range b.Blocks {
 				blockReq := tfsdk.ModifyAttributePlanRequest{
-					AttributePath: req.AttributePath.WithElementKeyInt(idx).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtListIndex(idx).AtName(name),
 					Config:        req.Config,
 					Plan:          resp.Plan,
 					ProviderMeta:  req.ProviderMeta,
+					ProviderData:  req.ProviderData,
 					State:         req.State,
 				}
 
@@ -142,10 +146,11 @@ func BlockModifyPlan(ctx context.Context, b tfsdk.Block, req tfsdk.ModifyAttribu
 
 			for name, attr := range b.Attributes {
 				attrReq := tfsdk.ModifyAttributePlanRequest{
-					AttributePath: req.AttributePath.WithElementKeyValue(tfValue).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtSetValue(tfValue).AtName(name),
 					Config:        req.Config,
 					Plan:          resp.Plan,
 					ProviderMeta:  req.ProviderMeta,
+					ProviderData:  req.ProviderData,
 					State:         req.State,
 				}
 
@@ -154,16 +159,60 @@ func BlockModifyPlan(ctx context.Context, b tfsdk.Block, req tfsdk.ModifyAttribu
 
 			for name, block := range b.Blocks {
 				blockReq := tfsdk.ModifyAttributePlanRequest{
-					AttributePath: req.AttributePath.WithElementKeyValue(tfValue).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtSetValue(tfValue).AtName(name),
 					Config:        req.Config,
 					Plan:          resp.Plan,
 					ProviderMeta:  req.ProviderMeta,
+					ProviderData:  req.ProviderData,
 					State:         req.State,
 				}
 
 				BlockModifyPlan(ctx, block, blockReq, resp)
 			}
 		}
+	case tfsdk.BlockNestingModeSingle:
+		o, ok := req.AttributePlan.(types.Object)
+
+		if !ok {
+			err := fmt.Errorf("unknown block value type (%s) for nesting mode (%T) at path: %s", req.AttributeConfig.Type(ctx), nm, req.AttributePath)
+			resp.Diagnostics.AddAttributeError(
+				req.AttributePath,
+				"Block Plan Modification Error",
+				"Block plan modification cannot walk schema. Report this to the provider developer:\n\n"+err.Error(),
+			)
+
+			return
+		}
+
+		if len(o.Attrs) == 0 {
+			return
+		}
+
+		for name, attr := range b.Attributes {
+			attrReq := tfsdk.ModifyAttributePlanRequest{
+				AttributePath: req.AttributePath.AtName(name),
+				Config:        req.Config,
+				Plan:          resp.Plan,
+				ProviderMeta:  req.ProviderMeta,
+				ProviderData:  req.ProviderData,
+				State:         req.State,
+			}
+
+			AttributeModifyPlan(ctx, attr, attrReq, resp)
+		}
+
+		for name, block := range b.Blocks {
+			blockReq := tfsdk.ModifyAttributePlanRequest{
+				AttributePath: req.AttributePath.AtName(name),
+				Config:        req.Config,
+				Plan:          resp.Plan,
+				ProviderMeta:  req.ProviderMeta,
+				ProviderData:  req.ProviderData,
+				State:         req.State,
+			}
+
+			BlockModifyPlan(ctx, block, blockReq, resp)
+		}
 	default:
 		err := fmt.Errorf("unknown block plan modification nesting mode (%T: %v) at path: %s", nm, nm, req.AttributePath)
 		resp.Diagnostics.AddAttributeError(
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/planmodifiers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -99,13 +100,84 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 	)
 
+	singleSchema := func(nestedAttrPlanModifiers tfsdk.AttributePlanModifiers) tfsdk.Schema {
+		return tfsdk.Schema{
+			Blocks: map[string]tfsdk.Block{
+				"test": {
+					Attributes: map[string]tfsdk.Attribute{
+						"nested_attr": {
+							Type:          types.StringType,
+							Optional:      true,
+							PlanModifiers: nestedAttrPlanModifiers,
+						},
+					},
+					NestingMode: tfsdk.BlockNestingModeSingle,
+				},
+			},
+		}
+	}
+
+	singleSchemaTfValue := func(nestedAttrValue *string) tftypes.Value {
+		var blockValue interface{}
+
+		if nestedAttrValue != nil {
+			blockValue = map[string]tftypes.Value{
+				"nested_attr": tftypes.NewValue(tftypes.String, *nestedAttrValue),
+			}
+		}
+
+		return tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"nested_attr": tftypes.String,
+						},
+					},
+				},
+			},
+			map[string]tftypes.Value{
+				"test": tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"nested_attr": tftypes.String,
+						},
+					},
+					blockValue,
+				),
+			},
+		)
+	}
+
+	singleModifyAttributePlanRequest := func(schema tfsdk.Schema, config, plan, state *string) tfsdk.ModifyAttributePlanRequest {
+		return tfsdk.ModifyAttributePlanRequest{
+			AttributePath: path.Root("test"),
+			Config: tfsdk.Config{
+				Raw:    singleSchemaTfValue(config),
+				Schema: schema,
+			},
+			Plan: tfsdk.Plan{
+				Raw:    singleSchemaTfValue(plan),
+				Schema: schema,
+			},
+			State: tfsdk.State{
+				Raw:    singleSchemaTfValue(state),
+				Schema: schema,
+			},
+		}
+	}
+
+	stringPointer := func(s string) *string {
+		return &s
+	}
+
 	type modifyAttributePlanValues struct {
 		config string
 		plan   string
 		state  string
 	}
 
-	modifyAttributePlanRequest := func(attrPath *tftypes.AttributePath, schema tfsdk.Schema, values modifyAttributePlanValues) tfsdk.ModifyAttributePlanRequest {
+	modifyAttributePlanRequest := func(attrPath path.Path, schema tfsdk.Schema, values modifyAttributePlanValues) tfsdk.ModifyAttributePlanRequest {
 		return tfsdk.ModifyAttributePlanRequest{
 			AttributePath: attrPath,
 			Config: tfsdk.Config{
@@ -130,7 +202,7 @@ func TestBlockModifyPlan(t *testing.T) {
 	}{
 		"no-plan-modifiers": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, nil),
 				modifyAttributePlanValues{
 					config: "testvalue",
@@ -148,7 +220,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"block-modified": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]tfsdk.AttributePlanModifier{
 					testBlockPlanModifierNullList{},
 				}, nil),
@@ -170,7 +242,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"block-modified-previous-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]tfsdk.AttributePlanModifier{
 					testBlockPlanModifierNullList{},
 				}, nil),
@@ -205,7 +277,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"block-requires-replacement": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]tfsdk.AttributePlanModifier{
 					tfsdk.RequiresReplace(),
 				}, nil),
@@ -223,14 +295,14 @@ func TestBlockModifyPlan(t *testing.T) {
 						tfsdk.RequiresReplace(),
 					}, nil),
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test"),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
 				},
 			},
 		},
 		"block-requires-replacement-previous-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]tfsdk.AttributePlanModifier{
 					tfsdk.RequiresReplace(),
 				}, nil),
@@ -261,14 +333,14 @@ func TestBlockModifyPlan(t *testing.T) {
 						tfsdk.RequiresReplace(),
 					}, nil),
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test"),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
 				},
 			},
 		},
 		"block-requires-replacement-passthrough": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]tfsdk.AttributePlanModifier{
 					tfsdk.RequiresReplace(),
 					testBlockPlanModifierNullList{},
@@ -288,14 +360,14 @@ func TestBlockModifyPlan(t *testing.T) {
 						testBlockPlanModifierNullList{},
 					}, nil),
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test"),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
 				},
 			},
 		},
 		"block-requires-replacement-unset": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]tfsdk.AttributePlanModifier{
 					tfsdk.RequiresReplace(),
 					planmodifiers.TestRequiresReplaceFalseModifier{},
@@ -319,7 +391,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"block-warnings": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]tfsdk.AttributePlanModifier{
 					planmodifiers.TestWarningDiagModifier{},
 					planmodifiers.TestWarningDiagModifier{},
@@ -352,7 +424,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"block-warnings-previous-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]tfsdk.AttributePlanModifier{
 					planmodifiers.TestWarningDiagModifier{},
 					planmodifiers.TestWarningDiagModifier{},
@@ -396,7 +468,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"block-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]tfsdk.AttributePlanModifier{
 					planmodifiers.TestErrorDiagModifier{},
 					planmodifiers.TestErrorDiagModifier{},
@@ -426,7 +498,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"block-error-previous-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]tfsdk.AttributePlanModifier{
 					planmodifiers.TestErrorDiagModifier{},
 					planmodifiers.TestErrorDiagModifier{},
@@ -467,7 +539,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"nested-attribute-modified": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []tfsdk.AttributePlanModifier{
 					planmodifiers.TestAttrPlanValueModifierOne{},
 					planmodifiers.TestAttrPlanValueModifierTwo{},
@@ -491,7 +563,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"nested-attribute-modified-previous-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []tfsdk.AttributePlanModifier{
 					planmodifiers.TestAttrPlanValueModifierOne{},
 					planmodifiers.TestAttrPlanValueModifierTwo{},
@@ -528,7 +600,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"nested-attribute-requires-replacement": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []tfsdk.AttributePlanModifier{
 					tfsdk.RequiresReplace(),
 				}),
@@ -546,14 +618,14 @@ func TestBlockModifyPlan(t *testing.T) {
 						tfsdk.RequiresReplace(),
 					}),
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("nested_attr"),
+				RequiresReplace: path.Paths{
+					path.Root("test").AtListIndex(0).AtName("nested_attr"),
 				},
 			},
 		},
 		"nested-attribute-requires-replacement-previous-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []tfsdk.AttributePlanModifier{
 					tfsdk.RequiresReplace(),
 				}),
@@ -584,14 +656,14 @@ func TestBlockModifyPlan(t *testing.T) {
 						tfsdk.RequiresReplace(),
 					}),
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("nested_attr"),
+				RequiresReplace: path.Paths{
+					path.Root("test").AtListIndex(0).AtName("nested_attr"),
 				},
 			},
 		},
 		"nested-attribute-requires-replacement-passthrough": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []tfsdk.AttributePlanModifier{
 					tfsdk.RequiresReplace(),
 					planmodifiers.TestAttrPlanValueModifierOne{},
@@ -611,14 +683,14 @@ func TestBlockModifyPlan(t *testing.T) {
 						planmodifiers.TestAttrPlanValueModifierOne{},
 					}),
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("nested_attr"),
+				RequiresReplace: path.Paths{
+					path.Root("test").AtListIndex(0).AtName("nested_attr"),
 				},
 			},
 		},
 		"nested-attribute-requires-replacement-unset": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []tfsdk.AttributePlanModifier{
 					tfsdk.RequiresReplace(),
 					planmodifiers.TestRequiresReplaceFalseModifier{},
@@ -642,7 +714,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"nested-attribute-warnings": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []tfsdk.AttributePlanModifier{
 					planmodifiers.TestWarningDiagModifier{},
 					planmodifiers.TestWarningDiagModifier{},
@@ -675,7 +747,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"nested-attribute-warnings-previous-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []tfsdk.AttributePlanModifier{
 					planmodifiers.TestWarningDiagModifier{},
 					planmodifiers.TestWarningDiagModifier{},
@@ -719,7 +791,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"nested-attribute-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []tfsdk.AttributePlanModifier{
 					planmodifiers.TestErrorDiagModifier{},
 					planmodifiers.TestErrorDiagModifier{},
@@ -749,7 +821,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"nested-attribute-error-previous-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []tfsdk.AttributePlanModifier{
 					planmodifiers.TestErrorDiagModifier{},
 					planmodifiers.TestErrorDiagModifier{},
@@ -788,6 +860,66 @@ func TestBlockModifyPlan(t *testing.T) {
 				},
 			},
 		},
+		"single-nested-attribute-modified": {
+			req: singleModifyAttributePlanRequest(
+				singleSchema([]tfsdk.AttributePlanModifier{
+					planmodifiers.TestAttrPlanValueModifierOne{},
+				}),
+				stringPointer("TESTATTRONE"),
+				stringPointer("TESTATTRONE"),
+				stringPointer("TESTATTRONE"),
+			),
+			resp: ModifySchemaPlanResponse{},
+			expectedResp: ModifySchemaPlanResponse{
+				Plan: tfsdk.Plan{
+					Raw: singleSchemaTfValue(stringPointer("TESTATTRTWO")),
+					Schema: singleSchema([]tfsdk.AttributePlanModifier{
+						planmodifiers.TestAttrPlanValueModifierOne{},
+					}),
+				},
+			},
+		},
+		"single-nested-attribute-requires-replacement": {
+			req: singleModifyAttributePlanRequest(
+				singleSchema([]tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				}),
+				stringPointer("newtestvalue"),
+				stringPointer("newtestvalue"),
+				stringPointer("testvalue"),
+			),
+			resp: ModifySchemaPlanResponse{},
+			expectedResp: ModifySchemaPlanResponse{
+				Plan: tfsdk.Plan{
+					Raw: singleSchemaTfValue(stringPointer("newtestvalue")),
+					Schema: singleSchema([]tfsdk.AttributePlanModifier{
+						tfsdk.RequiresReplace(),
+					}),
+				},
+				RequiresReplace: path.Paths{
+					path.Root("test").AtName("nested_attr"),
+				},
+			},
+		},
+		"single-null": {
+			req: singleModifyAttributePlanRequest(
+				singleSchema([]tfsdk.AttributePlanModifier{
+					planmodifiers.TestErrorDiagModifier{},
+				}),
+				nil,
+				nil,
+				nil,
+			),
+			resp: ModifySchemaPlanResponse{},
+			expectedResp: ModifySchemaPlanResponse{
+				Plan: tfsdk.Plan{
+					Raw: singleSchemaTfValue(nil),
+					Schema: singleSchema([]tfsdk.AttributePlanModifier{
+						planmodifiers.TestErrorDiagModifier{},
+					}),
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -795,7 +927,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			block, err := SchemaBlockAtPath(tc.req.Config.Schema, tc.req.AttributePath)
+			block, err := SchemaBlockAtPath(context.Background(), tc.req.Config.Schema, tc.req.AttributePath)
 
 			if err != nil {
 				t.Fatalf("Unexpected error getting %s", err)
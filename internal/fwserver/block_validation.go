@@ -25,7 +25,9 @@ func BlockValidate(ctx context.Context, b tfsdk.Block, req tfsdk.ValidateAttribu
 	req.AttributeConfig = attributeConfig
 
 	for _, validator := range b.Validators {
-		validator.Validate(ctx, req, resp)
+		callProviderDefined(ctx, &resp.Diagnostics, "AttributeValidator", req.AttributePath, func() {
+			validator.Validate(ctx, req, resp)
+		})
 	}
 
 	nm := b.NestingMode
@@ -47,8 +49,9 @@ func BlockValidate(ctx context.Context, b tfsdk.Block, req tfsdk.ValidateAttribu
 		for idx := range l.Elems {
 			for name, attr := range b.Attributes {
 				nestedAttrReq := tfsdk.ValidateAttributeRequest{
-					AttributePath: req.AttributePath.WithElementKeyInt(idx).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtListIndex(idx).AtName(name),
 					Config:        req.Config,
+					ProviderData:  req.ProviderData,
 				}
 				nestedAttrResp := &tfsdk.ValidateAttributeResponse{
 					Diagnostics: resp.Diagnostics,
@@ -61,8 +64,9 @@ func BlockValidate(ctx context.Context, b tfsdk.Block, req tfsdk.ValidateAttribu
 
 			for name, block := range b.Blocks {
 				nestedAttrReq := tfsdk.ValidateAttributeRequest{
-					AttributePath: req.AttributePath.WithElementKeyInt(idx).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtListIndex(idx).AtName(name),
 					Config:        req.Config,
+					ProviderData:  req.ProviderData,
 				}
 				nestedAttrResp := &tfsdk.ValidateAttributeResponse{
 					Diagnostics: resp.Diagnostics,
@@ -102,8 +106,9 @@ func BlockValidate(ctx context.Context, b tfsdk.Block, req tfsdk.ValidateAttribu
 
 			for name, attr := range b.Attributes {
 				nestedAttrReq := tfsdk.ValidateAttributeRequest{
-					AttributePath: req.AttributePath.WithElementKeyValue(tfValue).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtSetValue(tfValue).AtName(name),
 					Config:        req.Config,
+					ProviderData:  req.ProviderData,
 				}
 				nestedAttrResp := &tfsdk.ValidateAttributeResponse{
 					Diagnostics: resp.Diagnostics,
@@ -116,8 +121,54 @@ func BlockValidate(ctx context.Context, b tfsdk.Block, req tfsdk.ValidateAttribu
 
 			for name, block := range b.Blocks {
 				nestedAttrReq := tfsdk.ValidateAttributeRequest{
-					AttributePath: req.AttributePath.WithElementKeyValue(tfValue).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtSetValue(tfValue).AtName(name),
 					Config:        req.Config,
+					ProviderData:  req.ProviderData,
+				}
+				nestedAttrResp := &tfsdk.ValidateAttributeResponse{
+					Diagnostics: resp.Diagnostics,
+				}
+
+				BlockValidate(ctx, block, nestedAttrReq, nestedAttrResp)
+
+				resp.Diagnostics = nestedAttrResp.Diagnostics
+			}
+		}
+	case tfsdk.BlockNestingModeSingle:
+		o, ok := req.AttributeConfig.(types.Object)
+
+		if !ok {
+			err := fmt.Errorf("unknown block value type (%s) for nesting mode (%T) at path: %s", req.AttributeConfig.Type(ctx), nm, req.AttributePath)
+			resp.Diagnostics.AddAttributeError(
+				req.AttributePath,
+				"Block Validation Error",
+				"Block validation cannot walk schema. Report this to the provider developer:\n\n"+err.Error(),
+			)
+
+			return
+		}
+
+		if !o.Null && !o.Unknown {
+			for name, attr := range b.Attributes {
+				nestedAttrReq := tfsdk.ValidateAttributeRequest{
+					AttributePath: req.AttributePath.AtName(name),
+					Config:        req.Config,
+					ProviderData:  req.ProviderData,
+				}
+				nestedAttrResp := &tfsdk.ValidateAttributeResponse{
+					Diagnostics: resp.Diagnostics,
+				}
+
+				AttributeValidate(ctx, attr, nestedAttrReq, nestedAttrResp)
+
+				resp.Diagnostics = nestedAttrResp.Diagnostics
+			}
+
+			for name, block := range b.Blocks {
+				nestedAttrReq := tfsdk.ValidateAttributeRequest{
+					AttributePath: req.AttributePath.AtName(name),
+					Config:        req.Config,
+					ProviderData:  req.ProviderData,
 				}
 				nestedAttrResp := &tfsdk.ValidateAttributeResponse{
 					Diagnostics: resp.Diagnostics,
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -22,10 +24,10 @@ import (
 // The extra Config parameter is a carry-over of creating the proto6server
 // package from the tfsdk package and not wanting to export the method.
 // Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/215
-func ConfigGetAttributeValue(ctx context.Context, c tfsdk.Config, path *tftypes.AttributePath) (attr.Value, diag.Diagnostics) {
+func ConfigGetAttributeValue(ctx context.Context, c tfsdk.Config, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	attrType, err := c.Schema.AttributeTypeAtPath(path)
+	attrType, err := c.Schema.AttributeTypeAtPath(ctx, path)
 	if err != nil {
 		diags.AddAttributeError(
 			path,
@@ -41,7 +43,7 @@ func ConfigGetAttributeValue(ctx context.Context, c tfsdk.Config, path *tftypes.
 		return nil, nil
 	}
 
-	tfValue, err := ConfigTerraformValueAtPath(c, path)
+	tfValue, err := ConfigTerraformValueAtPath(ctx, c, path)
 
 	// Ignoring ErrInvalidStep will allow this method to return a null value of the type.
 	if err != nil && !errors.Is(err, tftypes.ErrInvalidStep) {
@@ -60,7 +62,9 @@ func ConfigGetAttributeValue(ctx context.Context, c tfsdk.Config, path *tftypes.
 	if attrTypeWithValidate, ok := attrType.(attr.TypeWithValidate); ok {
 		logging.FrameworkTrace(ctx, "Type implements TypeWithValidate")
 		logging.FrameworkDebug(ctx, "Calling provider defined Type Validate")
-		diags.Append(attrTypeWithValidate.Validate(ctx, tfValue, path)...)
+		callProviderDefined(ctx, &diags, "Type Validate", path, func() {
+			diags.Append(attrTypeWithValidate.Validate(ctx, tfValue, path)...)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined Type Validate")
 
 		if diags.HasError() {
@@ -68,7 +72,15 @@ func ConfigGetAttributeValue(ctx context.Context, c tfsdk.Config, path *tftypes.
 		}
 	}
 
-	attrValue, err := attrType.ValueFromTerraform(ctx, tfValue)
+	var attrValue attr.Value
+
+	callProviderDefined(ctx, &diags, "Type ValueFromTerraform", path, func() {
+		attrValue, err = attrType.ValueFromTerraform(ctx, tfValue)
+	})
+
+	if diags.HasError() {
+		return nil, diags
+	}
 
 	if err != nil {
 		diags.AddAttributeError(
@@ -89,8 +101,14 @@ func ConfigGetAttributeValue(ctx context.Context, c tfsdk.Config, path *tftypes.
 // The extra Config parameter is a carry-over of creating the proto6server
 // package from the tfsdk package and not wanting to export the method.
 // Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/215
-func ConfigTerraformValueAtPath(c tfsdk.Config, path *tftypes.AttributePath) (tftypes.Value, error) {
-	rawValue, remaining, err := tftypes.WalkAttributePath(c.Raw, path)
+func ConfigTerraformValueAtPath(ctx context.Context, c tfsdk.Config, path path.Path) (tftypes.Value, error) {
+	tftypesPath, err := totftypes.AttributePath(ctx, path)
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	rawValue, remaining, err := tftypes.WalkAttributePath(c.Raw, tftypesPath)
 	if err != nil {
 		return tftypes.Value{}, fmt.Errorf("%v still remains in the path: %w", remaining, err)
 	}
@@ -0,0 +1,122 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// RequestInfo describes the protocol request currently being handled. It is
+// used to add context to diagnostics generated when recovering from panics in
+// provider defined logic.
+type RequestInfo struct {
+	// RPC is the protocol RPC name, such as ApplyResourceChange.
+	RPC string
+
+	// DataSourceType is the data source type name, if applicable.
+	DataSourceType string
+
+	// ResourceType is the resource type name, if applicable.
+	ResourceType string
+}
+
+// requestInfoKey is the context key for RequestInfo.
+type requestInfoKey struct{}
+
+// ContextWithRequestInfo returns a new context containing the RequestInfo.
+// Protocol servers should call this before calling into the framework server.
+func ContextWithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// requestInfoFromContext returns the RequestInfo from the context, if any.
+func requestInfoFromContext(ctx context.Context) RequestInfo {
+	info, ok := ctx.Value(requestInfoKey{}).(RequestInfo)
+
+	if !ok {
+		return RequestInfo{}
+	}
+
+	return info
+}
+
+// callProviderDefined calls the given provider defined logic, converting any
+// panic into an error diagnostic that is appended to the given diagnostics.
+// The stack trace of the panic is written to the framework logs.
+//
+// The description should identify the provider defined logic being called,
+// such as "Resource Create". The attribute path should be empty unless the
+// logic is associated with a specific attribute.
+//
+// Every call into provider defined logic must happen within f, including
+// Description methods used for logging and custom type methods, since a
+// panic outside of f terminates the provider.
+func callProviderDefined(ctx context.Context, diags *diag.Diagnostics, description string, attributePath path.Path, f func()) {
+	defer func() {
+		r := recover()
+
+		if r == nil {
+			return
+		}
+
+		logging.FrameworkError(
+			ctx,
+			"Recovered from panic in provider defined "+description,
+			map[string]interface{}{
+				logging.KeyPanic:      fmt.Sprintf("%v", r),
+				logging.KeyStackTrace: string(debug.Stack()),
+			},
+		)
+
+		summary := "Provider Panic"
+		detail := fmt.Sprintf(
+			"An unexpected panic was recovered while calling provider defined %s%s. "+
+				"This is always a problem with the provider and should be reported to the provider developers. "+
+				"The stack trace has been written to the Terraform logs.\n\n"+
+				"Panic: %v",
+			description,
+			panicLocation(requestInfoFromContext(ctx)),
+			r,
+		)
+
+		if attributePath.Equal(path.Empty()) {
+			diags.AddError(summary, detail)
+
+			return
+		}
+
+		diags.AddAttributeError(attributePath, summary, detail)
+	}()
+
+	f()
+}
+
+// panicLocation returns a human readable description of the request
+// information for panic diagnostics, such as: during the ApplyResourceChange
+// RPC for the "example_thing" resource type.
+func panicLocation(info RequestInfo) string {
+	var parts []string
+
+	if info.RPC != "" {
+		parts = append(parts, fmt.Sprintf("during the %s RPC", info.RPC))
+	}
+
+	if info.ResourceType != "" {
+		parts = append(parts, fmt.Sprintf("for the %q resource type", info.ResourceType))
+	}
+
+	if info.DataSourceType != "" {
+		parts = append(parts, fmt.Sprintf("for the %q data source type", info.DataSourceType))
+	}
+
+	if len(parts) == 0 {
+		return ""
+	}
+
+	return " " + strings.Join(parts, " ")
+}
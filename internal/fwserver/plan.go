@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -22,10 +24,10 @@ import (
 // The extra Plan parameter is a carry-over of creating the proto6server
 // package from the tfsdk package and not wanting to export the method.
 // Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/215
-func PlanGetAttributeValue(ctx context.Context, p tfsdk.Plan, path *tftypes.AttributePath) (attr.Value, diag.Diagnostics) {
+func PlanGetAttributeValue(ctx context.Context, p tfsdk.Plan, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	attrType, err := p.Schema.AttributeTypeAtPath(path)
+	attrType, err := p.Schema.AttributeTypeAtPath(ctx, path)
 	if err != nil {
 		err = fmt.Errorf("error getting attribute type in schema: %w", err)
 		diags.AddAttributeError(
@@ -41,7 +43,7 @@ func PlanGetAttributeValue(ctx context.Context, p tfsdk.Plan, path *tftypes.Attr
 		return nil, nil
 	}
 
-	tfValue, err := PlanTerraformValueAtPath(p, path)
+	tfValue, err := PlanTerraformValueAtPath(ctx, p, path)
 
 	// Ignoring ErrInvalidStep will allow this method to return a null value of the type.
 	if err != nil && !errors.Is(err, tftypes.ErrInvalidStep) {
@@ -60,7 +62,9 @@ func PlanGetAttributeValue(ctx context.Context, p tfsdk.Plan, path *tftypes.Attr
 	if attrTypeWithValidate, ok := attrType.(attr.TypeWithValidate); ok {
 		logging.FrameworkTrace(ctx, "Type implements TypeWithValidate")
 		logging.FrameworkDebug(ctx, "Calling provider defined Type Validate")
-		diags.Append(attrTypeWithValidate.Validate(ctx, tfValue, path)...)
+		callProviderDefined(ctx, &diags, "Type Validate", path, func() {
+			diags.Append(attrTypeWithValidate.Validate(ctx, tfValue, path)...)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined Type Validate")
 
 		if diags.HasError() {
@@ -68,7 +72,15 @@ func PlanGetAttributeValue(ctx context.Context, p tfsdk.Plan, path *tftypes.Attr
 		}
 	}
 
-	attrValue, err := attrType.ValueFromTerraform(ctx, tfValue)
+	var attrValue attr.Value
+
+	callProviderDefined(ctx, &diags, "Type ValueFromTerraform", path, func() {
+		attrValue, err = attrType.ValueFromTerraform(ctx, tfValue)
+	})
+
+	if diags.HasError() {
+		return nil, diags
+	}
 
 	if err != nil {
 		diags.AddAttributeError(
@@ -89,8 +101,14 @@ func PlanGetAttributeValue(ctx context.Context, p tfsdk.Plan, path *tftypes.Attr
 // The extra Plan parameter is a carry-over of creating the proto6server
 // package from the tfsdk package and not wanting to export the method.
 // Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/215
-func PlanTerraformValueAtPath(p tfsdk.Plan, path *tftypes.AttributePath) (tftypes.Value, error) {
-	rawValue, remaining, err := tftypes.WalkAttributePath(p.Raw, path)
+func PlanTerraformValueAtPath(ctx context.Context, p tfsdk.Plan, path path.Path) (tftypes.Value, error) {
+	tftypesPath, err := totftypes.AttributePath(ctx, path)
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	rawValue, remaining, err := tftypes.WalkAttributePath(p.Raw, tftypesPath)
 	if err != nil {
 		return tftypes.Value{}, fmt.Errorf("%v still remains in the path: %w", remaining, err)
 	}
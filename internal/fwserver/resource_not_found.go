@@ -0,0 +1,53 @@
+package fwserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// resourceNotFoundDiags returns true if every error diagnostic of a resource
+// Read was created from an error which indicates the remote object no longer
+// exists, as determined by tfsdk.IsResourceNotFound. If so, those error
+// diagnostics are replaced with warning diagnostics in the returned
+// diagnostics, so the resource can be removed from the state.
+func resourceNotFoundDiags(diags diag.Diagnostics) (diag.Diagnostics, bool) {
+	var notFound bool
+	var result diag.Diagnostics
+
+	for _, d := range diags {
+		if d.Severity() != diag.SeverityError {
+			result.Append(d)
+
+			continue
+		}
+
+		dWithError, ok := d.(diag.DiagnosticWithError)
+
+		if !ok || !tfsdk.IsResourceNotFound(dWithError.Err()) {
+			return diags, false
+		}
+
+		notFound = true
+
+		warning := diag.NewWarningDiagnostic(
+			"Resource Not Found",
+			"The remote object was not found, so the resource was removed from the Terraform state. "+
+				"Terraform will propose to create the resource again if it remains in the configuration.\n\n"+
+				d.Summary()+": "+d.Detail(),
+		)
+
+		if dWithPath, ok := d.(diag.DiagnosticWithPath); ok {
+			result.Append(diag.WithPath(dWithPath.Path(), warning))
+
+			continue
+		}
+
+		result.Append(warning)
+	}
+
+	if !notFound {
+		return diags, false
+	}
+
+	return result, true
+}
@@ -0,0 +1,148 @@
+package fwserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Resource operation names, which match the attribute names of the
+// tfsdk.TimeoutsAttribute and tfsdk.TimeoutsBlock.
+const (
+	resourceOperationCreate = "create"
+	resourceOperationDelete = "delete"
+	resourceOperationRead   = "read"
+	resourceOperationUpdate = "update"
+)
+
+// resourceTimeout returns the timeout of the given resource operation. The
+// timeout configured in the tfsdk.TimeoutsName attribute or block of the
+// given resource data takes precedence over the default timeout of a
+// tfsdk.ResourceWithTimeouts. A zero duration indicates no timeout.
+func resourceTimeout(ctx context.Context, resource tfsdk.Resource, schema tfsdk.Schema, data tftypes.Value, operation string) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var timeout time.Duration
+
+	if resourceWithTimeouts, ok := resource.(tfsdk.ResourceWithTimeouts); ok {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithTimeouts")
+
+		var defaults tfsdk.Timeouts
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Resource Timeouts")
+		callProviderDefined(ctx, &diags, "Resource Timeouts", path.Empty(), func() {
+			defaults = resourceWithTimeouts.Timeouts(ctx)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined Resource Timeouts")
+
+		switch operation {
+		case resourceOperationCreate:
+			timeout = defaults.Create
+		case resourceOperationDelete:
+			timeout = defaults.Delete
+		case resourceOperationRead:
+			timeout = defaults.Read
+		case resourceOperationUpdate:
+			timeout = defaults.Update
+		}
+	}
+
+	_, hasTimeoutsAttribute := schema.Attributes[tfsdk.TimeoutsName]
+	_, hasTimeoutsBlock := schema.Blocks[tfsdk.TimeoutsName]
+
+	if !hasTimeoutsAttribute && !hasTimeoutsBlock {
+		return timeout, diags
+	}
+
+	if !data.IsKnown() || data.IsNull() {
+		return timeout, diags
+	}
+
+	timeouts, _, err := tftypes.WalkAttributePath(data, tftypes.NewAttributePath().WithAttributeName(tfsdk.TimeoutsName))
+
+	if err != nil {
+		return timeout, diags
+	}
+
+	if timeoutsValue, ok := timeouts.(tftypes.Value); !ok || !timeoutsValue.IsKnown() || timeoutsValue.IsNull() {
+		return timeout, diags
+	}
+
+	configured, _, err := tftypes.WalkAttributePath(timeouts, tftypes.NewAttributePath().WithAttributeName(operation))
+
+	if err != nil {
+		return timeout, diags
+	}
+
+	configuredValue, ok := configured.(tftypes.Value)
+
+	if !ok || !configuredValue.IsKnown() || configuredValue.IsNull() {
+		return timeout, diags
+	}
+
+	timeoutPath := path.Root(tfsdk.TimeoutsName).AtName(operation)
+
+	var configuredString string
+
+	if err := configuredValue.As(&configuredString); err != nil {
+		diags.AddAttributeError(
+			timeoutPath,
+			"Invalid Resource Timeout",
+			"An unexpected error was encountered trying to read the resource timeout. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return 0, diags
+	}
+
+	timeout, err = time.ParseDuration(configuredString)
+
+	if err != nil {
+		diags.AddAttributeError(
+			timeoutPath,
+			"Invalid Resource Timeout",
+			fmt.Sprintf("The %s timeout %q is not a valid duration: %s", operation, configuredString, err),
+		)
+
+		return 0, diags
+	}
+
+	logging.FrameworkTrace(ctx, "Using configured resource timeout", map[string]interface{}{"timeout": timeout.String()})
+
+	return timeout, diags
+}
+
+// contextWithResourceTimeout returns a context with a deadline of the given
+// timeout, unless it is zero or negative.
+func contextWithResourceTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// resourceTimeoutDiags returns an error diagnostic if the given diagnostics of
+// the resource operation contain an error and the deadline of the given
+// context, as created by contextWithResourceTimeout, was exceeded. Operations
+// which completed successfully are not reported as timed out, even if the
+// deadline passed just afterwards.
+func resourceTimeoutDiags(ctx context.Context, operation string, timeout time.Duration, operationDiags diag.Diagnostics) diag.Diagnostics {
+	if timeout <= 0 || !operationDiags.HasError() || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Resource Operation Timed Out",
+			fmt.Sprintf("The resource %s operation did not complete within the timeout of %s and returned errors after its context deadline was exceeded (%s). ", operation, timeout, ctx.Err())+
+				"The operation may still be in progress in the remote system. "+
+				fmt.Sprintf("If the resource supports a %q configuration, increase the %q timeout and try again.", tfsdk.TimeoutsName, operation),
+		),
+	}
+}
@@ -0,0 +1,307 @@
+package fwserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestResourceTimeout(t *testing.T) {
+	t.Parallel()
+
+	testTimeoutsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"create": tftypes.String,
+			"delete": tftypes.String,
+			"read":   tftypes.String,
+			"update": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test":     tftypes.String,
+			"timeouts": testTimeoutsType,
+		},
+	}
+
+	testTimeoutsValue := func(create tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, "test-value"),
+			"timeouts": tftypes.NewValue(testTimeoutsType, map[string]tftypes.Value{
+				"create": create,
+				"delete": tftypes.NewValue(tftypes.String, nil),
+				"read":   tftypes.NewValue(tftypes.String, nil),
+				"update": tftypes.NewValue(tftypes.String, "2h"),
+			}),
+		})
+	}
+
+	testSchemaAttribute := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test": {
+				Required: true,
+				Type:     types.StringType,
+			},
+			"timeouts": tfsdk.TimeoutsAttribute(),
+		},
+	}
+
+	testSchemaBlock := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test": {
+				Required: true,
+				Type:     types.StringType,
+			},
+		},
+		Blocks: map[string]tfsdk.Block{
+			"timeouts": tfsdk.TimeoutsBlock(),
+		},
+	}
+
+	testResourceWithTimeouts := &testprovider.ResourceWithTimeouts{
+		Resource: &testprovider.Resource{},
+		TimeoutsMethod: func(_ context.Context) tfsdk.Timeouts {
+			return tfsdk.Timeouts{
+				Create: 20 * time.Minute,
+				Delete: 10 * time.Minute,
+			}
+		},
+	}
+
+	testCases := map[string]struct {
+		resource      tfsdk.Resource
+		schema        tfsdk.Schema
+		data          tftypes.Value
+		operation     string
+		expected      time.Duration
+		expectedDiags diag.Diagnostics
+	}{
+		"no-defaults-no-timeouts": {
+			resource: &testprovider.Resource{},
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Required: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			data: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "test-value"),
+			}),
+			operation: resourceOperationCreate,
+			expected:  0,
+		},
+		"defaults": {
+			resource: testResourceWithTimeouts,
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Required: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			data: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "test-value"),
+			}),
+			operation: resourceOperationDelete,
+			expected:  10 * time.Minute,
+		},
+		"defaults-null-data": {
+			resource:  testResourceWithTimeouts,
+			schema:    testSchemaBlock,
+			data:      tftypes.NewValue(testType, nil),
+			operation: resourceOperationCreate,
+			expected:  20 * time.Minute,
+		},
+		"defaults-null-timeouts": {
+			resource: testResourceWithTimeouts,
+			schema:   testSchemaBlock,
+			data: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test":     tftypes.NewValue(tftypes.String, "test-value"),
+				"timeouts": tftypes.NewValue(testTimeoutsType, nil),
+			}),
+			operation: resourceOperationCreate,
+			expected:  20 * time.Minute,
+		},
+		"defaults-null-timeout": {
+			resource:  testResourceWithTimeouts,
+			schema:    testSchemaBlock,
+			data:      testTimeoutsValue(tftypes.NewValue(tftypes.String, nil)),
+			operation: resourceOperationCreate,
+			expected:  20 * time.Minute,
+		},
+		"defaults-unknown-timeout": {
+			resource:  testResourceWithTimeouts,
+			schema:    testSchemaBlock,
+			data:      testTimeoutsValue(tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+			operation: resourceOperationCreate,
+			expected:  20 * time.Minute,
+		},
+		"attribute-configured": {
+			resource:  testResourceWithTimeouts,
+			schema:    testSchemaAttribute,
+			data:      testTimeoutsValue(tftypes.NewValue(tftypes.String, "45s")),
+			operation: resourceOperationCreate,
+			expected:  45 * time.Second,
+		},
+		"block-configured": {
+			resource:  testResourceWithTimeouts,
+			schema:    testSchemaBlock,
+			data:      testTimeoutsValue(tftypes.NewValue(tftypes.String, "45s")),
+			operation: resourceOperationCreate,
+			expected:  45 * time.Second,
+		},
+		"block-configured-no-defaults": {
+			resource:  &testprovider.Resource{},
+			schema:    testSchemaBlock,
+			data:      testTimeoutsValue(tftypes.NewValue(tftypes.String, nil)),
+			operation: resourceOperationUpdate,
+			expected:  2 * time.Hour,
+		},
+		"block-configured-invalid": {
+			resource:  testResourceWithTimeouts,
+			schema:    testSchemaBlock,
+			data:      testTimeoutsValue(tftypes.NewValue(tftypes.String, "1 hour")),
+			operation: resourceOperationCreate,
+			expected:  0,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("timeouts").AtName("create"),
+					"Invalid Resource Timeout",
+					`The create timeout "1 hour" is not a valid duration: time: unknown unit " hour" in duration "1 hour"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := resourceTimeout(context.Background(), testCase.resource, testCase.schema, testCase.data, testCase.operation)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected timeout %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestResourceTimeoutDiags(t *testing.T) {
+	t.Parallel()
+
+	testErrorDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic("Error Creating Widget", "test error"),
+	}
+
+	testCases := map[string]struct {
+		ctx            func() (context.Context, context.CancelFunc)
+		timeout        time.Duration
+		operationDiags diag.Diagnostics
+		expected       diag.Diagnostics
+	}{
+		"deadline-exceeded-error": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := contextWithResourceTimeout(context.Background(), time.Nanosecond)
+
+				<-ctx.Done()
+
+				return ctx, cancel
+			},
+			timeout:        time.Nanosecond,
+			operationDiags: testErrorDiags,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Operation Timed Out",
+					"The resource create operation did not complete within the timeout of 1ns and returned errors after its context deadline was exceeded (context deadline exceeded). "+
+						"The operation may still be in progress in the remote system. "+
+						`If the resource supports a "timeouts" configuration, increase the "create" timeout and try again.`,
+				),
+			},
+		},
+		"deadline-exceeded-success": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := contextWithResourceTimeout(context.Background(), time.Nanosecond)
+
+				<-ctx.Done()
+
+				return ctx, cancel
+			},
+			timeout: time.Nanosecond,
+			operationDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Test Warning", "test warning"),
+			},
+			expected: nil,
+		},
+		"deadline-not-exceeded-error": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				return contextWithResourceTimeout(context.Background(), time.Hour)
+			},
+			timeout:        time.Hour,
+			operationDiags: testErrorDiags,
+			expected:       nil,
+		},
+		"canceled-error": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := contextWithResourceTimeout(context.Background(), time.Hour)
+
+				cancel()
+
+				return ctx, cancel
+			},
+			timeout:        time.Hour,
+			operationDiags: testErrorDiags,
+			expected:       nil,
+		},
+		"no-timeout-error": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				return contextWithResourceTimeout(context.Background(), 0)
+			},
+			timeout:        0,
+			operationDiags: testErrorDiags,
+			expected:       nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := testCase.ctx()
+			defer cancel()
+
+			got := resourceTimeoutDiags(ctx, resourceOperationCreate, testCase.timeout, testCase.operationDiags)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package fwserver
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -15,8 +18,14 @@ import (
 // The extra Schema parameter is a carry-over of creating the proto6server
 // package from the tfsdk package and not wanting to export the method.
 // Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/215
-func SchemaBlockAtPath(s tfsdk.Schema, path *tftypes.AttributePath) (tfsdk.Block, error) {
-	res, remaining, err := tftypes.WalkAttributePath(s, path)
+func SchemaBlockAtPath(ctx context.Context, s tfsdk.Schema, path path.Path) (tfsdk.Block, error) {
+	tftypesPath, err := totftypes.AttributePath(ctx, path)
+
+	if err != nil {
+		return tfsdk.Block{}, err
+	}
+
+	res, remaining, err := tftypes.WalkAttributePath(s, tftypesPath)
 	if err != nil {
 		return tfsdk.Block{}, fmt.Errorf("%v still remains in the path: %w", remaining, err)
 	}
@@ -0,0 +1,179 @@
+package fwserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ApplySchemaDefaultsRequest represents a request for a schema to set all
+// attribute default values in the plan.
+type ApplySchemaDefaultsRequest struct {
+	// Config is the configuration the user supplied for the resource.
+	Config tfsdk.Config
+
+	// Plan is the planned new state for the resource.
+	Plan tfsdk.Plan
+
+	// ProviderMeta is metadata from the provider_meta block of the module.
+	ProviderMeta tfsdk.Config
+
+	// ProviderData is the ResourceData from the ConfigureProvider response.
+	ProviderData interface{}
+}
+
+// ApplySchemaDefaultsResponse represents a response to an
+// ApplySchemaDefaultsRequest.
+type ApplySchemaDefaultsResponse struct {
+	// Plan is the planned new state for the resource, with default values
+	// set for attributes that are null in the configuration.
+	Plan tfsdk.Plan
+
+	// Diagnostics report errors or warnings related to determining the
+	// attribute default values. Returning an empty slice indicates a
+	// successful operation with no warnings or errors generated.
+	Diagnostics diag.Diagnostics
+}
+
+// SchemaApplyDefaults sets the Default value of every Attribute, including
+// those nested in Attributes and Blocks, which is null in the configuration.
+//
+// TODO: Clean up this abstraction back into an internal Schema type method.
+// The extra Schema parameter is a carry-over of creating the proto6server
+// package from the tfsdk package and not wanting to export the method.
+// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/215
+func SchemaApplyDefaults(ctx context.Context, s tfsdk.Schema, req ApplySchemaDefaultsRequest, resp *ApplySchemaDefaultsResponse) {
+	resp.Plan = req.Plan
+
+	if req.Plan.Raw.IsNull() || !req.Plan.Raw.IsKnown() {
+		return
+	}
+
+	modifiedPlan, err := tftypes.Transform(req.Plan.Raw, func(tfTypePath *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
+		// we are only modifying attributes, not the entire resource
+		if len(tfTypePath.Steps()) < 1 {
+			return val, nil
+		}
+
+		attrPath, err := fromtftypes.AttributePath(ctx, tfTypePath)
+
+		if err != nil {
+			return val, err
+		}
+
+		ctx := logging.FrameworkWithAttributePath(ctx, attrPath.String())
+
+		attribute, err := s.AttributeAtPath(ctx, attrPath)
+
+		if err != nil {
+			if errors.Is(err, tfsdk.ErrPathInsideAtomicAttribute) || errors.Is(err, tfsdk.ErrPathIsBlock) {
+				// ignore blocks and elements inside schema.Attributes, they
+				// have no defaults of their own
+				return val, nil
+			}
+
+			return val, fmt.Errorf("couldn't find attribute in resource schema: %w", err)
+		}
+
+		if attribute.Default == nil {
+			return val, nil
+		}
+
+		configVal, _, err := tftypes.WalkAttributePath(req.Config.Raw, tfTypePath)
+
+		if err != nil {
+			if errors.Is(err, tftypes.ErrInvalidStep) {
+				// The plan value cannot be correlated with the configuration,
+				// such as a changed set element, so there is no way to know
+				// whether the attribute was configured.
+				logging.FrameworkTrace(ctx, "attribute not found in config, not setting default value")
+				return val, nil
+			}
+
+			return val, err
+		}
+
+		if !configVal.(tftypes.Value).IsNull() {
+			logging.FrameworkTrace(ctx, "attribute not null in config, not setting default value")
+			return val, nil
+		}
+
+		defaultReq := tfsdk.AttributeDefaultRequest{
+			AttributePath: attrPath,
+			Config:        req.Config,
+			ProviderMeta:  req.ProviderMeta,
+			ProviderData:  req.ProviderData,
+		}
+		defaultResp := &tfsdk.AttributeDefaultResponse{}
+
+		callProviderDefined(ctx, &defaultResp.Diagnostics, "AttributeDefault", attrPath, func() {
+			description := attribute.Default.Description(ctx)
+
+			logging.FrameworkDebug(
+				ctx,
+				"Calling provider defined AttributeDefault",
+				map[string]interface{}{
+					logging.KeyDescription: description,
+				},
+			)
+			attribute.Default.DefaultValue(ctx, defaultReq, defaultResp)
+			logging.FrameworkDebug(
+				ctx,
+				"Called provider defined AttributeDefault",
+				map[string]interface{}{
+					logging.KeyDescription: description,
+				},
+			)
+		})
+
+		resp.Diagnostics.Append(defaultResp.Diagnostics...)
+
+		if defaultResp.Diagnostics.HasError() || defaultResp.Value == nil {
+			return val, nil
+		}
+
+		defaultVal, err := defaultResp.Value.ToTerraformValue(ctx)
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				attrPath,
+				"Attribute Default Error",
+				"An unexpected error was encountered converting the attribute default value. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+
+			return val, nil
+		}
+
+		if !defaultVal.Type().Equal(val.Type()) {
+			resp.Diagnostics.AddAttributeError(
+				attrPath,
+				"Attribute Default Error",
+				"An unexpected error was encountered setting the attribute default value. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Default value type %s does not match attribute type %s.", defaultVal.Type(), val.Type()),
+			)
+
+			return val, nil
+		}
+
+		logging.FrameworkDebug(ctx, "setting default value for attribute that is null in the config")
+
+		return defaultVal, nil
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error modifying plan",
+			"There was an unexpected error setting attribute default values in the plan. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Plan.Raw = modifiedPlan
+}
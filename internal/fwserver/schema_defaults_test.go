@@ -0,0 +1,304 @@
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaApplyDefaults(t *testing.T) {
+	t.Parallel()
+
+	testSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"string": {
+				Type:     types.StringType,
+				Optional: true,
+				Default:  tfsdk.StaticDefault(types.String{Value: "default"}),
+			},
+			"nested": {
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"int64": {
+						Type:     types.Int64Type,
+						Optional: true,
+						Default:  tfsdk.StaticDefault(types.Int64{Value: 123}),
+					},
+				}),
+				Optional: true,
+			},
+		},
+	}
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"int64": tftypes.Number,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":     tftypes.String,
+			"string": tftypes.String,
+			"nested": testNestedType,
+		},
+	}
+
+	testValue := func(str, nestedInt64 interface{}) tftypes.Value {
+		nested := tftypes.NewValue(testNestedType, nil)
+
+		if nestedInt64 != nil {
+			nested = tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+				"int64": tftypes.NewValue(tftypes.Number, nestedInt64),
+			})
+		}
+
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"id":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"string": tftypes.NewValue(tftypes.String, str),
+			"nested": nested,
+		})
+	}
+
+	testCases := map[string]struct {
+		schema   tfsdk.Schema
+		req      ApplySchemaDefaultsRequest
+		expected ApplySchemaDefaultsResponse
+	}{
+		"null-plan": {
+			schema: testSchema,
+			req: ApplySchemaDefaultsRequest{
+				Config: tfsdk.Config{
+					Raw:    tftypes.NewValue(testType, nil),
+					Schema: testSchema,
+				},
+				Plan: tfsdk.Plan{
+					Raw:    tftypes.NewValue(testType, nil),
+					Schema: testSchema,
+				},
+			},
+			expected: ApplySchemaDefaultsResponse{
+				Plan: tfsdk.Plan{
+					Raw:    tftypes.NewValue(testType, nil),
+					Schema: testSchema,
+				},
+			},
+		},
+		"config-null": {
+			schema: testSchema,
+			req: ApplySchemaDefaultsRequest{
+				Config: tfsdk.Config{
+					Raw:    testValue(nil, nil),
+					Schema: testSchema,
+				},
+				Plan: tfsdk.Plan{
+					Raw:    testValue(nil, nil),
+					Schema: testSchema,
+				},
+			},
+			expected: ApplySchemaDefaultsResponse{
+				Plan: tfsdk.Plan{
+					Raw:    testValue("default", nil),
+					Schema: testSchema,
+				},
+			},
+		},
+		"config-set": {
+			schema: testSchema,
+			req: ApplySchemaDefaultsRequest{
+				Config: tfsdk.Config{
+					Raw:    testValue("configured", 456),
+					Schema: testSchema,
+				},
+				Plan: tfsdk.Plan{
+					Raw:    testValue("configured", 456),
+					Schema: testSchema,
+				},
+			},
+			expected: ApplySchemaDefaultsResponse{
+				Plan: tfsdk.Plan{
+					Raw:    testValue("configured", 456),
+					Schema: testSchema,
+				},
+			},
+		},
+		"nested-attribute-config-null": {
+			schema: testSchema,
+			req: ApplySchemaDefaultsRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"id":     tftypes.NewValue(tftypes.String, nil),
+						"string": tftypes.NewValue(tftypes.String, "configured"),
+						"nested": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+							"int64": tftypes.NewValue(tftypes.Number, nil),
+						}),
+					}),
+					Schema: testSchema,
+				},
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"id":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"string": tftypes.NewValue(tftypes.String, "configured"),
+						"nested": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+							"int64": tftypes.NewValue(tftypes.Number, nil),
+						}),
+					}),
+					Schema: testSchema,
+				},
+			},
+			expected: ApplySchemaDefaultsResponse{
+				Plan: tfsdk.Plan{
+					Raw:    testValue("configured", 123),
+					Schema: testSchema,
+				},
+			},
+		},
+		"default-func-type-mismatch": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"string": {
+						Type:     types.StringType,
+						Optional: true,
+						Default: tfsdk.DefaultFunc(
+							func(_ context.Context, _ tfsdk.AttributeDefaultRequest, resp *tfsdk.AttributeDefaultResponse) {
+								resp.Value = types.Bool{Value: true}
+							},
+							"", "",
+						),
+					},
+				},
+			},
+			req: ApplySchemaDefaultsRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"string": tftypes.String}}, map[string]tftypes.Value{
+						"string": tftypes.NewValue(tftypes.String, nil),
+					}),
+				},
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"string": tftypes.String}}, map[string]tftypes.Value{
+						"string": tftypes.NewValue(tftypes.String, nil),
+					}),
+				},
+			},
+			expected: ApplySchemaDefaultsResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("string"),
+						"Attribute Default Error",
+						"An unexpected error was encountered setting the attribute default value. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+
+							"Default value type tftypes.Bool does not match attribute type tftypes.String.",
+					),
+				},
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"string": tftypes.String}}, map[string]tftypes.Value{
+						"string": tftypes.NewValue(tftypes.String, nil),
+					}),
+				},
+			},
+		},
+		"default-func-diagnostics": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"string": {
+						Type:     types.StringType,
+						Optional: true,
+						Default: tfsdk.DefaultFunc(
+							func(_ context.Context, req tfsdk.AttributeDefaultRequest, resp *tfsdk.AttributeDefaultResponse) {
+								resp.Diagnostics.AddAttributeError(req.AttributePath, "Default Failed", "Could not determine default.")
+							},
+							"", "",
+						),
+					},
+				},
+			},
+			req: ApplySchemaDefaultsRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"string": tftypes.String}}, map[string]tftypes.Value{
+						"string": tftypes.NewValue(tftypes.String, nil),
+					}),
+				},
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"string": tftypes.String}}, map[string]tftypes.Value{
+						"string": tftypes.NewValue(tftypes.String, nil),
+					}),
+				},
+			},
+			expected: ApplySchemaDefaultsResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("string"),
+						"Default Failed",
+						"Could not determine default.",
+					),
+				},
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"string": tftypes.String}}, map[string]tftypes.Value{
+						"string": tftypes.NewValue(tftypes.String, nil),
+					}),
+				},
+			},
+		},
+		"default-func-panic": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"string": {
+						Type:     types.StringType,
+						Optional: true,
+						Default: tfsdk.DefaultFunc(
+							func(_ context.Context, _ tfsdk.AttributeDefaultRequest, resp *tfsdk.AttributeDefaultResponse) {
+								panic("test panic")
+							},
+							"", "",
+						),
+					},
+				},
+			},
+			req: ApplySchemaDefaultsRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"string": tftypes.String}}, map[string]tftypes.Value{
+						"string": tftypes.NewValue(tftypes.String, nil),
+					}),
+				},
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"string": tftypes.String}}, map[string]tftypes.Value{
+						"string": tftypes.NewValue(tftypes.String, nil),
+					}),
+				},
+			},
+			expected: ApplySchemaDefaultsResponse{
+				Diagnostics: diag.Diagnostics{
+					testPanicDiagnostic(path.Root("string"), "AttributeDefault"),
+				},
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"string": tftypes.String}}, map[string]tftypes.Value{
+						"string": tftypes.NewValue(tftypes.String, nil),
+					}),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := &ApplySchemaDefaultsResponse{}
+			SchemaApplyDefaults(context.Background(), tc.schema, tc.req, got)
+
+			if diff := cmp.Diff(*got, tc.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,372 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	// validSchemaNameRegex matches the attribute and block names Terraform
+	// supports in configuration: lowercase alphanumeric characters and
+	// underscores, not beginning with a number.
+	validSchemaNameRegex = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+	// dataSourceReservedRootNames are the root attribute and block names
+	// Terraform reserves for data source configuration meta-arguments.
+	dataSourceReservedRootNames = []string{
+		"count",
+		"depends_on",
+		"for_each",
+		"lifecycle",
+		"provider",
+	}
+
+	// providerReservedRootNames are the root attribute and block names
+	// Terraform reserves for provider configuration meta-arguments.
+	providerReservedRootNames = []string{
+		"alias",
+		"version",
+	}
+
+	// resourceReservedRootNames are the root attribute and block names
+	// Terraform reserves for resource configuration meta-arguments.
+	resourceReservedRootNames = []string{
+		"connection",
+		"count",
+		"depends_on",
+		"for_each",
+		"lifecycle",
+		"provider",
+		"provisioner",
+	}
+)
+
+// ValidateSchemaImplementationRequest represents a request to check the
+// definition of a schema for issues introduced by the provider developer.
+type ValidateSchemaImplementationRequest struct {
+	// Description is a human-readable description of what the schema
+	// belongs to, such as `resource type "examplecloud_thing"`. It is
+	// included in diagnostics to help locate the issue.
+	Description string
+
+	// ReservedRootNames are the root attribute and block names which
+	// cannot be used, as Terraform reserves them for meta-arguments.
+	ReservedRootNames []string
+}
+
+// SchemaValidateImplementation returns diagnostics for any issues with the
+// definition of the Schema, including nested Attributes and Blocks. These
+// are always issues with the provider, such as an invalid attribute name,
+// or an attribute with both Required and Computed set, which would otherwise
+// only be found later or by Terraform with less helpful messages.
+//
+// TODO: Clean up this abstraction back into an internal Schema type method.
+// The extra Schema parameter is a carry-over of creating the proto6server
+// package from the tfsdk package and not wanting to export the method.
+// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/215
+func SchemaValidateImplementation(ctx context.Context, s tfsdk.Schema, req ValidateSchemaImplementationRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, name := range sortedAttributeNames(s.Attributes) {
+		attrPath := path.Root(name)
+
+		for _, reservedName := range req.ReservedRootNames {
+			if name == reservedName {
+				diags.Append(schemaImplementationErrorDiag(req, "Attribute", attrPath,
+					fmt.Sprintf("The attribute name is reserved by Terraform for the %q meta-argument and cannot be used.", name)),
+				)
+			}
+		}
+
+		diags.Append(attributeValidateImplementation(ctx, s.Attributes[name], attrPath, req)...)
+	}
+
+	for _, name := range sortedBlockNames(s.Blocks) {
+		blockPath := path.Root(name)
+
+		for _, reservedName := range req.ReservedRootNames {
+			if name == reservedName {
+				diags.Append(schemaImplementationErrorDiag(req, "Block", blockPath,
+					fmt.Sprintf("The block name is reserved by Terraform for the %q meta-argument and cannot be used.", name)),
+				)
+			}
+		}
+
+		if _, ok := s.Attributes[name]; ok {
+			diags.Append(schemaImplementationErrorDiag(req, "Block", blockPath,
+				"An attribute with the same name is also defined. Attribute and block names must be unique."),
+			)
+		}
+
+		diags.Append(blockValidateImplementation(ctx, s.Blocks[name], blockPath, req)...)
+	}
+
+	return diags
+}
+
+// attributeValidateImplementation returns diagnostics for any issues with
+// the definition of the Attribute and its nested Attributes.
+func attributeValidateImplementation(ctx context.Context, a tfsdk.Attribute, attrPath path.Path, req ValidateSchemaImplementationRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !validSchemaNameRegex.MatchString(schemaPathName(attrPath)) {
+		diags.Append(schemaImplementationErrorDiag(req, "Attribute", attrPath,
+			"Names must only contain lowercase alphanumeric characters (a-z, 0-9) and underscores (_), and cannot begin with a number."),
+		)
+	}
+
+	if a.Type != nil && a.Attributes != nil {
+		diags.Append(schemaImplementationErrorDiag(req, "Attribute", attrPath,
+			"Type and Attributes cannot both be set."),
+		)
+	}
+
+	if a.Type == nil && a.Attributes == nil {
+		diags.Append(schemaImplementationErrorDiag(req, "Attribute", attrPath,
+			"One of Type or Attributes must be set."),
+		)
+	}
+
+	if !a.Required && !a.Optional && !a.Computed {
+		diags.Append(schemaImplementationErrorDiag(req, "Attribute", attrPath,
+			"One of Required, Optional, or Computed must be set."),
+		)
+	}
+
+	if a.Required && a.Optional {
+		diags.Append(schemaImplementationErrorDiag(req, "Attribute", attrPath,
+			"Required and Optional cannot both be set."),
+		)
+	}
+
+	if a.Required && a.Computed {
+		diags.Append(schemaImplementationErrorDiag(req, "Attribute", attrPath,
+			"Required and Computed cannot both be set."),
+		)
+	}
+
+	if a.Required && a.Default != nil {
+		diags.Append(schemaImplementationErrorDiag(req, "Attribute", attrPath,
+			"Required and Default cannot both be set, as the attribute must always be configured."),
+		)
+	}
+
+	// DefaultFunc values can only be verified when called during planning.
+	if staticDefault, ok := a.Default.(tfsdk.StaticDefaultValue); ok && staticDefault.Value != nil {
+		if attributeType := attributeType(a); attributeType != nil {
+			if defaultType := staticDefault.Value.Type(ctx); !attributeType.Equal(defaultType) {
+				diags.Append(schemaImplementationErrorDiag(req, "Attribute", attrPath,
+					fmt.Sprintf("Default value type %s does not match attribute type %s.", defaultType, attributeType)),
+				)
+			}
+		}
+	}
+
+	if !a.Computed && a.Default == nil {
+		for _, planModifier := range a.PlanModifiers {
+			switch planModifier.(type) {
+			case tfsdk.RequiresReplaceModifier, tfsdk.RequiresReplaceIfModifier:
+				// Only marking the resource for replacement does not modify
+				// the planned value, so Computed is not necessary.
+				continue
+			}
+
+			diags.Append(schemaImplementationErrorDiag(req, "Attribute", attrPath,
+				fmt.Sprintf("PlanModifiers other than RequiresReplace require Computed to be set, as Terraform will return an error if %T modifies the planned value.", planModifier)),
+			)
+
+			break
+		}
+	}
+
+	if len(a.ElementValidators) > 0 && !attributeTypeIs(ctx, a, tftypes.List{}, tftypes.Set{}, tftypes.Map{}) {
+		diags.Append(schemaImplementationErrorDiag(req, "Attribute", attrPath,
+			"ElementValidators require Type to be a list, set, or map type."),
+		)
+	}
+
+	if len(a.KeyValidators) > 0 && !attributeTypeIs(ctx, a, tftypes.Map{}) {
+		diags.Append(schemaImplementationErrorDiag(req, "Attribute", attrPath,
+			"KeyValidators require Type to be a map type."),
+		)
+	}
+
+	if a.Attributes == nil {
+		return diags
+	}
+
+	switch nm := a.Attributes.GetNestingMode(); nm {
+	case tfsdk.NestingModeSingle, tfsdk.NestingModeList, tfsdk.NestingModeSet, tfsdk.NestingModeMap:
+	default:
+		diags.Append(schemaImplementationErrorDiag(req, "Attribute", attrPath,
+			fmt.Sprintf("Unrecognized nesting mode %v.", nm)),
+		)
+	}
+
+	if a.Attributes.GetMinItems() < 0 || a.Attributes.GetMaxItems() < 0 {
+		diags.Append(schemaImplementationErrorDiag(req, "Attribute", attrPath,
+			"MinItems and MaxItems cannot be negative."),
+		)
+	}
+
+	if a.Attributes.GetMaxItems() > 0 && a.Attributes.GetMinItems() > a.Attributes.GetMaxItems() {
+		diags.Append(schemaImplementationErrorDiag(req, "Attribute", attrPath,
+			fmt.Sprintf("MinItems (%d) cannot be greater than MaxItems (%d).", a.Attributes.GetMinItems(), a.Attributes.GetMaxItems())),
+		)
+	}
+
+	nestedAttributes := a.Attributes.GetAttributes()
+
+	for _, name := range sortedAttributeNames(nestedAttributes) {
+		diags.Append(attributeValidateImplementation(ctx, nestedAttributes[name], attrPath.AtName(name), req)...)
+	}
+
+	return diags
+}
+
+// attributeType returns the Attribute Type, or the type of its nested
+// Attributes, if either is set.
+func attributeType(a tfsdk.Attribute) attr.Type {
+	if a.Attributes != nil && len(a.Attributes.GetAttributes()) > 0 {
+		return a.Attributes.AttributeType()
+	}
+
+	return a.Type
+}
+
+// attributeTypeIs returns true if the Attribute Type is set and its
+// Terraform type is any of the given types.
+func attributeTypeIs(ctx context.Context, a tfsdk.Attribute, tfTypes ...tftypes.Type) bool {
+	if a.Type == nil {
+		return false
+	}
+
+	for _, tfType := range tfTypes {
+		if a.Type.TerraformType(ctx).Is(tfType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// blockValidateImplementation returns diagnostics for any issues with the
+// definition of the Block and its nested Attributes and Blocks.
+func blockValidateImplementation(ctx context.Context, b tfsdk.Block, blockPath path.Path, req ValidateSchemaImplementationRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !validSchemaNameRegex.MatchString(schemaPathName(blockPath)) {
+		diags.Append(schemaImplementationErrorDiag(req, "Block", blockPath,
+			"Names must only contain lowercase alphanumeric characters (a-z, 0-9) and underscores (_), and cannot begin with a number."),
+		)
+	}
+
+	switch b.NestingMode {
+	case tfsdk.BlockNestingModeList, tfsdk.BlockNestingModeSet:
+	case tfsdk.BlockNestingModeSingle:
+		if b.MinItems != b.MaxItems || b.MinItems > 1 {
+			diags.Append(schemaImplementationErrorDiag(req, "Block", blockPath,
+				fmt.Sprintf("MinItems and MaxItems must both be 0 or both be 1 for nesting mode %v.", b.NestingMode)),
+			)
+		}
+	default:
+		diags.Append(schemaImplementationErrorDiag(req, "Block", blockPath,
+			fmt.Sprintf("Unrecognized nesting mode %v.", b.NestingMode)),
+		)
+	}
+
+	if b.MinItems < 0 || b.MaxItems < 0 {
+		diags.Append(schemaImplementationErrorDiag(req, "Block", blockPath,
+			"MinItems and MaxItems cannot be negative."),
+		)
+	}
+
+	if b.MaxItems > 0 && b.MinItems > b.MaxItems {
+		diags.Append(schemaImplementationErrorDiag(req, "Block", blockPath,
+			fmt.Sprintf("MinItems (%d) cannot be greater than MaxItems (%d).", b.MinItems, b.MaxItems)),
+		)
+	}
+
+	for _, name := range sortedAttributeNames(b.Attributes) {
+		diags.Append(attributeValidateImplementation(ctx, b.Attributes[name], blockPath.AtName(name), req)...)
+	}
+
+	for _, name := range sortedBlockNames(b.Blocks) {
+		nestedBlockPath := blockPath.AtName(name)
+
+		if _, ok := b.Attributes[name]; ok {
+			diags.Append(schemaImplementationErrorDiag(req, "Block", nestedBlockPath,
+				"An attribute with the same name is also defined. Attribute and block names must be unique."),
+			)
+		}
+
+		diags.Append(blockValidateImplementation(ctx, b.Blocks[name], nestedBlockPath, req)...)
+	}
+
+	return diags
+}
+
+// schemaImplementationErrorDiag returns an error diagnostic for an issue
+// with the definition of the Attribute or Block at the given path.
+func schemaImplementationErrorDiag(req ValidateSchemaImplementationRequest, kind string, p path.Path, issue string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Schema Implementation",
+		schemaImplementationDetail(req, kind, p, issue),
+	)
+}
+
+func schemaImplementationDetail(req ValidateSchemaImplementationRequest, kind string, p path.Path, issue string) string {
+	return fmt.Sprintf("When validating the %s schema, an implementation issue was found. ", req.Description) +
+		"This is always an issue with the provider and should be reported to the provider developers.\n\n" +
+		fmt.Sprintf("%s %q: %s", kind, p, issue)
+}
+
+// schemaPathName returns the name of the last attribute name step in a
+// schema path.
+func schemaPathName(p path.Path) string {
+	lastStep, _ := p.Steps().LastStep()
+
+	name, ok := lastStep.(path.PathStepAttributeName)
+
+	if !ok {
+		return ""
+	}
+
+	return string(name)
+}
+
+// sortedAttributeNames returns the names of the attributes in a consistent
+// order, so diagnostics are deterministic.
+func sortedAttributeNames(attributes map[string]tfsdk.Attribute) []string {
+	names := make([]string, 0, len(attributes))
+
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// sortedBlockNames returns the names of the blocks in a consistent order, so
+// diagnostics are deterministic.
+func sortedBlockNames(blocks map[string]tfsdk.Block) []string {
+	names := make([]string, 0, len(blocks))
+
+	for name := range blocks {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,551 @@
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/planmodifiers"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaValidateImplementation(t *testing.T) {
+	t.Parallel()
+
+	testDetail := func(issue string) string {
+		return "When validating the resource type \"test_resource\" schema, an implementation issue was found. " +
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n" +
+			issue
+	}
+
+	testCases := map[string]struct {
+		schema        tfsdk.Schema
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			schema: tfsdk.Schema{},
+		},
+		"valid": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_computed": {
+						Computed: true,
+						Type:     types.StringType,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.UseStateForUnknown(),
+						},
+					},
+					"test_nested": {
+						Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+							"test_required": {
+								Required: true,
+								Type:     types.StringType,
+							},
+						}),
+						Optional: true,
+					},
+					"test_requires_replace": {
+						Required: true,
+						Type:     types.StringType,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+				},
+				Blocks: map[string]tfsdk.Block{
+					"test_block": {
+						Attributes: map[string]tfsdk.Attribute{
+							"test_optional": {
+								Optional: true,
+								Type:     types.StringType,
+							},
+						},
+						MaxItems:    2,
+						MinItems:    1,
+						NestingMode: tfsdk.BlockNestingModeList,
+					},
+				},
+			},
+		},
+		"attribute-name-invalid": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test-attribute": {
+						Optional: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Attribute \"test-attribute\": Names must only contain lowercase alphanumeric characters (a-z, 0-9) and underscores (_), and cannot begin with a number."),
+				),
+			},
+		},
+		"attribute-name-reserved": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"count": {
+						Optional: true,
+						Type:     types.NumberType,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Attribute \"count\": The attribute name is reserved by Terraform for the \"count\" meta-argument and cannot be used."),
+				),
+			},
+		},
+		"attribute-type-and-attributes": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_attribute": {
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"test_nested": {
+								Optional: true,
+								Type:     types.StringType,
+							},
+						}),
+						Optional: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Attribute \"test_attribute\": Type and Attributes cannot both be set."),
+				),
+			},
+		},
+		"attribute-type-missing": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_attribute": {
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Attribute \"test_attribute\": One of Type or Attributes must be set."),
+				),
+			},
+		},
+		"attribute-required-optional-computed-missing": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_attribute": {
+						Type: types.StringType,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Attribute \"test_attribute\": One of Required, Optional, or Computed must be set."),
+				),
+			},
+		},
+		"attribute-required-optional": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_attribute": {
+						Optional: true,
+						Required: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Attribute \"test_attribute\": Required and Optional cannot both be set."),
+				),
+			},
+		},
+		"attribute-required-computed": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_attribute": {
+						Computed: true,
+						Required: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Attribute \"test_attribute\": Required and Computed cannot both be set."),
+				),
+			},
+		},
+		"attribute-required-default": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_attribute": {
+						Default:  tfsdk.StaticDefault(types.String{Value: "test"}),
+						Required: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Attribute \"test_attribute\": Required and Default cannot both be set, as the attribute must always be configured."),
+				),
+			},
+		},
+		"attribute-default-type-mismatch": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_attribute": {
+						Default:  tfsdk.StaticDefault(types.Bool{Value: true}),
+						Optional: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Attribute \"test_attribute\": Default value type types.BoolType does not match attribute type types.StringType."),
+				),
+			},
+		},
+		"attribute-default-nested-attributes": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_attribute": {
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"test_nested": {
+								Optional: true,
+								Type:     types.StringType,
+							},
+						}),
+						Default: tfsdk.StaticDefault(types.Object{
+							AttrTypes: map[string]attr.Type{
+								"test_nested": types.StringType,
+							},
+							Attrs: map[string]attr.Value{
+								"test_nested": types.String{Value: "test"},
+							},
+						}),
+						Optional: true,
+					},
+				},
+			},
+		},
+		"attribute-default-func": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_attribute": {
+						Default: tfsdk.DefaultFunc(
+							func(_ context.Context, _ tfsdk.AttributeDefaultRequest, resp *tfsdk.AttributeDefaultResponse) {
+								resp.Value = types.Bool{Value: true}
+							},
+							"test description",
+							"test markdown description",
+						),
+						Optional: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+		},
+		"attribute-planmodifiers-not-computed": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_attribute": {
+						Optional: true,
+						Type:     types.StringType,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							planmodifiers.TestAttrDefaultValueModifier{},
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Attribute \"test_attribute\": PlanModifiers other than RequiresReplace require Computed to be set, as Terraform will return an error if planmodifiers.TestAttrDefaultValueModifier modifies the planned value."),
+				),
+			},
+		},
+		"attribute-elementvalidators-invalid-type": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_attribute": {
+						ElementValidators: []tfsdk.AttributeValidator{
+							testErrorAttributeValidator{},
+						},
+						Optional: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Attribute \"test_attribute\": ElementValidators require Type to be a list, set, or map type."),
+				),
+			},
+		},
+		"attribute-keyvalidators-invalid-type": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_attribute": {
+						KeyValidators: []tfsdk.AttributeValidator{
+							testErrorAttributeValidator{},
+						},
+						Optional: true,
+						Type:     types.ListType{ElemType: types.StringType},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Attribute \"test_attribute\": KeyValidators require Type to be a map type."),
+				),
+			},
+		},
+		"attribute-nested-invalid": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_attribute": {
+						Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+							"test_nested": {
+								Computed: true,
+								Required: true,
+								Type:     types.StringType,
+							},
+						}),
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Attribute \"test_attribute.test_nested\": Required and Computed cannot both be set."),
+				),
+			},
+		},
+		"attribute-nested-minitems-greater-than-maxitems": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_attribute": {
+						Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+							"test_nested": {
+								Optional: true,
+								Type:     types.StringType,
+							},
+						}, tfsdk.SetNestedAttributesOptions{
+							MaxItems: 1,
+							MinItems: 2,
+						}),
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Attribute \"test_attribute\": MinItems (2) cannot be greater than MaxItems (1)."),
+				),
+			},
+		},
+		"block-name-attribute-conflict": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_name": {
+						Optional: true,
+						Type:     types.StringType,
+					},
+				},
+				Blocks: map[string]tfsdk.Block{
+					"test_name": {
+						NestingMode: tfsdk.BlockNestingModeList,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Block \"test_name\": An attribute with the same name is also defined. Attribute and block names must be unique."),
+				),
+			},
+		},
+		"block-name-reserved": {
+			schema: tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"lifecycle": {
+						NestingMode: tfsdk.BlockNestingModeList,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Block \"lifecycle\": The block name is reserved by Terraform for the \"lifecycle\" meta-argument and cannot be used."),
+				),
+			},
+		},
+		"block-nestingmode-unknown": {
+			schema: tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"test_block": {},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Block \"test_block\": Unrecognized nesting mode 0."),
+				),
+			},
+		},
+		"block-nestingmode-single-maxitems": {
+			schema: tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"test_block": {
+						MaxItems:    2,
+						NestingMode: tfsdk.BlockNestingModeSingle,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Block \"test_block\": MinItems and MaxItems must both be 0 or both be 1 for nesting mode 3."),
+				),
+			},
+		},
+		"block-nestingmode-single-maxitems-mismatch": {
+			schema: tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"test_block": {
+						MaxItems:    1,
+						NestingMode: tfsdk.BlockNestingModeSingle,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Block \"test_block\": MinItems and MaxItems must both be 0 or both be 1 for nesting mode 3."),
+				),
+			},
+		},
+		"block-nestingmode-single-minitems-mismatch": {
+			schema: tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"test_block": {
+						MinItems:    1,
+						NestingMode: tfsdk.BlockNestingModeSingle,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Block \"test_block\": MinItems and MaxItems must both be 0 or both be 1 for nesting mode 3."),
+				),
+			},
+		},
+		"block-minitems-negative": {
+			schema: tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"test_block": {
+						MinItems:    -1,
+						NestingMode: tfsdk.BlockNestingModeSet,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Block \"test_block\": MinItems and MaxItems cannot be negative."),
+				),
+			},
+		},
+		"block-minitems-greater-than-maxitems": {
+			schema: tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"test_block": {
+						MaxItems:    1,
+						MinItems:    2,
+						NestingMode: tfsdk.BlockNestingModeList,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Block \"test_block\": MinItems (2) cannot be greater than MaxItems (1)."),
+				),
+			},
+		},
+		"block-nested-invalid": {
+			schema: tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"test_block": {
+						Attributes: map[string]tfsdk.Attribute{
+							"test_attribute": {
+								Type: types.StringType,
+							},
+						},
+						Blocks: map[string]tfsdk.Block{
+							"test_nested_block": {
+								Attributes: map[string]tfsdk.Attribute{
+									"TestAttribute": {
+										Optional: true,
+										Type:     types.StringType,
+									},
+								},
+								NestingMode: tfsdk.BlockNestingModeSet,
+							},
+						},
+						NestingMode: tfsdk.BlockNestingModeList,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Attribute \"test_block.test_attribute\": One of Required, Optional, or Computed must be set."),
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Implementation",
+					testDetail("Attribute \"test_block.test_nested_block.TestAttribute\": Names must only contain lowercase alphanumeric characters (a-z, 0-9) and underscores (_), and cannot begin with a number."),
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := SchemaValidateImplementation(context.Background(), tc.schema, ValidateSchemaImplementationRequest{
+				Description:       "resource type \"test_resource\"",
+				ReservedRootNames: resourceReservedRootNames,
+			})
+
+			if diff := cmp.Diff(got, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
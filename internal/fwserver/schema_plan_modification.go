@@ -4,8 +4,8 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // ModifySchemaPlanRequest represents a request for a schema to run all
@@ -22,6 +22,9 @@ type ModifySchemaPlanRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta tfsdk.Config
+
+	// ProviderData is the ResourceData from the ConfigureProvider response.
+	ProviderData interface{}
 }
 
 // ModifySchemaPlanResponse represents a response to a ModifySchemaPlanRequest.
@@ -29,11 +32,11 @@ type ModifySchemaPlanResponse struct {
 	// Plan is the planned new state for the resource.
 	Plan tfsdk.Plan
 
-	// RequiresReplace is a list of tftypes.AttributePaths that require the
+	// RequiresReplace is a list of attribute paths that require the
 	// resource to be replaced. They should point to the specific field
 	// that changed that requires the resource to be destroyed and
 	// recreated.
-	RequiresReplace []*tftypes.AttributePath
+	RequiresReplace path.Paths
 
 	// Diagnostics report errors or warnings related to running all attribute
 	// plan modifiers. Returning an empty slice indicates a successful
@@ -51,11 +54,12 @@ type ModifySchemaPlanResponse struct {
 func SchemaModifyPlan(ctx context.Context, s tfsdk.Schema, req ModifySchemaPlanRequest, resp *ModifySchemaPlanResponse) {
 	for name, attr := range s.Attributes {
 		attrReq := tfsdk.ModifyAttributePlanRequest{
-			AttributePath: tftypes.NewAttributePath().WithAttributeName(name),
+			AttributePath: path.Root(name),
 			Config:        req.Config,
 			State:         req.State,
 			Plan:          req.Plan,
 			ProviderMeta:  req.ProviderMeta,
+			ProviderData:  req.ProviderData,
 		}
 
 		AttributeModifyPlan(ctx, attr, attrReq, resp)
@@ -63,11 +67,12 @@ func SchemaModifyPlan(ctx context.Context, s tfsdk.Schema, req ModifySchemaPlanR
 
 	for name, block := range s.Blocks {
 		blockReq := tfsdk.ModifyAttributePlanRequest{
-			AttributePath: tftypes.NewAttributePath().WithAttributeName(name),
+			AttributePath: path.Root(name),
 			Config:        req.Config,
 			State:         req.State,
 			Plan:          req.Plan,
 			ProviderMeta:  req.ProviderMeta,
+			ProviderData:  req.ProviderData,
 		}
 
 		BlockModifyPlan(ctx, block, blockReq, resp)
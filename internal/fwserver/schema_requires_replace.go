@@ -0,0 +1,98 @@
+package fwserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// schemaRequiresReplaceModifiers returns a copy of the schema where all
+// attribute and block PlanModifiers, other than RequiresReplace and
+// RequiresReplaceIf, are removed. Those plan modifiers only compare the
+// configuration, plan, and state, so they can be run to determine whether
+// the resource requires replacement while other provider defined plan
+// modification logic cannot be called, such as when the provider
+// configuration is deferred.
+func schemaRequiresReplaceModifiers(s tfsdk.Schema) tfsdk.Schema {
+	s.Attributes = attributesRequiresReplaceModifiers(s.Attributes)
+	s.Blocks = blocksRequiresReplaceModifiers(s.Blocks)
+
+	return s
+}
+
+// attributesRequiresReplaceModifiers returns a copy of the attributes with
+// only RequiresReplace and RequiresReplaceIf PlanModifiers, including any
+// nested attributes.
+func attributesRequiresReplaceModifiers(attributes map[string]tfsdk.Attribute) map[string]tfsdk.Attribute {
+	if attributes == nil {
+		return nil
+	}
+
+	result := make(map[string]tfsdk.Attribute, len(attributes))
+
+	for name, a := range attributes {
+		a.PlanModifiers = requiresReplaceModifiers(a.PlanModifiers)
+
+		if a.Attributes != nil {
+			nested := attributesRequiresReplaceModifiers(a.Attributes.GetAttributes())
+
+			switch a.Attributes.GetNestingMode() {
+			case tfsdk.NestingModeSingle:
+				a.Attributes = tfsdk.SingleNestedAttributes(nested)
+			case tfsdk.NestingModeList:
+				a.Attributes = tfsdk.ListNestedAttributes(nested, tfsdk.ListNestedAttributesOptions{
+					MinItems: a.Attributes.GetMinItems(),
+					MaxItems: a.Attributes.GetMaxItems(),
+				})
+			case tfsdk.NestingModeSet:
+				a.Attributes = tfsdk.SetNestedAttributes(nested, tfsdk.SetNestedAttributesOptions{
+					MinItems: a.Attributes.GetMinItems(),
+					MaxItems: a.Attributes.GetMaxItems(),
+				})
+			case tfsdk.NestingModeMap:
+				a.Attributes = tfsdk.MapNestedAttributes(nested, tfsdk.MapNestedAttributesOptions{
+					MinItems: a.Attributes.GetMinItems(),
+					MaxItems: a.Attributes.GetMaxItems(),
+				})
+			}
+		}
+
+		result[name] = a
+	}
+
+	return result
+}
+
+// blocksRequiresReplaceModifiers returns a copy of the blocks with only
+// RequiresReplace and RequiresReplaceIf PlanModifiers, including any nested
+// attributes and blocks.
+func blocksRequiresReplaceModifiers(blocks map[string]tfsdk.Block) map[string]tfsdk.Block {
+	if blocks == nil {
+		return nil
+	}
+
+	result := make(map[string]tfsdk.Block, len(blocks))
+
+	for name, b := range blocks {
+		b.PlanModifiers = requiresReplaceModifiers(b.PlanModifiers)
+		b.Attributes = attributesRequiresReplaceModifiers(b.Attributes)
+		b.Blocks = blocksRequiresReplaceModifiers(b.Blocks)
+
+		result[name] = b
+	}
+
+	return result
+}
+
+// requiresReplaceModifiers returns only the RequiresReplace and
+// RequiresReplaceIf plan modifiers.
+func requiresReplaceModifiers(planModifiers tfsdk.AttributePlanModifiers) tfsdk.AttributePlanModifiers {
+	var result tfsdk.AttributePlanModifiers
+
+	for _, planModifier := range planModifiers {
+		switch planModifier.(type) {
+		case tfsdk.RequiresReplaceModifier, tfsdk.RequiresReplaceIfModifier:
+			result = append(result, planModifier)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,154 @@
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/planmodifiers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaRequiresReplaceModifiers(t *testing.T) {
+	t.Parallel()
+
+	testRequiresReplaceIf := tfsdk.RequiresReplaceIf(
+		func(_ context.Context, _, _ attr.Value, _ path.Path) (bool, diag.Diagnostics) {
+			return true, nil
+		},
+		"test description",
+		"test description",
+	)
+
+	testPlanModifiers := func(requiresReplace bool) tfsdk.AttributePlanModifiers {
+		if !requiresReplace {
+			return tfsdk.AttributePlanModifiers{
+				planmodifiers.TestErrorDiagModifier{},
+				tfsdk.RequiresReplace(),
+				planmodifiers.TestWarningDiagModifier{},
+				testRequiresReplaceIf,
+			}
+		}
+
+		return tfsdk.AttributePlanModifiers{
+			tfsdk.RequiresReplace(),
+			testRequiresReplaceIf,
+		}
+	}
+
+	testSchema := func(requiresReplace bool) tfsdk.Schema {
+		return tfsdk.Schema{
+			Attributes: map[string]tfsdk.Attribute{
+				"test_attribute": {
+					Optional:      true,
+					Type:          types.StringType,
+					PlanModifiers: testPlanModifiers(requiresReplace),
+				},
+				"test_attribute_no_modifiers": {
+					Computed: true,
+					Type:     types.StringType,
+					PlanModifiers: tfsdk.AttributePlanModifiers{
+						planmodifiers.TestAttrPlanValueModifierOne{},
+					},
+				},
+				"test_nested_attributes": {
+					Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+						"test_nested_attribute": {
+							Optional:      true,
+							Type:          types.StringType,
+							PlanModifiers: testPlanModifiers(requiresReplace),
+						},
+					}, tfsdk.ListNestedAttributesOptions{
+						MaxItems: 2,
+					}),
+					Optional:      true,
+					PlanModifiers: testPlanModifiers(requiresReplace),
+				},
+			},
+			Blocks: map[string]tfsdk.Block{
+				"test_block": {
+					Attributes: map[string]tfsdk.Attribute{
+						"test_block_attribute": {
+							Optional:      true,
+							Type:          types.StringType,
+							PlanModifiers: testPlanModifiers(requiresReplace),
+						},
+					},
+					Blocks: map[string]tfsdk.Block{
+						"test_nested_block": {
+							Attributes: map[string]tfsdk.Attribute{
+								"test_nested_block_attribute": {
+									Optional:      true,
+									Type:          types.StringType,
+									PlanModifiers: testPlanModifiers(requiresReplace),
+								},
+							},
+							NestingMode:   tfsdk.BlockNestingModeList,
+							PlanModifiers: testPlanModifiers(requiresReplace),
+						},
+					},
+					NestingMode:   tfsdk.BlockNestingModeSet,
+					PlanModifiers: testPlanModifiers(requiresReplace),
+				},
+			},
+		}
+	}
+
+	got := testPlanModifierDescriptions(schemaRequiresReplaceModifiers(testSchema(false)))
+	expected := testPlanModifierDescriptions(testSchema(true))
+
+	// Attributes without RequiresReplace plan modifiers have none left.
+	expected["test_attribute_no_modifiers"] = nil
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+// testPlanModifierDescriptions returns the plan modifier descriptions of
+// all attributes and blocks in the schema, keyed by name. The schema,
+// attribute, and block Equal methods do not compare plan modifiers.
+func testPlanModifierDescriptions(s tfsdk.Schema) map[string][]string {
+	result := map[string][]string{}
+
+	descriptions := func(planModifiers tfsdk.AttributePlanModifiers) []string {
+		var result []string
+
+		for _, planModifier := range planModifiers {
+			result = append(result, planModifier.Description(context.Background()))
+		}
+
+		return result
+	}
+
+	var walkAttributes func(map[string]tfsdk.Attribute)
+	var walkBlocks func(map[string]tfsdk.Block)
+
+	walkAttributes = func(attributes map[string]tfsdk.Attribute) {
+		for name, a := range attributes {
+			result[name] = descriptions(a.PlanModifiers)
+
+			if a.Attributes != nil {
+				walkAttributes(a.Attributes.GetAttributes())
+			}
+		}
+	}
+
+	walkBlocks = func(blocks map[string]tfsdk.Block) {
+		for name, b := range blocks {
+			result[name] = descriptions(b.PlanModifiers)
+
+			walkAttributes(b.Attributes)
+			walkBlocks(b.Blocks)
+		}
+	}
+
+	walkAttributes(s.Attributes)
+	walkBlocks(s.Blocks)
+
+	return result
+}
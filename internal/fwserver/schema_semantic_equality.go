@@ -0,0 +1,205 @@
+package fwserver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SchemaSemanticEqualityRequest represents a request for a schema to keep
+// prior values which are semantically equal to new values.
+type SchemaSemanticEqualityRequest struct {
+	// PriorValue is the value to keep when semantically equal, such as the
+	// prior state during planning and reading or the planned state during
+	// applying.
+	PriorValue tftypes.Value
+
+	// NewValue is the value returned by the framework or provider, such as
+	// the proposed new state during planning or the new state after applying.
+	NewValue tftypes.Value
+
+	// ComputedAttributesOnly limits replacement to entire values of Computed
+	// attributes, skipping collection elements and attributes which are not
+	// Computed. This must be set during planning, since Terraform requires
+	// the planned value of other attributes to match the configuration.
+	ComputedAttributesOnly bool
+}
+
+// SchemaSemanticEqualityResponse represents a response to a
+// SchemaSemanticEqualityRequest.
+type SchemaSemanticEqualityResponse struct {
+	// NewValue is the new value with any semantically equal attribute values
+	// replaced by their prior value.
+	NewValue tftypes.Value
+
+	// Diagnostics report errors or warnings related to determining
+	// semantic equality. Returning an empty slice indicates a successful
+	// operation with no warnings or errors generated.
+	Diagnostics diag.Diagnostics
+}
+
+// SchemaSemanticEquality replaces every attribute value, including those
+// nested in Attributes, Blocks, and collection elements, with its prior
+// value when the new value implements attr.ValueWithSemanticEquals and is
+// semantically equal to the prior value. This prevents spurious plan
+// differences and inconsistent result errors for values which differ byte
+// for byte, but have the same meaning.
+//
+// Values which are null or unknown, in either the prior or new value, are
+// never replaced. Set elements cannot be correlated with a prior value
+// unless exactly equal, so only their nested attributes are checked. If
+// ComputedAttributesOnly is set, only the values of Computed attributes
+// are replaced.
+//
+// TODO: Clean up this abstraction back into an internal Schema type method.
+// The extra Schema parameter is a carry-over of creating the proto6server
+// package from the tfsdk package and not wanting to export the method.
+// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/215
+func SchemaSemanticEquality(ctx context.Context, s tfsdk.Schema, req SchemaSemanticEqualityRequest, resp *SchemaSemanticEqualityResponse) {
+	resp.NewValue = req.NewValue
+
+	if req.PriorValue.IsNull() || !req.PriorValue.IsKnown() || req.NewValue.IsNull() || !req.NewValue.IsKnown() {
+		return
+	}
+
+	if req.PriorValue.Equal(req.NewValue) {
+		return
+	}
+
+	newValue, err := tftypes.Transform(req.NewValue, func(tfTypePath *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
+		// the entire resource is not a custom type
+		if len(tfTypePath.Steps()) < 1 {
+			return val, nil
+		}
+
+		if val.IsNull() || !val.IsKnown() {
+			return val, nil
+		}
+
+		attrPath, err := fromtftypes.AttributePath(ctx, tfTypePath)
+
+		if err != nil {
+			return val, err
+		}
+
+		if req.ComputedAttributesOnly {
+			attribute, err := s.AttributeAtPath(ctx, attrPath)
+
+			// Blocks and collection elements are not attributes, so they
+			// can only be replaced as part of a Computed attribute.
+			if err != nil || !attribute.Computed {
+				return val, nil
+			}
+		}
+
+		attrType, err := s.AttributeTypeAtPath(ctx, attrPath)
+
+		if err != nil {
+			// Paths within dynamic values have no schema type, so there is
+			// no semantic equality to check.
+			logging.FrameworkTrace(ctx, "unable to find attribute type, skipping semantic equality", map[string]interface{}{logging.KeyAttributePath: attrPath.String()})
+
+			return val, nil
+		}
+
+		// Block elements are not attributes and resolve to the type of the
+		// entire block, so skip any path where the types do not align.
+		if !attrType.TerraformType(ctx).Equal(val.Type()) {
+			return val, nil
+		}
+
+		var newAttrValue attr.Value
+		var diags diag.Diagnostics
+
+		callProviderDefined(ctx, &diags, "Type ValueFromTerraform", attrPath, func() {
+			newAttrValue, err = attrType.ValueFromTerraform(ctx, val)
+		})
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return val, nil
+		}
+
+		if err != nil {
+			return val, err
+		}
+
+		newAttrValueWithSemanticEquals, ok := newAttrValue.(attr.ValueWithSemanticEquals)
+
+		if !ok {
+			return val, nil
+		}
+
+		priorRawValue, _, err := tftypes.WalkAttributePath(req.PriorValue, tfTypePath)
+
+		if err != nil {
+			if errors.Is(err, tftypes.ErrInvalidStep) {
+				// The new value cannot be correlated with the prior value,
+				// such as an added list element.
+				return val, nil
+			}
+
+			return val, err
+		}
+
+		priorVal, ok := priorRawValue.(tftypes.Value)
+
+		if !ok || priorVal.IsNull() || !priorVal.IsKnown() || priorVal.Equal(val) {
+			return val, nil
+		}
+
+		var priorAttrValue attr.Value
+
+		callProviderDefined(ctx, &diags, "Type ValueFromTerraform", attrPath, func() {
+			priorAttrValue, err = attrType.ValueFromTerraform(ctx, priorVal)
+		})
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return val, nil
+		}
+
+		if err != nil {
+			return val, err
+		}
+
+		var semanticallyEqual bool
+
+		ctx := logging.FrameworkWithAttributePath(ctx, attrPath.String())
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Value SemanticEquals")
+		callProviderDefined(ctx, &diags, "Value SemanticEquals", attrPath, func() {
+			semanticallyEqual, diags = newAttrValueWithSemanticEquals.SemanticEquals(ctx, priorAttrValue)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined Value SemanticEquals")
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() || !semanticallyEqual {
+			return val, nil
+		}
+
+		logging.FrameworkTrace(ctx, "Value is semantically equal to prior value, keeping prior value")
+
+		return priorVal, nil
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Checking Semantic Equality",
+			"There was an unexpected error checking semantic equality of values. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	resp.NewValue = newValue
+}
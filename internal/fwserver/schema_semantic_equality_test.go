@@ -0,0 +1,213 @@
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaSemanticEquality(t *testing.T) {
+	t.Parallel()
+
+	testSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_semantic": {
+				Optional: true,
+				Type:     testtypes.StringTypeWithSemanticEquals{},
+			},
+			"test_string": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"test_list": {
+				Optional: true,
+				Type:     types.ListType{ElemType: testtypes.StringTypeWithSemanticEquals{}},
+			},
+		},
+	}
+	testSchemaComputed := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_semantic": {
+				Optional: true,
+				Computed: true,
+				Type:     testtypes.StringTypeWithSemanticEquals{},
+			},
+			"test_string": {
+				Optional: true,
+				Computed: true,
+				Type:     types.StringType,
+			},
+			"test_list": {
+				Optional: true,
+				Computed: true,
+				Type:     types.ListType{ElemType: testtypes.StringTypeWithSemanticEquals{}},
+			},
+		},
+	}
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_semantic": tftypes.String,
+			"test_string":   tftypes.String,
+			"test_list":     tftypes.List{ElementType: tftypes.String},
+		},
+	}
+	testValue := func(semantic, str interface{}, list []string) tftypes.Value {
+		var listValue interface{}
+
+		if list != nil {
+			elements := make([]tftypes.Value, 0, len(list))
+
+			for _, element := range list {
+				elements = append(elements, tftypes.NewValue(tftypes.String, element))
+			}
+
+			listValue = elements
+		}
+
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_semantic": tftypes.NewValue(tftypes.String, semantic),
+			"test_string":   tftypes.NewValue(tftypes.String, str),
+			"test_list":     tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, listValue),
+		})
+	}
+
+	testCases := map[string]struct {
+		schema           tfsdk.Schema
+		req              SchemaSemanticEqualityRequest
+		expectedNewValue tftypes.Value
+		expectedDiags    diag.Diagnostics
+	}{
+		"equal": {
+			schema: testSchema,
+			req: SchemaSemanticEqualityRequest{
+				PriorValue: testValue("prior", "prior", []string{"prior"}),
+				NewValue:   testValue("prior", "prior", []string{"prior"}),
+			},
+			expectedNewValue: testValue("prior", "prior", []string{"prior"}),
+		},
+		"prior-null": {
+			schema: testSchema,
+			req: SchemaSemanticEqualityRequest{
+				PriorValue: tftypes.NewValue(testType, nil),
+				NewValue:   testValue("NEW", "new", nil),
+			},
+			expectedNewValue: testValue("NEW", "new", nil),
+		},
+		"prior-attribute-null": {
+			schema: testSchema,
+			req: SchemaSemanticEqualityRequest{
+				PriorValue: testValue(nil, nil, nil),
+				NewValue:   testValue("NEW", "new", nil),
+			},
+			expectedNewValue: testValue("NEW", "new", nil),
+		},
+		"new-attribute-unknown": {
+			schema: testSchema,
+			req: SchemaSemanticEqualityRequest{
+				PriorValue: testValue("prior", "prior", nil),
+				NewValue:   testValue(tftypes.UnknownValue, "prior", nil),
+			},
+			expectedNewValue: testValue(tftypes.UnknownValue, "prior", nil),
+		},
+		"semantically-equal": {
+			schema: testSchema,
+			req: SchemaSemanticEqualityRequest{
+				PriorValue: testValue("prior", "prior", nil),
+				NewValue:   testValue("PRIOR", "PRIOR", nil),
+			},
+			// Only the attribute with semantic equality keeps the prior
+			// value.
+			expectedNewValue: testValue("prior", "PRIOR", nil),
+		},
+		"semantically-not-equal": {
+			schema: testSchema,
+			req: SchemaSemanticEqualityRequest{
+				PriorValue: testValue("prior", "prior", nil),
+				NewValue:   testValue("new", "prior", nil),
+			},
+			expectedNewValue: testValue("new", "prior", nil),
+		},
+		"list-elements": {
+			schema: testSchema,
+			req: SchemaSemanticEqualityRequest{
+				PriorValue: testValue(nil, nil, []string{"one", "two"}),
+				NewValue:   testValue(nil, nil, []string{"ONE", "three", "FOUR"}),
+			},
+			expectedNewValue: testValue(nil, nil, []string{"one", "three", "FOUR"}),
+		},
+		"computed-attributes-only-not-computed": {
+			schema: testSchema,
+			req: SchemaSemanticEqualityRequest{
+				ComputedAttributesOnly: true,
+				PriorValue:             testValue("prior", "prior", []string{"one", "two"}),
+				NewValue:               testValue("PRIOR", "PRIOR", []string{"ONE", "two"}),
+			},
+			expectedNewValue: testValue("PRIOR", "PRIOR", []string{"ONE", "two"}),
+		},
+		"computed-attributes-only-computed": {
+			schema: testSchemaComputed,
+			req: SchemaSemanticEqualityRequest{
+				ComputedAttributesOnly: true,
+				PriorValue:             testValue("prior", "prior", []string{"one", "two"}),
+				NewValue:               testValue("PRIOR", "PRIOR", []string{"ONE", "two"}),
+			},
+			// List elements are never replaced, even within a Computed
+			// attribute.
+			expectedNewValue: testValue("prior", "PRIOR", []string{"ONE", "two"}),
+		},
+		"diagnostics": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test_semantic": {
+						Optional: true,
+						Type: testtypes.StringTypeWithSemanticEquals{
+							SemanticEqualsDiagnostics: diag.Diagnostics{
+								diag.NewWarningDiagnostic("test summary", "test detail"),
+							},
+						},
+					},
+				},
+			},
+			req: SchemaSemanticEqualityRequest{
+				PriorValue: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"test_semantic": tftypes.String}}, map[string]tftypes.Value{
+					"test_semantic": tftypes.NewValue(tftypes.String, "prior"),
+				}),
+				NewValue: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"test_semantic": tftypes.String}}, map[string]tftypes.Value{
+					"test_semantic": tftypes.NewValue(tftypes.String, "PRIOR"),
+				}),
+			},
+			expectedNewValue: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"test_semantic": tftypes.String}}, map[string]tftypes.Value{
+				"test_semantic": tftypes.NewValue(tftypes.String, "prior"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("test summary", "test detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &SchemaSemanticEqualityResponse{}
+
+			SchemaSemanticEquality(context.Background(), testCase.schema, testCase.req, resp)
+
+			if diff := cmp.Diff(resp.NewValue, testCase.expectedNewValue); diff != "" {
+				t.Errorf("unexpected new value difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
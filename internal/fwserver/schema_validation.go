@@ -4,8 +4,8 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // ValidateSchemaRequest repesents a request for validating a Schema.
@@ -16,6 +16,10 @@ type ValidateSchemaRequest struct {
 	// interpolation or other functionality that would prevent Terraform
 	// from knowing the value at request time.
 	Config tfsdk.Config
+
+	// ProviderData is the ResourceData or DataSourceData from the
+	// ConfigureProvider response, if the provider has been configured.
+	ProviderData interface{}
 }
 
 // ValidateSchemaResponse represents a response to a
@@ -36,8 +40,9 @@ func SchemaValidate(ctx context.Context, s tfsdk.Schema, req ValidateSchemaReque
 	for name, attribute := range s.Attributes {
 
 		attributeReq := tfsdk.ValidateAttributeRequest{
-			AttributePath: tftypes.NewAttributePath().WithAttributeName(name),
+			AttributePath: path.Root(name),
 			Config:        req.Config,
+			ProviderData:  req.ProviderData,
 		}
 		attributeResp := &tfsdk.ValidateAttributeResponse{
 			Diagnostics: resp.Diagnostics,
@@ -50,8 +55,9 @@ func SchemaValidate(ctx context.Context, s tfsdk.Schema, req ValidateSchemaReque
 
 	for name, block := range s.Blocks {
 		attributeReq := tfsdk.ValidateAttributeRequest{
-			AttributePath: tftypes.NewAttributePath().WithAttributeName(name),
+			AttributePath: path.Root(name),
 			Config:        req.Config,
+			ProviderData:  req.ProviderData,
 		}
 		attributeResp := &tfsdk.ValidateAttributeResponse{
 			Diagnostics: resp.Diagnostics,
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -16,6 +17,10 @@ import (
 type Server struct {
 	Provider tfsdk.Provider
 
+	// dataSourceData is the DataSourceData from the ConfigureProvider
+	// response, which is passed to data source requests.
+	dataSourceData interface{}
+
 	// dataSourceSchemas is the cached DataSource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the DataSourceType.GetSchema() method.
@@ -44,6 +49,21 @@ type Server struct {
 	// access from race conditions.
 	dataSourceTypesMutex sync.Mutex
 
+	// providerConfigDeferred is true if the provider configuration contained
+	// unknown values and the provider requested the framework to defer
+	// resource planning and data source reading logic until it is known.
+	providerConfigDeferred bool
+
+	// providerConfigured is true once the ConfigureProvider RPC has completed
+	// without errors. RPCs which require the resourceData or dataSourceData
+	// return an error diagnostic until then.
+	providerConfigured bool
+
+	// providerConfiguredMutex is a mutex to protect concurrent
+	// providerConfigDeferred, providerConfigured, resourceData, and
+	// dataSourceData access from race conditions.
+	providerConfiguredMutex sync.Mutex
+
 	// providerSchema is the cached Provider Schema for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the Provider.GetSchema() method.
@@ -72,6 +92,10 @@ type Server struct {
 	// access from race conditions.
 	providerMetaSchemaMutex sync.Mutex
 
+	// resourceData is the ResourceData from the ConfigureProvider response,
+	// which is passed to resource requests.
+	resourceData interface{}
+
 	// resourceSchemas is the cached Resource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the ResourceType.GetSchema() method.
@@ -101,6 +125,19 @@ type Server struct {
 	resourceTypesMutex sync.Mutex
 }
 
+// DataSourceData returns the DataSourceData set by the provider during the
+// ConfigureProvider RPC. An error diagnostic is returned if the provider has
+// not been configured.
+func (s *Server) DataSourceData(ctx context.Context) (interface{}, diag.Diagnostics) {
+	_, dataSourceData, configured := s.configuredData(ctx)
+
+	if !configured {
+		return nil, providerNotConfiguredDiags()
+	}
+
+	return dataSourceData, nil
+}
+
 // DataSourceSchema returns the Schema associated with the DataSourceType for
 // the given type name.
 func (s *Server) DataSourceSchema(ctx context.Context, typeName string) (*tfsdk.Schema, diag.Diagnostics) {
@@ -145,7 +182,12 @@ func (s *Server) DataSourceSchemas(ctx context.Context) (map[string]*tfsdk.Schem
 		logging.FrameworkTrace(ctx, "Found data source type", map[string]interface{}{logging.KeyDataSourceType: dataSourceTypeName})
 
 		logging.FrameworkDebug(ctx, "Calling provider defined DataSourceType GetSchema", map[string]interface{}{logging.KeyDataSourceType: dataSourceTypeName})
-		schema, diags := dataSourceType.GetSchema(ctx)
+		var schema tfsdk.Schema
+		var diags diag.Diagnostics
+
+		callProviderDefined(ctx, &diags, "DataSourceType GetSchema", path.Empty(), func() {
+			schema, diags = dataSourceType.GetSchema(ctx)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined DataSourceType GetSchema", map[string]interface{}{logging.KeyDataSourceType: dataSourceTypeName})
 
 		s.dataSourceSchemasDiags.Append(diags...)
@@ -154,6 +196,16 @@ func (s *Server) DataSourceSchemas(ctx context.Context) (map[string]*tfsdk.Schem
 			return s.dataSourceSchemas, s.dataSourceSchemasDiags
 		}
 
+		logging.FrameworkTrace(ctx, "Validating DataSourceType schema implementation", map[string]interface{}{logging.KeyDataSourceType: dataSourceTypeName})
+		s.dataSourceSchemasDiags.Append(SchemaValidateImplementation(ctx, schema, ValidateSchemaImplementationRequest{
+			Description:       fmt.Sprintf("data source type %q", dataSourceTypeName),
+			ReservedRootNames: dataSourceReservedRootNames,
+		})...)
+
+		if s.dataSourceSchemasDiags.HasError() {
+			return s.dataSourceSchemas, s.dataSourceSchemasDiags
+		}
+
 		s.dataSourceSchemas[dataSourceTypeName] = &schema
 	}
 
@@ -190,7 +242,9 @@ func (s *Server) DataSourceTypes(ctx context.Context) (map[string]tfsdk.DataSour
 	}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider GetDataSources")
-	s.dataSourceTypes, s.dataSourceTypesDiags = s.Provider.GetDataSources(ctx)
+	callProviderDefined(ctx, &s.dataSourceTypesDiags, "Provider GetDataSources", path.Empty(), func() {
+		s.dataSourceTypes, s.dataSourceTypesDiags = s.Provider.GetDataSources(ctx)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Provider GetDataSources")
 
 	return s.dataSourceTypes, s.dataSourceTypesDiags
@@ -208,12 +262,27 @@ func (s *Server) ProviderSchema(ctx context.Context) (*tfsdk.Schema, diag.Diagno
 	}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider GetSchema")
-	providerSchema, diags := s.Provider.GetSchema(ctx)
+	var providerSchema tfsdk.Schema
+	var diags diag.Diagnostics
+
+	callProviderDefined(ctx, &diags, "Provider GetSchema", path.Empty(), func() {
+		providerSchema, diags = s.Provider.GetSchema(ctx)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Provider GetSchema")
 
 	s.providerSchema = &providerSchema
 	s.providerSchemaDiags = diags
 
+	if s.providerSchemaDiags.HasError() {
+		return s.providerSchema, s.providerSchemaDiags
+	}
+
+	logging.FrameworkTrace(ctx, "Validating Provider schema implementation")
+	s.providerSchemaDiags.Append(SchemaValidateImplementation(ctx, providerSchema, ValidateSchemaImplementationRequest{
+		Description:       "provider",
+		ReservedRootNames: providerReservedRootNames,
+	})...)
+
 	return s.providerSchema, s.providerSchemaDiags
 }
 
@@ -237,15 +306,42 @@ func (s *Server) ProviderMetaSchema(ctx context.Context) (*tfsdk.Schema, diag.Di
 	}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider GetMetaSchema")
-	providerMetaSchema, diags := providerWithProviderMeta.GetMetaSchema(ctx)
+	var providerMetaSchema tfsdk.Schema
+	var diags diag.Diagnostics
+
+	callProviderDefined(ctx, &diags, "Provider GetMetaSchema", path.Empty(), func() {
+		providerMetaSchema, diags = providerWithProviderMeta.GetMetaSchema(ctx)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Provider GetMetaSchema")
 
 	s.providerMetaSchema = &providerMetaSchema
 	s.providerMetaSchemaDiags = diags
 
+	if s.providerMetaSchemaDiags.HasError() {
+		return s.providerMetaSchema, s.providerMetaSchemaDiags
+	}
+
+	logging.FrameworkTrace(ctx, "Validating Provider meta schema implementation")
+	s.providerMetaSchemaDiags.Append(SchemaValidateImplementation(ctx, providerMetaSchema, ValidateSchemaImplementationRequest{
+		Description: "provider meta",
+	})...)
+
 	return s.providerMetaSchema, s.providerMetaSchemaDiags
 }
 
+// ResourceData returns the ResourceData set by the provider during the
+// ConfigureProvider RPC. An error diagnostic is returned if the provider has
+// not been configured.
+func (s *Server) ResourceData(ctx context.Context) (interface{}, diag.Diagnostics) {
+	resourceData, _, configured := s.configuredData(ctx)
+
+	if !configured {
+		return nil, providerNotConfiguredDiags()
+	}
+
+	return resourceData, nil
+}
+
 // ResourceSchema returns the Schema associated with the ResourceType for
 // the given type name.
 func (s *Server) ResourceSchema(ctx context.Context, typeName string) (*tfsdk.Schema, diag.Diagnostics) {
@@ -290,7 +386,12 @@ func (s *Server) ResourceSchemas(ctx context.Context) (map[string]*tfsdk.Schema,
 		logging.FrameworkTrace(ctx, "Found resource type", map[string]interface{}{logging.KeyResourceType: resourceTypeName})
 
 		logging.FrameworkDebug(ctx, "Calling provider defined ResourceType GetSchema", map[string]interface{}{logging.KeyResourceType: resourceTypeName})
-		schema, diags := resourceType.GetSchema(ctx)
+		var schema tfsdk.Schema
+		var diags diag.Diagnostics
+
+		callProviderDefined(ctx, &diags, "ResourceType GetSchema", path.Empty(), func() {
+			schema, diags = resourceType.GetSchema(ctx)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined ResourceType GetSchema", map[string]interface{}{logging.KeyResourceType: resourceTypeName})
 
 		s.resourceSchemasDiags.Append(diags...)
@@ -299,6 +400,16 @@ func (s *Server) ResourceSchemas(ctx context.Context) (map[string]*tfsdk.Schema,
 			return s.resourceSchemas, s.resourceSchemasDiags
 		}
 
+		logging.FrameworkTrace(ctx, "Validating ResourceType schema implementation", map[string]interface{}{logging.KeyResourceType: resourceTypeName})
+		s.resourceSchemasDiags.Append(SchemaValidateImplementation(ctx, schema, ValidateSchemaImplementationRequest{
+			Description:       fmt.Sprintf("resource type %q", resourceTypeName),
+			ReservedRootNames: resourceReservedRootNames,
+		})...)
+
+		if s.resourceSchemasDiags.HasError() {
+			return s.resourceSchemas, s.resourceSchemasDiags
+		}
+
 		s.resourceSchemas[resourceTypeName] = &schema
 	}
 
@@ -335,8 +446,45 @@ func (s *Server) ResourceTypes(ctx context.Context) (map[string]tfsdk.ResourceTy
 	}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider GetResources")
-	s.resourceTypes, s.resourceTypesDiags = s.Provider.GetResources(ctx)
+	callProviderDefined(ctx, &s.resourceTypesDiags, "Provider GetResources", path.Empty(), func() {
+		s.resourceTypes, s.resourceTypesDiags = s.Provider.GetResources(ctx)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Provider GetResources")
 
 	return s.resourceTypes, s.resourceTypesDiags
 }
+
+// configuredData returns the ResourceData and DataSourceData set by the
+// provider during the ConfigureProvider RPC, along with whether the provider
+// has been configured. Validation RPCs use this directly, as Terraform
+// validates configuration before configuring the provider.
+func (s *Server) configuredData(ctx context.Context) (interface{}, interface{}, bool) {
+	logging.FrameworkTrace(ctx, "Checking ProviderConfigured lock")
+	s.providerConfiguredMutex.Lock()
+	defer s.providerConfiguredMutex.Unlock()
+
+	return s.resourceData, s.dataSourceData, s.providerConfigured
+}
+
+// providerConfigIsDeferred returns true if the provider configuration
+// contained unknown values and the provider set DeferWhenConfigUnknown in the
+// ConfigureProvider response.
+func (s *Server) providerConfigIsDeferred(ctx context.Context) bool {
+	logging.FrameworkTrace(ctx, "Checking ProviderConfigured lock")
+	s.providerConfiguredMutex.Lock()
+	defer s.providerConfiguredMutex.Unlock()
+
+	return s.providerConfigDeferred
+}
+
+// providerNotConfiguredDiags returns the error diagnostic for RPCs which
+// require provider configuration, but were called before ConfigureProvider.
+func providerNotConfiguredDiags() diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Provider Not Configured",
+			"The provider received a request which requires the provider to be configured, before the provider was configured. "+
+				"This is always an issue with Terraform or the Terraform Provider SDK used to implement the provider and should be reported to the provider developers.",
+		),
+	}
+}
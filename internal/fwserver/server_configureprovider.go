@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -11,11 +12,34 @@ import (
 func (s *Server) ConfigureProvider(ctx context.Context, req *tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider Configure")
 
+	configureReq := tfsdk.ConfigureProviderRequest{}
+
 	if req != nil {
-		s.Provider.Configure(ctx, *req, resp)
-	} else {
-		s.Provider.Configure(ctx, tfsdk.ConfigureProviderRequest{}, resp)
+		configureReq = *req
 	}
 
+	configureReq.ConfigHasUnknownValues = !configureReq.Config.Raw.IsFullyKnown()
+
+	callProviderDefined(ctx, &resp.Diagnostics, "Provider Configure", path.Empty(), func() {
+		s.Provider.Configure(ctx, configureReq, resp)
+	})
+
 	logging.FrameworkDebug(ctx, "Called provider defined Provider Configure")
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.FrameworkTrace(ctx, "Checking ProviderConfigured lock")
+	s.providerConfiguredMutex.Lock()
+	defer s.providerConfiguredMutex.Unlock()
+
+	s.resourceData = resp.ResourceData
+	s.dataSourceData = resp.DataSourceData
+	s.providerConfigured = true
+	s.providerConfigDeferred = configureReq.ConfigHasUnknownValues && resp.DeferWhenConfigUnknown
+
+	if s.providerConfigDeferred {
+		logging.FrameworkDebug(ctx, "Provider configuration has unknown values, deferring resource planning and data source reading")
+	}
 }
@@ -5,9 +5,12 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/emptyprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // TODO: Migrate tfsdk.Provider bits of proto6server.testProviderServer to
@@ -22,9 +25,12 @@ func TestServerConfigureProvider(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
-		server           *fwserver.Server
-		request          *tfsdk.ConfigureProviderRequest
-		expectedResponse *tfsdk.ConfigureProviderResponse
+		server                 *fwserver.Server
+		request                *tfsdk.ConfigureProviderRequest
+		expectedResponse       *tfsdk.ConfigureProviderResponse
+		expectedDataSourceData interface{}
+		expectedResourceData   interface{}
+		expectedDataDiags      diag.Diagnostics
 	}{
 		"empty-provider": {
 			server: &fwserver.Server{
@@ -32,6 +38,93 @@ func TestServerConfigureProvider(t *testing.T) {
 			},
 			expectedResponse: &tfsdk.ConfigureProviderResponse{},
 		},
+		"request-config-known": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ConfigureMethod: func(_ context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+						resp.ResourceData = req.ConfigHasUnknownValues
+					},
+				},
+			},
+			request: &tfsdk.ConfigureProviderRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_attribute": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+					}),
+				},
+			},
+			expectedResponse: &tfsdk.ConfigureProviderResponse{
+				ResourceData: false,
+			},
+			expectedResourceData: false,
+		},
+		"request-config-unknown": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ConfigureMethod: func(_ context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+						resp.ResourceData = req.ConfigHasUnknownValues
+					},
+				},
+			},
+			request: &tfsdk.ConfigureProviderRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_attribute": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_attribute": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+				},
+			},
+			expectedResponse: &tfsdk.ConfigureProviderResponse{
+				ResourceData: true,
+			},
+			expectedResourceData: true,
+		},
+		"response-providerdata": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ConfigureMethod: func(_ context.Context, _ tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+						resp.DataSourceData = "test-datasource-data"
+						resp.ResourceData = "test-resource-data"
+					},
+				},
+			},
+			expectedResponse: &tfsdk.ConfigureProviderResponse{
+				DataSourceData: "test-datasource-data",
+				ResourceData:   "test-resource-data",
+			},
+			expectedDataSourceData: "test-datasource-data",
+			expectedResourceData:   "test-resource-data",
+		},
+		"response-diagnostics-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ConfigureMethod: func(_ context.Context, _ tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+						resp.Diagnostics.AddError("test summary", "test detail")
+						resp.ResourceData = "test-resource-data"
+					},
+				},
+			},
+			expectedResponse: &tfsdk.ConfigureProviderResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test summary", "test detail"),
+				},
+				ResourceData: "test-resource-data",
+			},
+			expectedDataDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Provider Not Configured",
+					"The provider received a request which requires the provider to be configured, before the provider was configured. "+
+						"This is always an issue with Terraform or the Terraform Provider SDK used to implement the provider and should be reported to the provider developers.",
+				),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -46,6 +139,26 @@ func TestServerConfigureProvider(t *testing.T) {
 			if diff := cmp.Diff(response, testCase.expectedResponse); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
+
+			dataSourceData, diags := testCase.server.DataSourceData(context.Background())
+
+			if diff := cmp.Diff(diags, testCase.expectedDataDiags); diff != "" {
+				t.Errorf("unexpected DataSourceData diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(dataSourceData, testCase.expectedDataSourceData); diff != "" {
+				t.Errorf("unexpected DataSourceData difference: %s", diff)
+			}
+
+			resourceData, diags := testCase.server.ResourceData(context.Background())
+
+			if diff := cmp.Diff(diags, testCase.expectedDataDiags); diff != "" {
+				t.Errorf("unexpected ResourceData diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resourceData, testCase.expectedResourceData); diff != "" {
+				t.Errorf("unexpected ResourceData difference: %s", diff)
+			}
 		})
 	}
 }
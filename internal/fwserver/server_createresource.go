@@ -5,6 +5,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -13,7 +15,7 @@ import (
 // with the ApplyResourceChange RPC.
 type CreateResourceRequest struct {
 	Config         *tfsdk.Config
-	PlannedPrivate []byte
+	PlannedPrivate *privatestate.Data
 	PlannedState   *tfsdk.Plan
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema tfsdk.Schema
@@ -25,7 +27,7 @@ type CreateResourceRequest struct {
 type CreateResourceResponse struct {
 	Diagnostics diag.Diagnostics
 	NewState    *tfsdk.State
-	Private     []byte
+	Private     *privatestate.Data
 }
 
 // CreateResource implements the framework server create request logic for the
@@ -35,9 +37,21 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		return
 	}
 
+	resourceData, diags := s.ResourceData(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Always instantiate new Resource instances.
 	logging.FrameworkDebug(ctx, "Calling provider defined ResourceType NewResource")
-	resource, diags := req.ResourceType.NewResource(ctx, s.Provider)
+	var resource tfsdk.Resource
+
+	callProviderDefined(ctx, &diags, "ResourceType NewResource", path.Empty(), func() {
+		resource, diags = req.ResourceType.NewResource(ctx, s.Provider)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined ResourceType NewResource")
 
 	resp.Diagnostics.Append(diags...)
@@ -55,12 +69,24 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 			Schema: req.ResourceSchema,
 			Raw:    tftypes.NewValue(req.ResourceSchema.TerraformType(ctx), nil),
 		},
+		ProviderData: resourceData,
 	}
 	createResp := tfsdk.CreateResourceResponse{
 		State: tfsdk.State{
 			Schema: req.ResourceSchema,
 			Raw:    tftypes.NewValue(req.ResourceSchema.TerraformType(ctx), nil),
 		},
+		Private: privatestate.EmptyProviderData(ctx),
+	}
+
+	privateData := privatestate.EmptyData(ctx)
+
+	if req.PlannedPrivate != nil {
+		privateData.Framework = req.PlannedPrivate.Framework
+
+		if req.PlannedPrivate.Provider != nil {
+			createResp.Private = req.PlannedPrivate.Provider
+		}
 	}
 
 	if req.Config != nil {
@@ -75,10 +101,42 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		createReq.ProviderMeta = *req.ProviderMeta
 	}
 
+	timeout, diags := resourceTimeout(ctx, resource, req.ResourceSchema, createReq.Plan.Raw, resourceOperationCreate)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createCtx, cancel := contextWithResourceTimeout(ctx, timeout)
+	defer cancel()
+
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource Create")
-	resource.Create(ctx, createReq, &createResp)
+	callProviderDefined(ctx, &createResp.Diagnostics, "Resource Create", path.Empty(), func() {
+		resource.Create(createCtx, createReq, &createResp)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Resource Create")
 
-	resp.Diagnostics = createResp.Diagnostics
+	createResp.Diagnostics.Append(resourceTimeoutDiags(createCtx, resourceOperationCreate, timeout, createResp.Diagnostics)...)
+
+	// Keep any planned state values which are semantically equal to the new
+	// state values.
+	semanticEqualityReq := SchemaSemanticEqualityRequest{
+		PriorValue: createReq.Plan.Raw,
+		NewValue:   createResp.State.Raw,
+	}
+	semanticEqualityResp := SchemaSemanticEqualityResponse{
+		Diagnostics: createResp.Diagnostics,
+	}
+
+	SchemaSemanticEquality(ctx, req.ResourceSchema, semanticEqualityReq, &semanticEqualityResp)
+
+	createResp.State.Raw = semanticEqualityResp.NewValue
+
+	resp.Diagnostics = semanticEqualityResp.Diagnostics
 	resp.NewState = &createResp.State
+
+	privateData.Provider = createResp.Private
+	resp.Private = privateData
 }
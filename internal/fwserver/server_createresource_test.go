@@ -3,10 +3,19 @@ package fwserver_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/emptyprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // TODO: Migrate tfsdk.Provider bits of proto6server.testProviderServer to
@@ -20,6 +29,81 @@ import (
 func TestServerCreateResource(t *testing.T) {
 	t.Parallel()
 
+	testTimeoutsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"create": tftypes.String,
+			"delete": tftypes.String,
+			"read":   tftypes.String,
+			"update": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+			"timeouts":      testTimeoutsType,
+		},
+	}
+
+	testSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_required": {
+				Required: true,
+				Type:     types.StringType,
+			},
+		},
+		Blocks: map[string]tfsdk.Block{
+			"timeouts": tfsdk.TimeoutsBlock(),
+		},
+	}
+
+	testPlanValue := func(createTimeout tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+			"timeouts": tftypes.NewValue(testTimeoutsType, map[string]tftypes.Value{
+				"create": createTimeout,
+				"delete": tftypes.NewValue(tftypes.String, nil),
+				"read":   tftypes.NewValue(tftypes.String, nil),
+				"update": tftypes.NewValue(tftypes.String, nil),
+			}),
+		})
+	}
+
+	testEmptyState := &tfsdk.State{
+		Raw:    tftypes.NewValue(testType, nil),
+		Schema: testSchema,
+	}
+
+	testEmptyPrivate := &privatestate.Data{
+		Provider: privatestate.EmptyProviderData(context.Background()),
+	}
+
+	testSchemaSemanticEquality := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_list": {
+				Optional: true,
+				Type:     types.ListType{ElemType: testtypes.StringTypeWithSemanticEquals{}},
+			},
+			"test_semantic": {
+				Optional: true,
+				Type:     testtypes.StringTypeWithSemanticEquals{},
+			},
+		},
+	}
+
+	testSemanticEqualityValue := func(semantic string, list ...string) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(list))
+
+		for _, element := range list {
+			elements = append(elements, tftypes.NewValue(tftypes.String, element))
+		}
+
+		return tftypes.NewValue(testSchemaSemanticEquality.TerraformType(context.Background()), map[string]tftypes.Value{
+			"test_list":     tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements),
+			"test_semantic": tftypes.NewValue(tftypes.String, semantic),
+		})
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.CreateResourceRequest
@@ -31,6 +115,211 @@ func TestServerCreateResource(t *testing.T) {
 			},
 			expectedResponse: &fwserver.CreateResourceResponse{},
 		},
+		"semantic-equality": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw:    testSemanticEqualityValue("planned", "one", "two"),
+					Schema: testSchemaSemanticEquality,
+				},
+				ResourceSchema: testSchemaSemanticEquality,
+				ResourceType: &testprovider.ResourceType{
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return &testprovider.Resource{
+							CreateMethod: func(_ context.Context, _ tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+								resp.State.Raw = testSemanticEqualityValue("PLANNED", "ONE", "three")
+							},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw:    testSemanticEqualityValue("planned", "one", "three"),
+					Schema: testSchemaSemanticEquality,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"resource-timeouts-default": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw:    testPlanValue(tftypes.NewValue(tftypes.String, nil)),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				ResourceType: &testprovider.ResourceType{
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return &testprovider.ResourceWithTimeouts{
+							Resource: &testprovider.Resource{
+								CreateMethod: func(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+									deadline, ok := ctx.Deadline()
+
+									if !ok {
+										resp.Diagnostics.AddError("Unexpected Context", "expected context deadline")
+
+										return
+									}
+
+									if remaining := time.Until(deadline); remaining <= 10*time.Minute || remaining > 20*time.Minute {
+										resp.Diagnostics.AddError("Unexpected Context", "unexpected context deadline: "+remaining.String())
+									}
+								},
+							},
+							TimeoutsMethod: func(_ context.Context) tfsdk.Timeouts {
+								return tfsdk.Timeouts{
+									Create: 20 * time.Minute,
+								}
+							},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: testEmptyState,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"resource-timeouts-configured": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw:    testPlanValue(tftypes.NewValue(tftypes.String, "5m")),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				ResourceType: &testprovider.ResourceType{
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return &testprovider.ResourceWithTimeouts{
+							Resource: &testprovider.Resource{
+								CreateMethod: func(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+									deadline, ok := ctx.Deadline()
+
+									if !ok {
+										resp.Diagnostics.AddError("Unexpected Context", "expected context deadline")
+
+										return
+									}
+
+									if remaining := time.Until(deadline); remaining > 5*time.Minute {
+										resp.Diagnostics.AddError("Unexpected Context", "unexpected context deadline: "+remaining.String())
+									}
+								},
+							},
+							TimeoutsMethod: func(_ context.Context) tfsdk.Timeouts {
+								return tfsdk.Timeouts{
+									Create: 20 * time.Minute,
+								}
+							},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: testEmptyState,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"resource-timeouts-exceeded": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw:    testPlanValue(tftypes.NewValue(tftypes.String, "1ms")),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				ResourceType: &testprovider.ResourceType{
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return &testprovider.Resource{
+							CreateMethod: func(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+								<-ctx.Done()
+
+								resp.Diagnostics.AddError("Error Creating Widget", ctx.Err().Error())
+							},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("Error Creating Widget", "context deadline exceeded"),
+					diag.NewErrorDiagnostic(
+						"Resource Operation Timed Out",
+						"The resource create operation did not complete within the timeout of 1ms and returned errors after its context deadline was exceeded (context deadline exceeded). "+
+							"The operation may still be in progress in the remote system. "+
+							`If the resource supports a "timeouts" configuration, increase the "create" timeout and try again.`,
+					),
+				},
+				NewState: testEmptyState,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"resource-timeouts-exceeded-success": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw:    testPlanValue(tftypes.NewValue(tftypes.String, "1ms")),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				ResourceType: &testprovider.ResourceType{
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return &testprovider.Resource{
+							CreateMethod: func(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+								// Simulate the operation completing just as the
+								// deadline passes.
+								<-ctx.Done()
+							},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: testEmptyState,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"resource-timeouts-invalid": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw:    testPlanValue(tftypes.NewValue(tftypes.String, "invalid")),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				ResourceType: &testprovider.ResourceType{
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return &testprovider.Resource{
+							CreateMethod: func(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+								resp.Diagnostics.AddError("Unexpected Create", "expected Create not to be called")
+							},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("timeouts").AtName("create"),
+						"Invalid Resource Timeout",
+						`The create timeout "invalid" is not a valid duration: time: invalid duration "invalid"`,
+					),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -39,6 +328,8 @@ func TestServerCreateResource(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
+			testCase.server.ConfigureProvider(context.Background(), nil, &tfsdk.ConfigureProviderResponse{})
+
 			response := &fwserver.CreateResourceResponse{}
 			testCase.server.CreateResource(context.Background(), testCase.request, response)
 
@@ -5,6 +5,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -12,7 +14,7 @@ import (
 // DeleteResourceRequest is the framework server request for a delete request
 // with the ApplyResourceChange RPC.
 type DeleteResourceRequest struct {
-	PlannedPrivate []byte
+	PlannedPrivate *privatestate.Data
 	PriorState     *tfsdk.State
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema tfsdk.Schema
@@ -24,7 +26,7 @@ type DeleteResourceRequest struct {
 type DeleteResourceResponse struct {
 	Diagnostics diag.Diagnostics
 	NewState    *tfsdk.State
-	Private     []byte
+	Private     *privatestate.Data
 }
 
 // DeleteResource implements the framework server delete request logic for the
@@ -34,9 +36,21 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 		return
 	}
 
+	resourceData, diags := s.ResourceData(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Always instantiate new Resource instances.
 	logging.FrameworkDebug(ctx, "Calling provider defined ResourceType NewResource")
-	resource, diags := req.ResourceType.NewResource(ctx, s.Provider)
+	var resource tfsdk.Resource
+
+	callProviderDefined(ctx, &diags, "ResourceType NewResource", path.Empty(), func() {
+		resource, diags = req.ResourceType.NewResource(ctx, s.Provider)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined ResourceType NewResource")
 
 	resp.Diagnostics.Append(diags...)
@@ -50,6 +64,7 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 			Schema: req.ResourceSchema,
 			Raw:    tftypes.NewValue(req.ResourceSchema.TerraformType(ctx), nil),
 		},
+		ProviderData: resourceData,
 	}
 	deleteResp := tfsdk.DeleteResourceResponse{
 		State: tfsdk.State{
@@ -67,10 +82,29 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 		deleteReq.ProviderMeta = *req.ProviderMeta
 	}
 
+	if req.PlannedPrivate != nil {
+		deleteReq.Private = req.PlannedPrivate.Provider
+	}
+
+	timeout, diags := resourceTimeout(ctx, resource, req.ResourceSchema, deleteReq.State.Raw, resourceOperationDelete)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteCtx, cancel := contextWithResourceTimeout(ctx, timeout)
+	defer cancel()
+
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource Delete")
-	resource.Delete(ctx, deleteReq, &deleteResp)
+	callProviderDefined(ctx, &deleteResp.Diagnostics, "Resource Delete", path.Empty(), func() {
+		resource.Delete(deleteCtx, deleteReq, &deleteResp)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Resource Delete")
 
+	deleteResp.Diagnostics.Append(resourceTimeoutDiags(deleteCtx, resourceOperationDelete, timeout, deleteResp.Diagnostics)...)
+
 	if !deleteResp.Diagnostics.HasError() {
 		logging.FrameworkTrace(ctx, "No provider defined Delete errors detected, ensuring State is cleared")
 		deleteResp.State.RemoveResource(ctx)
@@ -78,4 +112,9 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 
 	resp.Diagnostics = deleteResp.Diagnostics
 	resp.NewState = &deleteResp.State
+
+	// Preserve any existing private state data if the resource still exists.
+	if deleteResp.Diagnostics.HasError() {
+		resp.Private = req.PlannedPrivate
+	}
 }
@@ -116,6 +116,55 @@ func TestServerGetProviderSchema(t *testing.T) {
 				ResourceSchemas: map[string]*tfsdk.Schema{},
 			},
 		},
+		"provider-invalid-implementation": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return tfsdk.Schema{
+							Attributes: map[string]tfsdk.Attribute{
+								"test": {
+									Computed: true,
+									Required: true,
+									Type:     types.StringType,
+								},
+							},
+						}, nil
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Schema Implementation",
+						"When validating the provider schema, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Attribute \"test\": Required and Computed cannot both be set.",
+					),
+				},
+			},
+		},
+		"provider-panic": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						panic("test panic")
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Provider Panic",
+						"An unexpected panic was recovered while calling provider defined Provider GetSchema. "+
+							"This is always a problem with the provider and should be reported to the provider developers. "+
+							"The stack trace has been written to the Terraform logs.\n\n"+
+							"Panic: test panic",
+					),
+				},
+			},
+		},
 		"providermeta": {
 			server: &fwserver.Server{
 				Provider: &testprovider.ProviderWithProviderMeta{
@@ -204,6 +253,47 @@ func TestServerGetProviderSchema(t *testing.T) {
 				},
 			},
 		},
+		"resourceschemas-invalid-implementation": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					GetResourcesMethod: func(_ context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
+						return map[string]tfsdk.ResourceType{
+							"test_resource": &testprovider.ResourceType{
+								GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+									return tfsdk.Schema{
+										Blocks: map[string]tfsdk.Block{
+											"test_block": {
+												Attributes: map[string]tfsdk.Attribute{
+													"test_attribute": {
+														Required: true,
+														Type:     types.StringType,
+													},
+												},
+												MaxItems:    1,
+												MinItems:    2,
+												NestingMode: tfsdk.BlockNestingModeList,
+											},
+										},
+									}, nil
+								},
+							},
+						}, nil
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Schema Implementation",
+						"When validating the resource type \"test_resource\" schema, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Block \"test_block\": MinItems (2) cannot be greater than MaxItems (1).",
+					),
+				},
+				Provider: &tfsdk.Schema{},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
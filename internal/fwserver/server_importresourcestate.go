@@ -5,12 +5,14 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
 // ImportedResource represents a resource that was imported.
 type ImportedResource struct {
-	Private  []byte
+	Private  *privatestate.Data
 	State    tfsdk.State
 	TypeName string
 }
@@ -45,9 +47,21 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 		return
 	}
 
+	resourceData, diags := s.ResourceData(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Always instantiate new Resource instances.
 	logging.FrameworkDebug(ctx, "Calling provider defined ResourceType NewResource")
-	resource, diags := req.ResourceType.NewResource(ctx, s.Provider)
+	var resource tfsdk.Resource
+
+	callProviderDefined(ctx, &diags, "ResourceType NewResource", path.Empty(), func() {
+		resource, diags = req.ResourceType.NewResource(ctx, s.Provider)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined ResourceType NewResource")
 
 	resp.Diagnostics.Append(diags...)
@@ -77,17 +91,21 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 	}
 
 	importReq := tfsdk.ImportResourceStateRequest{
-		ID: req.ID,
+		ID:           req.ID,
+		ProviderData: resourceData,
 	}
 	importResp := tfsdk.ImportResourceStateResponse{
 		State: tfsdk.State{
 			Raw:    req.EmptyState.Raw.Copy(),
 			Schema: req.EmptyState.Schema,
 		},
+		Private: privatestate.EmptyProviderData(ctx),
 	}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource ImportState")
-	resourceWithImportState.ImportState(ctx, importReq, &importResp)
+	callProviderDefined(ctx, &importResp.Diagnostics, "Resource ImportState", path.Empty(), func() {
+		resourceWithImportState.ImportState(ctx, importReq, &importResp)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Resource ImportState")
 
 	resp.Diagnostics.Append(importResp.Diagnostics...)
@@ -105,8 +123,13 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 		return
 	}
 
+	privateData := &privatestate.Data{
+		Provider: importResp.Private,
+	}
+
 	resp.ImportedResources = []ImportedResource{
 		{
+			Private:  privateData,
 			State:    importResp.State,
 			TypeName: req.TypeName,
 		},
@@ -7,7 +7,10 @@ import (
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -16,7 +19,7 @@ import (
 // PlanResourceChange RPC.
 type PlanResourceChangeRequest struct {
 	Config           *tfsdk.Config
-	PriorPrivate     []byte
+	PriorPrivate     *privatestate.Data
 	PriorState       *tfsdk.State
 	ProposedNewState *tfsdk.Plan
 	ProviderMeta     *tfsdk.Config
@@ -28,12 +31,12 @@ type PlanResourceChangeRequest struct {
 // PlanResourceChange RPC.
 type PlanResourceChangeResponse struct {
 	Diagnostics    diag.Diagnostics
-	PlannedPrivate []byte
+	PlannedPrivate *privatestate.Data
 	PlannedState   *tfsdk.State
 
 	// TODO: Replace with framework defined type
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/81
-	RequiresReplace []*tftypes.AttributePath
+	RequiresReplace path.Paths
 }
 
 // PlanResourceChange implements the framework server PlanResourceChange RPC.
@@ -42,9 +45,29 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		return
 	}
 
+	resourceData, diags := s.ResourceData(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if s.providerConfigIsDeferred(ctx) {
+		logging.FrameworkDebug(ctx, "Provider configuration is unknown, skipping provider defined Resource planning logic")
+
+		deferredPlanResourceChange(ctx, req, resp)
+
+		return
+	}
+
 	// Always instantiate new Resource instances.
 	logging.FrameworkDebug(ctx, "Calling provider defined ResourceType NewResource")
-	resource, diags := req.ResourceType.NewResource(ctx, s.Provider)
+	var resource tfsdk.Resource
+
+	callProviderDefined(ctx, &diags, "ResourceType NewResource", path.Empty(), func() {
+		resource, diags = req.ResourceType.NewResource(ctx, s.Provider)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined ResourceType NewResource")
 
 	resp.Diagnostics.Append(diags...)
@@ -80,6 +103,82 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 
 	resp.PlannedState = planToState(*req.ProposedNewState)
 
+	// Preserve any existing private state data, which resource-level
+	// ModifyPlan can update below.
+	resp.PlannedPrivate = privatestate.EmptyData(ctx)
+
+	if req.PriorPrivate != nil {
+		resp.PlannedPrivate.Framework = req.PriorPrivate.Framework
+
+		if req.PriorPrivate.Provider != nil {
+			resp.PlannedPrivate.Provider = req.PriorPrivate.Provider
+		}
+	}
+
+	// Set any attribute Default values which are null in the configuration.
+	//
+	// This is done before any Computed-only attributes are marked as unknown
+	// so that defaults matching the prior state do not cause plan
+	// differences.
+	//
+	// We only do this if there's a plan to modify; otherwise, it
+	// represents a resource being deleted and there's no point.
+	if !resp.PlannedState.Raw.IsNull() {
+		logging.FrameworkTrace(ctx, "Setting attribute Default values in Plan")
+
+		applyDefaultsReq := ApplySchemaDefaultsRequest{
+			Config:       *req.Config,
+			Plan:         stateToPlan(*resp.PlannedState),
+			ProviderData: resourceData,
+		}
+
+		if req.ProviderMeta != nil {
+			applyDefaultsReq.ProviderMeta = *req.ProviderMeta
+		}
+
+		applyDefaultsResp := ApplySchemaDefaultsResponse{
+			Diagnostics: resp.Diagnostics,
+		}
+
+		SchemaApplyDefaults(ctx, req.ResourceSchema, applyDefaultsReq, &applyDefaultsResp)
+
+		resp.Diagnostics = applyDefaultsResp.Diagnostics
+		resp.PlannedState = planToState(applyDefaultsResp.Plan)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Keep any prior state values of Computed attributes which are
+	// semantically equal to the proposed new state values. Terraform
+	// rejects plans where any other attribute value, including collection
+	// elements, differs from the configuration value.
+	//
+	// This is done before any Computed-only attributes are marked as unknown
+	// so that semantically equal values do not cause plan differences.
+	if !resp.PlannedState.Raw.IsNull() && !req.PriorState.Raw.IsNull() {
+		logging.FrameworkTrace(ctx, "Keeping semantically equal prior state values in Plan")
+
+		semanticEqualityReq := SchemaSemanticEqualityRequest{
+			ComputedAttributesOnly: true,
+			PriorValue:             req.PriorState.Raw,
+			NewValue:               resp.PlannedState.Raw,
+		}
+		semanticEqualityResp := SchemaSemanticEqualityResponse{
+			Diagnostics: resp.Diagnostics,
+		}
+
+		SchemaSemanticEquality(ctx, req.ResourceSchema, semanticEqualityReq, &semanticEqualityResp)
+
+		resp.Diagnostics = semanticEqualityResp.Diagnostics
+		resp.PlannedState.Raw = semanticEqualityResp.NewValue
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Execute any AttributePlanModifiers.
 	//
 	// This pass is before any Computed-only attributes are marked as unknown
@@ -158,9 +257,10 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 	// represents a resource being deleted and there's no point.
 	if !resp.PlannedState.Raw.IsNull() {
 		modifySchemaPlanReq := ModifySchemaPlanRequest{
-			Config: *req.Config,
-			Plan:   stateToPlan(*resp.PlannedState),
-			State:  *req.PriorState,
+			Config:       *req.Config,
+			Plan:         stateToPlan(*resp.PlannedState),
+			ProviderData: resourceData,
+			State:        *req.PriorState,
 		}
 
 		if req.ProviderMeta != nil {
@@ -195,9 +295,11 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithModifyPlan")
 
 		modifyPlanReq := tfsdk.ModifyResourcePlanRequest{
-			Config: *req.Config,
-			Plan:   stateToPlan(*resp.PlannedState),
-			State:  *req.PriorState,
+			Config:       *req.Config,
+			Plan:         stateToPlan(*resp.PlannedState),
+			Private:      resp.PlannedPrivate.Provider,
+			ProviderData: resourceData,
+			State:        *req.PriorState,
 		}
 
 		if req.ProviderMeta != nil {
@@ -207,32 +309,138 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		modifyPlanResp := tfsdk.ModifyResourcePlanResponse{
 			Diagnostics:     resp.Diagnostics,
 			Plan:            modifyPlanReq.Plan,
-			RequiresReplace: []*tftypes.AttributePath{},
+			Private:         modifyPlanReq.Private,
+			RequiresReplace: path.Paths{},
 		}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource ModifyPlan")
-		resource.ModifyPlan(ctx, modifyPlanReq, &modifyPlanResp)
+		callProviderDefined(ctx, &modifyPlanResp.Diagnostics, "Resource ModifyPlan", path.Empty(), func() {
+			resource.ModifyPlan(ctx, modifyPlanReq, &modifyPlanResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined Resource ModifyPlan")
 
 		resp.Diagnostics = modifyPlanResp.Diagnostics
 		resp.PlannedState = planToState(modifyPlanResp.Plan)
 		resp.RequiresReplace = append(resp.RequiresReplace, modifyPlanResp.RequiresReplace...)
+		resp.PlannedPrivate.Provider = modifyPlanResp.Private
 	}
 
 	// Ensure deterministic RequiresReplace by sorting and deduplicating
 	resp.RequiresReplace = NormaliseRequiresReplace(ctx, resp.RequiresReplace)
 }
 
+// deferredPlanResourceChange plans the resource change without calling any
+// provider defined logic, for use while the provider configuration is
+// unknown. The proposed new state is planned with all Computed attributes
+// and attributes with a Default which are null in the configuration marked
+// as unknown, since any of them may change once the provider is configured
+// with known values. Defaults are not applied, as a DefaultFunc may require
+// the configured provider.
+//
+// Only the RequiresReplace and RequiresReplaceIf attribute plan modifiers
+// are called, since they only compare the configuration, plan, and state,
+// so Terraform plans a replacement instead of an update which the resource
+// cannot perform.
+func deferredPlanResourceChange(ctx context.Context, req *PlanResourceChangeRequest, resp *PlanResourceChangeResponse) {
+	resp.PlannedPrivate = privatestate.EmptyData(ctx)
+
+	if req.PriorPrivate != nil {
+		resp.PlannedPrivate.Framework = req.PriorPrivate.Framework
+
+		if req.PriorPrivate.Provider != nil {
+			resp.PlannedPrivate.Provider = req.PriorPrivate.Provider
+		}
+	}
+
+	if req.Config == nil || req.ProposedNewState == nil {
+		resp.PlannedState = &tfsdk.State{
+			Raw:    tftypes.NewValue(req.ResourceSchema.TerraformType(ctx), nil),
+			Schema: req.ResourceSchema,
+		}
+
+		return
+	}
+
+	resp.PlannedState = planToState(*req.ProposedNewState)
+
+	// A null plan represents a resource being deleted, which has nothing
+	// to mark as unknown.
+	if resp.PlannedState.Raw.IsNull() {
+		return
+	}
+
+	// Determine RequiresReplace before marking unknown values, since the
+	// proposed new state contains the prior state values of Computed
+	// attributes which are null in the configuration.
+	if req.PriorState != nil && !req.PriorState.Raw.IsNull() {
+		logging.FrameworkTrace(ctx, "Calling RequiresReplace attribute plan modifiers with deferred provider configuration")
+
+		modifySchemaPlanReq := ModifySchemaPlanRequest{
+			Config: *req.Config,
+			Plan:   *req.ProposedNewState,
+			State:  *req.PriorState,
+		}
+
+		if req.ProviderMeta != nil {
+			modifySchemaPlanReq.ProviderMeta = *req.ProviderMeta
+		}
+
+		modifySchemaPlanResp := ModifySchemaPlanResponse{
+			Diagnostics: resp.Diagnostics,
+			Plan:        modifySchemaPlanReq.Plan,
+		}
+
+		SchemaModifyPlan(ctx, schemaRequiresReplaceModifiers(req.ResourceSchema), modifySchemaPlanReq, &modifySchemaPlanResp)
+
+		resp.Diagnostics = modifySchemaPlanResp.Diagnostics
+		resp.RequiresReplace = NormaliseRequiresReplace(ctx, modifySchemaPlanResp.RequiresReplace)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	logging.FrameworkTrace(ctx, "Marking Computed and Default null Config values as unknown in Plan")
+
+	modifiedPlan, err := tftypes.Transform(resp.PlannedState.Raw, markNilsAsUnknown(ctx, req.Config.Raw, req.ResourceSchema, true))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error modifying plan",
+			"There was an unexpected error updating the plan. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	resp.PlannedState.Raw = modifiedPlan
+}
+
 func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resourceSchema tfsdk.Schema) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
-	return func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
-		ctx = logging.FrameworkWithAttributePath(ctx, path.String())
+	return markNilsAsUnknown(ctx, config, resourceSchema, false)
+}
 
+// markNilsAsUnknown returns a tftypes.Transform function which marks
+// Computed attributes that are null in the configuration as unknown. If
+// includeDefaults is true, attributes with a Default are also marked as
+// unknown, otherwise they are left as is since the default value has
+// already been applied to the plan.
+func markNilsAsUnknown(ctx context.Context, config tftypes.Value, resourceSchema tfsdk.Schema, includeDefaults bool) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
+	return func(tfTypePath *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
 		// we are only modifying attributes, not the entire resource
-		if len(path.Steps()) < 1 {
+		if len(tfTypePath.Steps()) < 1 {
 			return val, nil
 		}
 
-		configVal, _, err := tftypes.WalkAttributePath(config, path)
+		attrPath, err := fromtftypes.AttributePath(ctx, tfTypePath)
+
+		if err != nil {
+			return val, err
+		}
+
+		ctx = logging.FrameworkWithAttributePath(ctx, attrPath.String())
+
+		configVal, _, err := tftypes.WalkAttributePath(config, tfTypePath)
 
 		if err != tftypes.ErrInvalidStep && err != nil {
 			logging.FrameworkError(ctx, "error walking attribute path")
@@ -242,7 +450,7 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 			return val, nil
 		}
 
-		attribute, err := resourceSchema.AttributeAtPath(path)
+		attribute, err := resourceSchema.AttributeAtPath(ctx, attrPath)
 
 		if err != nil {
 			if errors.Is(err, tfsdk.ErrPathInsideAtomicAttribute) {
@@ -255,12 +463,24 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 
 			return tftypes.Value{}, fmt.Errorf("couldn't find attribute in resource schema: %w", err)
 		}
+		if includeDefaults && attribute.Default != nil {
+			logging.FrameworkDebug(ctx, "marking attribute with a default value that is null in the config as unknown")
+
+			return tftypes.NewValue(val.Type(), tftypes.UnknownValue), nil
+		}
+
 		if !attribute.Computed {
 			logging.FrameworkTrace(ctx, "attribute is not computed in schema, not marking unknown")
 
 			return val, nil
 		}
 
+		if attribute.Default != nil {
+			logging.FrameworkTrace(ctx, "attribute has a default value in schema, not marking unknown")
+
+			return val, nil
+		}
+
 		logging.FrameworkDebug(ctx, "marking computed attribute that is null in the config as unknown")
 
 		return tftypes.NewValue(val.Type(), tftypes.UnknownValue), nil
@@ -270,7 +490,7 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 // NormaliseRequiresReplace sorts and deduplicates the slice of AttributePaths
 // used in the RequiresReplace response field.
 // Sorting is lexical based on the string representation of each AttributePath.
-func NormaliseRequiresReplace(ctx context.Context, rs []*tftypes.AttributePath) []*tftypes.AttributePath {
+func NormaliseRequiresReplace(ctx context.Context, rs path.Paths) path.Paths {
 	if len(rs) < 2 {
 		return rs
 	}
@@ -279,7 +499,7 @@ func NormaliseRequiresReplace(ctx context.Context, rs []*tftypes.AttributePath)
 		return rs[i].String() < rs[j].String()
 	})
 
-	ret := make([]*tftypes.AttributePath, len(rs))
+	ret := make(path.Paths, len(rs))
 	ret[0] = rs[0]
 
 	// deduplicate
@@ -7,8 +7,14 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/emptyprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/planmodifiers"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -40,6 +46,14 @@ func TestMarkComputedNilsAsUnknown(t *testing.T) {
 				Optional: true,
 				Computed: true,
 			},
+			// nil computed values with a default should be left alone, the
+			// default is applied separately
+			"string-nil-optional-computed-default": {
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+				Default:  tfsdk.StaticDefault(types.String{Value: "default"}),
+			},
 			// non-nil computed values should be left alone
 			"string-value-optional-computed": {
 				Type:     types.StringType,
@@ -111,12 +125,13 @@ func TestMarkComputedNilsAsUnknown(t *testing.T) {
 		},
 	}
 	input := tftypes.NewValue(s.TerraformType(context.Background()), map[string]tftypes.Value{
-		"string-value":                   tftypes.NewValue(tftypes.String, "hello, world"),
-		"string-nil":                     tftypes.NewValue(tftypes.String, nil),
-		"string-nil-computed":            tftypes.NewValue(tftypes.String, nil),
-		"string-nil-optional-computed":   tftypes.NewValue(tftypes.String, nil),
-		"string-value-optional-computed": tftypes.NewValue(tftypes.String, "hello, world"),
-		"object-nil-optional-computed":   tftypes.NewValue(s.Attributes["object-nil-optional-computed"].Type.TerraformType(context.Background()), nil),
+		"string-value":                         tftypes.NewValue(tftypes.String, "hello, world"),
+		"string-nil":                           tftypes.NewValue(tftypes.String, nil),
+		"string-nil-computed":                  tftypes.NewValue(tftypes.String, nil),
+		"string-nil-optional-computed":         tftypes.NewValue(tftypes.String, nil),
+		"string-nil-optional-computed-default": tftypes.NewValue(tftypes.String, nil),
+		"string-value-optional-computed":       tftypes.NewValue(tftypes.String, "hello, world"),
+		"object-nil-optional-computed":         tftypes.NewValue(s.Attributes["object-nil-optional-computed"].Type.TerraformType(context.Background()), nil),
 		"object-value-optional-computed": tftypes.NewValue(s.Attributes["object-value-optional-computed"].Type.TerraformType(context.Background()), map[string]tftypes.Value{
 			"string-nil": tftypes.NewValue(tftypes.String, nil),
 			"string-set": tftypes.NewValue(tftypes.String, "foo"),
@@ -128,12 +143,13 @@ func TestMarkComputedNilsAsUnknown(t *testing.T) {
 		}),
 	})
 	expected := tftypes.NewValue(s.TerraformType(context.Background()), map[string]tftypes.Value{
-		"string-value":                   tftypes.NewValue(tftypes.String, "hello, world"),
-		"string-nil":                     tftypes.NewValue(tftypes.String, nil),
-		"string-nil-computed":            tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-		"string-nil-optional-computed":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-		"string-value-optional-computed": tftypes.NewValue(tftypes.String, "hello, world"),
-		"object-nil-optional-computed":   tftypes.NewValue(s.Attributes["object-nil-optional-computed"].Type.TerraformType(context.Background()), tftypes.UnknownValue),
+		"string-value":                         tftypes.NewValue(tftypes.String, "hello, world"),
+		"string-nil":                           tftypes.NewValue(tftypes.String, nil),
+		"string-nil-computed":                  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"string-nil-optional-computed":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"string-nil-optional-computed-default": tftypes.NewValue(tftypes.String, nil),
+		"string-value-optional-computed":       tftypes.NewValue(tftypes.String, "hello, world"),
+		"object-nil-optional-computed":         tftypes.NewValue(s.Attributes["object-nil-optional-computed"].Type.TerraformType(context.Background()), tftypes.UnknownValue),
 		"object-value-optional-computed": tftypes.NewValue(s.Attributes["object-value-optional-computed"].Type.TerraformType(context.Background()), map[string]tftypes.Value{
 			"string-nil": tftypes.NewValue(tftypes.String, nil),
 			"string-set": tftypes.NewValue(tftypes.String, "foo"),
@@ -165,8 +181,8 @@ func TestNormaliseRequiresReplace(t *testing.T) {
 	t.Parallel()
 
 	type testCase struct {
-		input    []*tftypes.AttributePath
-		expected []*tftypes.AttributePath
+		input    path.Paths
+		expected path.Paths
 	}
 
 	tests := map[string]testCase{
@@ -175,29 +191,29 @@ func TestNormaliseRequiresReplace(t *testing.T) {
 			expected: nil,
 		},
 		"no-duplicates": {
-			input: []*tftypes.AttributePath{
-				tftypes.NewAttributePath().WithAttributeName("name2"),
-				tftypes.NewAttributePath().WithAttributeName("name1"),
-				tftypes.NewAttributePath().WithElementKeyInt(1234),
-				tftypes.NewAttributePath().WithAttributeName("name1").WithElementKeyString("elementkey"),
-			},
-			expected: []*tftypes.AttributePath{
-				tftypes.NewAttributePath().WithAttributeName("name1"),
-				tftypes.NewAttributePath().WithAttributeName("name1").WithElementKeyString("elementkey"),
-				tftypes.NewAttributePath().WithAttributeName("name2"),
-				tftypes.NewAttributePath().WithElementKeyInt(1234),
+			input: path.Paths{
+				path.Root("name2"),
+				path.Root("name1"),
+				path.Empty().AtListIndex(1234),
+				path.Root("name1").AtMapKey("elementkey"),
+			},
+			expected: path.Paths{
+				path.Empty().AtListIndex(1234),
+				path.Root("name1"),
+				path.Root("name1").AtMapKey("elementkey"),
+				path.Root("name2"),
 			},
 		},
 		"duplicates": {
-			input: []*tftypes.AttributePath{
-				tftypes.NewAttributePath().WithAttributeName("name1"),
-				tftypes.NewAttributePath().WithAttributeName("name1"),
-				tftypes.NewAttributePath().WithElementKeyInt(1234),
-				tftypes.NewAttributePath().WithElementKeyInt(1234),
+			input: path.Paths{
+				path.Root("name1"),
+				path.Root("name1"),
+				path.Empty().AtListIndex(1234),
+				path.Empty().AtListIndex(1234),
 			},
-			expected: []*tftypes.AttributePath{
-				tftypes.NewAttributePath().WithAttributeName("name1"),
-				tftypes.NewAttributePath().WithElementKeyInt(1234),
+			expected: path.Paths{
+				path.Empty().AtListIndex(1234),
+				path.Root("name1"),
 			},
 		},
 	}
@@ -229,16 +245,431 @@ func TestNormaliseRequiresReplace(t *testing.T) {
 func TestServerPlanResourceChange(t *testing.T) {
 	t.Parallel()
 
+	testSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_computed": {
+				Computed: true,
+				Type:     types.StringType,
+			},
+			"test_required": {
+				Required: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+			"test_required": tftypes.String,
+		},
+	}
+
+	testConfigValue := tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+		"test_computed": tftypes.NewValue(tftypes.String, nil),
+		"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+	})
+
+	testSchemaDefault := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_default": {
+				Optional: true,
+				Type:     types.StringType,
+				Default: tfsdk.DefaultFunc(
+					func(_ context.Context, req tfsdk.AttributeDefaultRequest, resp *tfsdk.AttributeDefaultResponse) {
+						resp.Diagnostics.AddAttributeError(req.AttributePath, "Unexpected Default Call", "Default should not be called.")
+					},
+					"", "",
+				),
+			},
+			"test_default_configured": {
+				Optional: true,
+				Type:     types.StringType,
+				Default:  tfsdk.StaticDefault(types.String{Value: "test-default-value"}),
+			},
+			"test_optional": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testSchemaDefaultType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_default":            tftypes.String,
+			"test_default_configured": tftypes.String,
+			"test_optional":           tftypes.String,
+		},
+	}
+
+	testConfigDefaultValue := tftypes.NewValue(testSchemaDefaultType, map[string]tftypes.Value{
+		"test_default":            tftypes.NewValue(tftypes.String, nil),
+		"test_default_configured": tftypes.NewValue(tftypes.String, "test-config-value"),
+		"test_optional":           tftypes.NewValue(tftypes.String, nil),
+	})
+
+	testSchemaSemanticEquality := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_computed": {
+				Optional: true,
+				Computed: true,
+				Type:     testtypes.StringTypeWithSemanticEquals{},
+			},
+			"test_list": {
+				Optional: true,
+				Computed: true,
+				Type:     types.ListType{ElemType: testtypes.StringTypeWithSemanticEquals{}},
+			},
+			"test_optional": {
+				Optional: true,
+				Type:     testtypes.StringTypeWithSemanticEquals{},
+			},
+		},
+	}
+
+	testSchemaSemanticEqualityType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+			"test_list":     tftypes.List{ElementType: tftypes.String},
+			"test_optional": tftypes.String,
+		},
+	}
+
+	testSemanticEqualityValue := func(computed, optional string, list ...string) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(list))
+
+		for _, element := range list {
+			elements = append(elements, tftypes.NewValue(tftypes.String, element))
+		}
+
+		return tftypes.NewValue(testSchemaSemanticEqualityType, map[string]tftypes.Value{
+			"test_computed": tftypes.NewValue(tftypes.String, computed),
+			"test_list":     tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements),
+			"test_optional": tftypes.NewValue(tftypes.String, optional),
+		})
+	}
+
+	testSchemaRequiresReplace := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_computed": {
+				Computed: true,
+				Type:     types.StringType,
+			},
+			"test_other": {
+				Optional: true,
+				Type:     types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					planmodifiers.TestErrorDiagModifier{},
+				},
+			},
+			"test_replace": {
+				Required: true,
+				Type:     types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+		},
+	}
+
+	testSchemaRequiresReplaceType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+			"test_other":    tftypes.String,
+			"test_replace":  tftypes.String,
+		},
+	}
+
+	testRequiresReplaceValue := func(computed interface{}, other, replace string) tftypes.Value {
+		return tftypes.NewValue(testSchemaRequiresReplaceType, map[string]tftypes.Value{
+			"test_computed": tftypes.NewValue(tftypes.String, computed),
+			"test_other":    tftypes.NewValue(tftypes.String, other),
+			"test_replace":  tftypes.NewValue(tftypes.String, replace),
+		})
+	}
+
+	testDeferredProvider := &testprovider.Provider{
+		ConfigureMethod: func(_ context.Context, _ tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+			resp.DeferWhenConfigUnknown = true
+		},
+	}
+
+	testResourceType := &testprovider.ResourceType{
+		GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+			return testSchema, nil
+		},
+		NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+			var diags diag.Diagnostics
+
+			diags.AddError("Unexpected NewResource Call", "NewResource should not be called.")
+
+			return nil, diags
+		},
+	}
+
 	testCases := map[string]struct {
-		server           *fwserver.Server
-		request          *fwserver.PlanResourceChangeRequest
-		expectedResponse *fwserver.PlanResourceChangeResponse
+		server                   *fwserver.Server
+		configureProviderRequest *tfsdk.ConfigureProviderRequest
+		request                  *fwserver.PlanResourceChangeRequest
+		expectedResponse         *fwserver.PlanResourceChangeResponse
 	}{
 		"empty-provider": {
 			server: &fwserver.Server{
 				Provider: &emptyprovider.Provider{},
 			},
-			expectedResponse: &fwserver.PlanResourceChangeResponse{},
+			configureProviderRequest: &tfsdk.ConfigureProviderRequest{},
+			expectedResponse:         &fwserver.PlanResourceChangeResponse{},
+		},
+		"semantic-equality-computed-attributes-only": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			configureProviderRequest: &tfsdk.ConfigureProviderRequest{},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testSemanticEqualityValue("PRIOR", "PRIOR", "ONE", "two"),
+					Schema: testSchemaSemanticEquality,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    testSemanticEqualityValue("PRIOR", "PRIOR", "ONE", "two"),
+					Schema: testSchemaSemanticEquality,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testSemanticEqualityValue("prior", "prior", "one", "two"),
+					Schema: testSchemaSemanticEquality,
+				},
+				ResourceSchema: testSchemaSemanticEquality,
+				ResourceType: &testprovider.ResourceType{
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return &testprovider.Resource{}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				// Only the Computed attribute keeps the prior value, since
+				// Terraform requires other planned values, including list
+				// elements, to match the configuration.
+				PlannedState: &tfsdk.State{
+					Raw:    testSemanticEqualityValue("prior", "PRIOR", "ONE", "two"),
+					Schema: testSchemaSemanticEquality,
+				},
+				PlannedPrivate: privatestate.EmptyData(context.Background()),
+			},
+		},
+		"provider-config-deferred-create": {
+			server: &fwserver.Server{
+				Provider: testDeferredProvider,
+			},
+			configureProviderRequest: &tfsdk.ConfigureProviderRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"region": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"region": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+				},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testConfigValue,
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    testConfigValue,
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaType, nil),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				ResourceType:   testResourceType,
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: privatestate.EmptyData(context.Background()),
+			},
+		},
+		"provider-config-deferred-create-default": {
+			server: &fwserver.Server{
+				Provider: testDeferredProvider,
+			},
+			configureProviderRequest: &tfsdk.ConfigureProviderRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testConfigDefaultValue,
+					Schema: testSchemaDefault,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    testConfigDefaultValue,
+					Schema: testSchemaDefault,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaDefaultType, nil),
+					Schema: testSchemaDefault,
+				},
+				ResourceSchema: testSchemaDefault,
+				ResourceType:   testResourceType,
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaDefaultType, map[string]tftypes.Value{
+						"test_default":            tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_default_configured": tftypes.NewValue(tftypes.String, "test-config-value"),
+						"test_optional":           tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchemaDefault,
+				},
+				PlannedPrivate: privatestate.EmptyData(context.Background()),
+			},
+		},
+		"provider-config-deferred-update": {
+			server: &fwserver.Server{
+				Provider: testDeferredProvider,
+			},
+			configureProviderRequest: &tfsdk.ConfigureProviderRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testConfigValue,
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				ResourceType:   testResourceType,
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: privatestate.EmptyData(context.Background()),
+			},
+		},
+		"provider-config-deferred-update-requiresreplace": {
+			server: &fwserver.Server{
+				Provider: testDeferredProvider,
+			},
+			configureProviderRequest: &tfsdk.ConfigureProviderRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testRequiresReplaceValue(nil, "test-other-value", "test-new-value"),
+					Schema: testSchemaRequiresReplace,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    testRequiresReplaceValue("test-computed-value", "test-other-value", "test-new-value"),
+					Schema: testSchemaRequiresReplace,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testRequiresReplaceValue("test-computed-value", "test-other-value", "test-old-value"),
+					Schema: testSchemaRequiresReplace,
+				},
+				ResourceSchema: testSchemaRequiresReplace,
+				ResourceType:   testResourceType,
+			},
+			// Only the RequiresReplace plan modifier is called, so there
+			// is no error diagnostic from the test_other plan modifier.
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw:    testRequiresReplaceValue(tftypes.UnknownValue, "test-other-value", "test-new-value"),
+					Schema: testSchemaRequiresReplace,
+				},
+				PlannedPrivate: privatestate.EmptyData(context.Background()),
+				RequiresReplace: path.Paths{
+					path.Root("test_replace"),
+				},
+			},
+		},
+		"provider-config-deferred-delete": {
+			server: &fwserver.Server{
+				Provider: testDeferredProvider,
+			},
+			configureProviderRequest: &tfsdk.ConfigureProviderRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    tftypes.NewValue(testSchemaType, nil),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    tftypes.NewValue(testSchemaType, nil),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testConfigValue,
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				ResourceType:   testResourceType,
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaType, nil),
+					Schema: testSchema,
+				},
+				PlannedPrivate: privatestate.EmptyData(context.Background()),
+			},
+		},
+		"provider-config-known": {
+			server: &fwserver.Server{
+				Provider: testDeferredProvider,
+			},
+			configureProviderRequest: &tfsdk.ConfigureProviderRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.String, "test-provider-config"),
+				},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testConfigValue,
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				ResourceType:   testResourceType,
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("Unexpected NewResource Call", "NewResource should not be called."),
+				},
+			},
 		},
 	}
 
@@ -248,6 +679,10 @@ func TestServerPlanResourceChange(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
+			if testCase.configureProviderRequest != nil {
+				testCase.server.ConfigureProvider(context.Background(), testCase.configureProviderRequest, &tfsdk.ConfigureProviderResponse{})
+			}
+
 			response := &fwserver.PlanResourceChangeResponse{}
 			testCase.server.PlanResourceChange(context.Background(), testCase.request, response)
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -29,9 +30,33 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 		return
 	}
 
+	dataSourceData, diags := s.DataSourceData(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if s.providerConfigIsDeferred(ctx) {
+		logging.FrameworkDebug(ctx, "Provider configuration is unknown, skipping provider defined DataSource Read")
+
+		resp.Diagnostics.AddError(
+			"Data Source Not Read",
+			"The provider configuration contains values which are not yet known, so the data source cannot be read. "+
+				"Apply any resources the provider configuration depends on first, such as with the -target flag, then try again.",
+		)
+
+		return
+	}
+
 	// Always instantiate new DataSource instances.
 	logging.FrameworkDebug(ctx, "Calling provider defined DataSourceType NewDataSource")
-	dataSource, diags := req.DataSourceType.NewDataSource(ctx, s.Provider)
+	var dataSource tfsdk.DataSource
+
+	callProviderDefined(ctx, &diags, "DataSourceType NewDataSource", path.Empty(), func() {
+		dataSource, diags = req.DataSourceType.NewDataSource(ctx, s.Provider)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined DataSourceType NewDataSource")
 
 	resp.Diagnostics.Append(diags...)
@@ -40,7 +65,9 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 		return
 	}
 
-	readReq := tfsdk.ReadDataSourceRequest{}
+	readReq := tfsdk.ReadDataSourceRequest{
+		ProviderData: dataSourceData,
+	}
 	readResp := tfsdk.ReadDataSourceResponse{
 		State: tfsdk.State{
 			Schema: req.Config.Schema,
@@ -57,7 +84,9 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 	}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined DataSource Read")
-	dataSource.Read(ctx, readReq, &readResp)
+	callProviderDefined(ctx, &readResp.Diagnostics, "DataSource Read", path.Empty(), func() {
+		dataSource.Read(ctx, readReq, &readResp)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined DataSource Read")
 
 	resp.Diagnostics = readResp.Diagnostics
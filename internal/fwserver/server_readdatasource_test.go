@@ -2,11 +2,17 @@ package fwserver_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/emptyprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // TODO: Migrate tfsdk.Provider bits of proto6server.testProviderServer to
@@ -20,10 +26,46 @@ import (
 func TestServerReadDataSource(t *testing.T) {
 	t.Parallel()
 
+	testSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_attribute": {
+				Required: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testConfig := &tfsdk.Config{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"test_attribute": tftypes.String,
+			},
+		}, map[string]tftypes.Value{
+			"test_attribute": tftypes.NewValue(tftypes.String, "test-config-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	testDataSourceType := &testprovider.DataSourceType{
+		GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+			return testSchema, nil
+		},
+		NewDataSourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+			return &testprovider.DataSource{
+				ReadMethod: func(_ context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+					if req.ProviderData != "test-provider-data" {
+						resp.Diagnostics.AddError("Unexpected ProviderData", fmt.Sprintf("Got: %v", req.ProviderData))
+					}
+				},
+			}, nil
+		},
+	}
+
 	testCases := map[string]struct {
-		server           *fwserver.Server
-		request          *fwserver.ReadDataSourceRequest
-		expectedResponse *fwserver.ReadDataSourceResponse
+		server                   *fwserver.Server
+		configureProviderRequest *tfsdk.ConfigureProviderRequest
+		request                  *fwserver.ReadDataSourceRequest
+		expectedResponse         *fwserver.ReadDataSourceResponse
 	}{
 		"empty-provider": {
 			server: &fwserver.Server{
@@ -31,6 +73,72 @@ func TestServerReadDataSource(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ReadDataSourceResponse{},
 		},
+		"provider-not-configured": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:         testConfig,
+				DataSourceType: testDataSourceType,
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Provider Not Configured",
+						"The provider received a request which requires the provider to be configured, before the provider was configured. "+
+							"This is always an issue with Terraform or the Terraform Provider SDK used to implement the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
+		"provider-config-deferred": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ConfigureMethod: func(_ context.Context, _ tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+						resp.DeferWhenConfigUnknown = true
+					},
+				},
+			},
+			configureProviderRequest: &tfsdk.ConfigureProviderRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:         testConfig,
+				DataSourceType: testDataSourceType,
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Data Source Not Read",
+						"The provider configuration contains values which are not yet known, so the data source cannot be read. "+
+							"Apply any resources the provider configuration depends on first, such as with the -target flag, then try again.",
+					),
+				},
+			},
+		},
+		"request-providerdata": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ConfigureMethod: func(_ context.Context, _ tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+						resp.DataSourceData = "test-provider-data"
+						resp.ResourceData = "test-resource-data"
+					},
+				},
+			},
+			configureProviderRequest: &tfsdk.ConfigureProviderRequest{},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:         testConfig,
+				DataSourceType: testDataSourceType,
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State: &tfsdk.State{
+					Raw:    testConfig.Raw,
+					Schema: testSchema,
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -39,6 +147,10 @@ func TestServerReadDataSource(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
+			if testCase.configureProviderRequest != nil {
+				testCase.server.ConfigureProvider(context.Background(), testCase.configureProviderRequest, &tfsdk.ConfigureProviderResponse{})
+			}
+
 			response := &fwserver.ReadDataSourceResponse{}
 			testCase.server.ReadDataSource(context.Background(), testCase.request, response)
 
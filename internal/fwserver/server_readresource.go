@@ -5,6 +5,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -13,7 +15,7 @@ import (
 type ReadResourceRequest struct {
 	CurrentState *tfsdk.State
 	ResourceType tfsdk.ResourceType
-	Private      []byte
+	Private      *privatestate.Data
 	ProviderMeta *tfsdk.Config
 }
 
@@ -22,7 +24,7 @@ type ReadResourceRequest struct {
 type ReadResourceResponse struct {
 	Diagnostics diag.Diagnostics
 	NewState    *tfsdk.State
-	Private     []byte
+	Private     *privatestate.Data
 }
 
 // ReadResource implements the framework server ReadResource RPC.
@@ -41,9 +43,21 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		return
 	}
 
+	resourceData, diags := s.ResourceData(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Always instantiate new Resource instances.
 	logging.FrameworkDebug(ctx, "Calling provider defined ResourceType NewResource")
-	resource, diags := req.ResourceType.NewResource(ctx, s.Provider)
+	var resource tfsdk.Resource
+
+	callProviderDefined(ctx, &diags, "ResourceType NewResource", path.Empty(), func() {
+		resource, diags = req.ResourceType.NewResource(ctx, s.Provider)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined ResourceType NewResource")
 
 	resp.Diagnostics.Append(diags...)
@@ -57,6 +71,7 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 			Schema: req.CurrentState.Schema,
 			Raw:    req.CurrentState.Raw.Copy(),
 		},
+		ProviderData: resourceData,
 	}
 	readResp := tfsdk.ReadResourceResponse{
 		State: tfsdk.State{
@@ -65,14 +80,68 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		},
 	}
 
+	privateData := privatestate.EmptyData(ctx)
+
+	if req.Private != nil {
+		privateData.Framework = req.Private.Framework
+
+		if req.Private.Provider != nil {
+			privateData.Provider = req.Private.Provider
+		}
+	}
+
+	readReq.Private = privateData.Provider
+	readResp.Private = privateData.Provider
+
 	if req.ProviderMeta != nil {
 		readReq.ProviderMeta = *req.ProviderMeta
 	}
 
+	timeout, diags := resourceTimeout(ctx, resource, req.CurrentState.Schema, readReq.State.Raw, resourceOperationRead)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readCtx, cancel := contextWithResourceTimeout(ctx, timeout)
+	defer cancel()
+
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource Read")
-	resource.Read(ctx, readReq, &readResp)
+	callProviderDefined(ctx, &readResp.Diagnostics, "Resource Read", path.Empty(), func() {
+		resource.Read(readCtx, readReq, &readResp)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Resource Read")
 
-	resp.Diagnostics = readResp.Diagnostics
+	readResp.Diagnostics.Append(resourceTimeoutDiags(readCtx, resourceOperationRead, timeout, readResp.Diagnostics)...)
+
+	// Remove the resource from the state, rather than failing the refresh,
+	// if the provider reported the remote object no longer exists.
+	if notFoundDiags, ok := resourceNotFoundDiags(readResp.Diagnostics); ok {
+		logging.FrameworkDebug(ctx, "Resource not found, removing from state")
+
+		readResp.Diagnostics = notFoundDiags
+		readResp.State.RemoveResource(ctx)
+	}
+
+	// Keep any prior state values which are semantically equal to the new
+	// state values.
+	semanticEqualityReq := SchemaSemanticEqualityRequest{
+		PriorValue: readReq.State.Raw,
+		NewValue:   readResp.State.Raw,
+	}
+	semanticEqualityResp := SchemaSemanticEqualityResponse{
+		Diagnostics: readResp.Diagnostics,
+	}
+
+	SchemaSemanticEquality(ctx, readReq.State.Schema, semanticEqualityReq, &semanticEqualityResp)
+
+	readResp.State.Raw = semanticEqualityResp.NewValue
+
+	resp.Diagnostics = semanticEqualityResp.Diagnostics
 	resp.NewState = &readResp.State
+
+	privateData.Provider = readResp.Private
+	resp.Private = privateData
 }
@@ -2,14 +2,37 @@ package fwserver_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/emptyprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+// testNotFoundError is an API client error which implements
+// tfsdk.ResourceNotFoundError.
+type testNotFoundError struct {
+	statusCode int
+}
+
+func (e testNotFoundError) Error() string {
+	return fmt.Sprintf("unexpected status code: %d", e.statusCode)
+}
+
+func (e testNotFoundError) ResourceNotFound() bool {
+	return e.statusCode == 404
+}
+
 // TODO: Migrate tfsdk.Provider bits of proto6server.testProviderServer to
 // new internal/testing/provider.Provider that allows customization of all
 // method implementations via struct fields. Then, create additional test
@@ -21,6 +44,81 @@ import (
 func TestServerReadResource(t *testing.T) {
 	t.Parallel()
 
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+			"test_required": tftypes.String,
+		},
+	}
+
+	testSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_computed": {
+				Computed: true,
+				Type:     types.StringType,
+			},
+			"test_required": {
+				Required: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testCurrentState := &tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+			"test_required": tftypes.NewValue(tftypes.String, "test-required-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	testRemovedState := &tfsdk.State{
+		Raw:    tftypes.NewValue(testType, nil),
+		Schema: testSchema,
+	}
+
+	testResourceType := func(readMethod func(context.Context, tfsdk.ReadResourceRequest, *tfsdk.ReadResourceResponse)) tfsdk.ResourceType {
+		return &testprovider.ResourceType{
+			GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+				return testSchema, nil
+			},
+			NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+				return &testprovider.Resource{
+					ReadMethod: readMethod,
+				}, nil
+			},
+		}
+	}
+
+	testNotFoundWarningDetail := "The remote object was not found, so the resource was removed from the Terraform state. " +
+		"Terraform will propose to create the resource again if it remains in the configuration.\n\n"
+
+	testSchemaSemanticEquality := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_list": {
+				Optional: true,
+				Type:     types.ListType{ElemType: testtypes.StringTypeWithSemanticEquals{}},
+			},
+			"test_semantic": {
+				Optional: true,
+				Type:     testtypes.StringTypeWithSemanticEquals{},
+			},
+		},
+	}
+
+	testSemanticEqualityValue := func(semantic string, list ...string) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(list))
+
+		for _, element := range list {
+			elements = append(elements, tftypes.NewValue(tftypes.String, element))
+		}
+
+		return tftypes.NewValue(testSchemaSemanticEquality.TerraformType(context.Background()), map[string]tftypes.Value{
+			"test_list":     tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements),
+			"test_semantic": tftypes.NewValue(tftypes.String, semantic),
+		})
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ReadResourceRequest
@@ -47,6 +145,161 @@ func TestServerReadResource(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ReadResourceResponse{},
 		},
+		"resource-not-found-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				ResourceType: testResourceType(func(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+					resp.Diagnostics.AddErrorFromError(
+						"Error Reading Widget",
+						fmt.Errorf("%w: widget 123", tfsdk.ErrResourceNotFound),
+					)
+				}),
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Resource Not Found",
+						testNotFoundWarningDetail+"Error Reading Widget: resource not found: widget 123",
+					),
+				},
+				NewState: testRemovedState,
+				Private:  privatestate.EmptyData(context.Background()),
+			},
+		},
+		"resource-not-found-error-with-path": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				ResourceType: testResourceType(func(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+					resp.Diagnostics.Append(diag.WithPath(
+						path.Root("test_required"),
+						diag.NewErrorDiagnosticFromError(
+							"Error Reading Widget",
+							fmt.Errorf("%w: widget 123", tfsdk.ErrResourceNotFound),
+						),
+					))
+				}),
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test_required"),
+						"Resource Not Found",
+						testNotFoundWarningDetail+"Error Reading Widget: resource not found: widget 123",
+					),
+				},
+				NewState: testRemovedState,
+				Private:  privatestate.EmptyData(context.Background()),
+			},
+		},
+		"resource-not-found-error-interface": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				ResourceType: testResourceType(func(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+					resp.Diagnostics.AddWarning("Test Warning", "test warning")
+					resp.Diagnostics.AddErrorFromError(
+						"Error Reading Widget",
+						fmt.Errorf("reading widget 123: %w", testNotFoundError{statusCode: 404}),
+					)
+				}),
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("Test Warning", "test warning"),
+					diag.NewWarningDiagnostic(
+						"Resource Not Found",
+						testNotFoundWarningDetail+"Error Reading Widget: reading widget 123: unexpected status code: 404",
+					),
+				},
+				NewState: testRemovedState,
+				Private:  privatestate.EmptyData(context.Background()),
+			},
+		},
+		"resource-not-found-error-interface-false": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				ResourceType: testResourceType(func(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+					resp.Diagnostics.AddErrorFromError("Error Reading Widget", testNotFoundError{statusCode: 500})
+				}),
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnosticFromError("Error Reading Widget", testNotFoundError{statusCode: 500}),
+				},
+				NewState: testCurrentState,
+				Private:  privatestate.EmptyData(context.Background()),
+			},
+		},
+		"resource-not-found-error-with-other-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				ResourceType: testResourceType(func(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+					resp.Diagnostics.AddErrorFromError("Error Reading Widget", tfsdk.ErrResourceNotFound)
+					resp.Diagnostics.AddError("Error Reading Widget Policy", "test error")
+				}),
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnosticFromError("Error Reading Widget", tfsdk.ErrResourceNotFound),
+					diag.NewErrorDiagnostic("Error Reading Widget Policy", "test error"),
+				},
+				NewState: testCurrentState,
+				Private:  privatestate.EmptyData(context.Background()),
+			},
+		},
+		"resource-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				ResourceType: testResourceType(func(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+					resp.Diagnostics.AddErrorFromError("Error Reading Widget", errors.New("test error"))
+				}),
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnosticFromError("Error Reading Widget", errors.New("test error")),
+				},
+				NewState: testCurrentState,
+				Private:  privatestate.EmptyData(context.Background()),
+			},
+		},
+		"semantic-equality": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw:    testSemanticEqualityValue("prior", "one", "two"),
+					Schema: testSchemaSemanticEquality,
+				},
+				ResourceType: testResourceType(func(_ context.Context, _ tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+					resp.State.Raw = testSemanticEqualityValue("PRIOR", "ONE", "three")
+				}),
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					Raw:    testSemanticEqualityValue("prior", "one", "three"),
+					Schema: testSchemaSemanticEquality,
+				},
+				Private: privatestate.EmptyData(context.Background()),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -55,6 +308,8 @@ func TestServerReadResource(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
+			testCase.server.ConfigureProvider(context.Background(), nil, &tfsdk.ConfigureProviderResponse{})
+
 			response := &fwserver.ReadResourceResponse{}
 			testCase.server.ReadResource(context.Background(), testCase.request, response)
 
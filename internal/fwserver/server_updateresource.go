@@ -5,6 +5,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -13,7 +15,7 @@ import (
 // with the ApplyResourceChange RPC.
 type UpdateResourceRequest struct {
 	Config         *tfsdk.Config
-	PlannedPrivate []byte
+	PlannedPrivate *privatestate.Data
 	PlannedState   *tfsdk.Plan
 	PriorState     *tfsdk.State
 	ProviderMeta   *tfsdk.Config
@@ -26,7 +28,7 @@ type UpdateResourceRequest struct {
 type UpdateResourceResponse struct {
 	Diagnostics diag.Diagnostics
 	NewState    *tfsdk.State
-	Private     []byte
+	Private     *privatestate.Data
 }
 
 // UpdateResource implements the framework server update request logic for the
@@ -36,9 +38,21 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		return
 	}
 
+	resourceData, diags := s.ResourceData(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Always instantiate new Resource instances.
 	logging.FrameworkDebug(ctx, "Calling provider defined ResourceType NewResource")
-	resource, diags := req.ResourceType.NewResource(ctx, s.Provider)
+	var resource tfsdk.Resource
+
+	callProviderDefined(ctx, &diags, "ResourceType NewResource", path.Empty(), func() {
+		resource, diags = req.ResourceType.NewResource(ctx, s.Provider)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined ResourceType NewResource")
 
 	resp.Diagnostics.Append(diags...)
@@ -60,6 +74,7 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 			Schema: req.ResourceSchema,
 			Raw:    tftypes.NewValue(req.ResourceSchema.TerraformType(ctx), nil),
 		},
+		ProviderData: resourceData,
 	}
 	updateResp := tfsdk.UpdateResourceResponse{
 		State: tfsdk.State{
@@ -86,10 +101,55 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		updateReq.ProviderMeta = *req.ProviderMeta
 	}
 
+	privateData := privatestate.EmptyData(ctx)
+
+	if req.PlannedPrivate != nil {
+		privateData.Framework = req.PlannedPrivate.Framework
+
+		if req.PlannedPrivate.Provider != nil {
+			privateData.Provider = req.PlannedPrivate.Provider
+		}
+	}
+
+	updateReq.Private = privateData.Provider
+	updateResp.Private = privateData.Provider
+
+	timeout, diags := resourceTimeout(ctx, resource, req.ResourceSchema, updateReq.Plan.Raw, resourceOperationUpdate)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateCtx, cancel := contextWithResourceTimeout(ctx, timeout)
+	defer cancel()
+
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource Update")
-	resource.Update(ctx, updateReq, &updateResp)
+	callProviderDefined(ctx, &updateResp.Diagnostics, "Resource Update", path.Empty(), func() {
+		resource.Update(updateCtx, updateReq, &updateResp)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Resource Update")
 
-	resp.Diagnostics = updateResp.Diagnostics
+	updateResp.Diagnostics.Append(resourceTimeoutDiags(updateCtx, resourceOperationUpdate, timeout, updateResp.Diagnostics)...)
+
+	// Keep any planned state values which are semantically equal to the new
+	// state values.
+	semanticEqualityReq := SchemaSemanticEqualityRequest{
+		PriorValue: updateReq.Plan.Raw,
+		NewValue:   updateResp.State.Raw,
+	}
+	semanticEqualityResp := SchemaSemanticEqualityResponse{
+		Diagnostics: updateResp.Diagnostics,
+	}
+
+	SchemaSemanticEquality(ctx, req.ResourceSchema, semanticEqualityReq, &semanticEqualityResp)
+
+	updateResp.State.Raw = semanticEqualityResp.NewValue
+
+	resp.Diagnostics = semanticEqualityResp.Diagnostics
 	resp.NewState = &updateResp.State
+
+	privateData.Provider = updateResp.Private
+	resp.Private = privateData
 }
@@ -5,8 +5,15 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/emptyprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // TODO: Migrate tfsdk.Provider bits of proto6server.testProviderServer to
@@ -20,6 +27,32 @@ import (
 func TestServerUpdateResource(t *testing.T) {
 	t.Parallel()
 
+	testSchemaSemanticEquality := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_list": {
+				Optional: true,
+				Type:     types.ListType{ElemType: testtypes.StringTypeWithSemanticEquals{}},
+			},
+			"test_semantic": {
+				Optional: true,
+				Type:     testtypes.StringTypeWithSemanticEquals{},
+			},
+		},
+	}
+
+	testSemanticEqualityValue := func(semantic string, list ...string) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(list))
+
+		for _, element := range list {
+			elements = append(elements, tftypes.NewValue(tftypes.String, element))
+		}
+
+		return tftypes.NewValue(testSchemaSemanticEquality.TerraformType(context.Background()), map[string]tftypes.Value{
+			"test_list":     tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements),
+			"test_semantic": tftypes.NewValue(tftypes.String, semantic),
+		})
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.UpdateResourceRequest
@@ -31,6 +64,38 @@ func TestServerUpdateResource(t *testing.T) {
 			},
 			expectedResponse: &fwserver.UpdateResourceResponse{},
 		},
+		"semantic-equality": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpdateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw:    testSemanticEqualityValue("planned", "one", "two"),
+					Schema: testSchemaSemanticEquality,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testSemanticEqualityValue("prior", "one"),
+					Schema: testSchemaSemanticEquality,
+				},
+				ResourceSchema: testSchemaSemanticEquality,
+				ResourceType: &testprovider.ResourceType{
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return &testprovider.Resource{
+							UpdateMethod: func(_ context.Context, _ tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+								resp.State.Raw = testSemanticEqualityValue("PLANNED", "ONE", "three")
+							},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.UpdateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw:    testSemanticEqualityValue("planned", "one", "three"),
+					Schema: testSchemaSemanticEquality,
+				},
+				Private: privatestate.EmptyData(context.Background()),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -39,6 +104,8 @@ func TestServerUpdateResource(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
+			testCase.server.ConfigureProvider(context.Background(), nil, &tfsdk.ConfigureProviderResponse{})
+
 			response := &fwserver.UpdateResourceResponse{}
 			testCase.server.UpdateResource(context.Background(), testCase.request, response)
 
@@ -5,9 +5,12 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromflatmap"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // UpgradeResourceStateRequest is the framework server request for the
@@ -60,15 +63,12 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 	if req.Version == req.ResourceSchema.Version {
 		logging.FrameworkTrace(ctx, "UpgradeResourceState request version matches current Schema version, using framework defined passthrough implementation")
 
-		resourceSchemaType := req.ResourceSchema.TerraformType(ctx)
-
-		rawStateValue, err := req.RawState.Unmarshal(resourceSchemaType)
+		rawStateValue, err := rawStateUnmarshal(ctx, req.RawState, req.ResourceSchema)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Read Previously Saved State for UpgradeResourceState",
 				"There was an error reading the saved resource state using the current resource schema.\n\n"+
-					"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. "+
 					"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. "+
 					"Otherwise, please report this to the provider developer:\n\n"+err.Error(),
 			)
@@ -83,9 +83,16 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 		return
 	}
 
+	resourceData, _, _ := s.configuredData(ctx)
+
 	// Always instantiate new Resource instances.
 	logging.FrameworkDebug(ctx, "Calling provider defined ResourceType NewResource")
-	resource, diags := req.ResourceType.NewResource(ctx, s.Provider)
+	var resource tfsdk.Resource
+	var diags diag.Diagnostics
+
+	callProviderDefined(ctx, &diags, "ResourceType NewResource", path.Empty(), func() {
+		resource, diags = req.ResourceType.NewResource(ctx, s.Provider)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined ResourceType NewResource")
 
 	resp.Diagnostics.Append(diags...)
@@ -94,6 +101,14 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 		return
 	}
 
+	if resourceWithUpgradeStateSteps, ok := resource.(tfsdk.ResourceWithUpgradeStateSteps); ok {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithUpgradeStateSteps")
+
+		upgradeResourceStateSteps(ctx, resourceWithUpgradeStateSteps, resourceData, req, resp)
+
+		return
+	}
+
 	resourceWithUpgradeState, ok := resource.(tfsdk.ResourceWithUpgradeState)
 
 	if !ok {
@@ -109,9 +124,17 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 	logging.FrameworkTrace(ctx, "Resource implements ResourceWithUpgradeState")
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource UpgradeState")
-	resourceStateUpgraders := resourceWithUpgradeState.UpgradeState(ctx)
+	var resourceStateUpgraders map[int64]tfsdk.ResourceStateUpgrader
+
+	callProviderDefined(ctx, &resp.Diagnostics, "Resource UpgradeState", path.Empty(), func() {
+		resourceStateUpgraders = resourceWithUpgradeState.UpgradeState(ctx)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined Resource UpgradeState")
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Panic prevention
 	if resourceStateUpgraders == nil {
 		resourceStateUpgraders = make(map[int64]tfsdk.ResourceStateUpgrader, 0)
@@ -130,15 +153,14 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 	}
 
 	upgradeResourceStateRequest := tfsdk.UpgradeResourceStateRequest{
-		RawState: req.RawState,
+		ProviderData: resourceData,
+		RawState:     req.RawState,
 	}
 
 	if resourceStateUpgrader.PriorSchema != nil {
 		logging.FrameworkTrace(ctx, "Initializing populated UpgradeResourceStateRequest state from provider defined prior schema and request RawState")
 
-		priorSchemaType := resourceStateUpgrader.PriorSchema.TerraformType(ctx)
-
-		rawStateValue, err := req.RawState.Unmarshal(priorSchemaType)
+		rawStateValue, err := rawStateUnmarshal(ctx, req.RawState, *resourceStateUpgrader.PriorSchema)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -168,7 +190,9 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 	// any errors.
 
 	logging.FrameworkDebug(ctx, "Calling provider defined StateUpgrader")
-	resourceStateUpgrader.StateUpgrader(ctx, upgradeResourceStateRequest, &upgradeResourceStateResponse)
+	callProviderDefined(ctx, &upgradeResourceStateResponse.Diagnostics, "StateUpgrader", path.Empty(), func() {
+		resourceStateUpgrader.StateUpgrader(ctx, upgradeResourceStateRequest, &upgradeResourceStateResponse)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined StateUpgrader")
 
 	resp.Diagnostics.Append(upgradeResourceStateResponse.Diagnostics...)
@@ -177,37 +201,186 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 		return
 	}
 
+	upgradedState, diags := upgradeResourceStateResponseState(ctx, req.ResourceSchema, req.Version, upgradeResourceStateResponse)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.UpgradedState = upgradedState
+}
+
+// upgradeResourceStateSteps calls the state upgrader of each version, in
+// sequence, from the request version until the current schema version.
+func upgradeResourceStateSteps(ctx context.Context, resource tfsdk.ResourceWithUpgradeStateSteps, resourceData interface{}, req *UpgradeResourceStateRequest, resp *UpgradeResourceStateResponse) {
+	if req.Version > req.ResourceSchema.Version {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Resource State",
+			fmt.Sprintf("Terraform requested a resource state upgrade from version %d, which is greater than the current schema version %d. ", req.Version, req.ResourceSchema.Version)+
+				"Resource state cannot be downgraded. "+
+				"This may occur when the resource state was last written by a newer version of the provider.",
+		)
+		return
+	}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Resource UpgradeStateSteps")
+	var resourceStateUpgraders map[int64]tfsdk.ResourceStateUpgrader
+
+	callProviderDefined(ctx, &resp.Diagnostics, "Resource UpgradeStateSteps", path.Empty(), func() {
+		resourceStateUpgraders = resource.UpgradeStateSteps(ctx)
+	})
+	logging.FrameworkDebug(ctx, "Called provider defined Resource UpgradeStateSteps")
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Verify every step before calling any provider defined logic, so a
+	// missing step cannot leave the upgrade partially applied.
+	for version := req.Version; version < req.ResourceSchema.Version; version++ {
+		resourceStateUpgrader, ok := resourceStateUpgraders[version]
+
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unable to Upgrade Resource State",
+				"This resource was implemented with an UpgradeStateSteps() method, "+
+					fmt.Sprintf("however Terraform was expecting an implementation for version %d upgrade.\n\n", version)+
+					"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+			)
+			continue
+		}
+
+		if resourceStateUpgrader.PriorSchema == nil {
+			resp.Diagnostics.AddError(
+				"Unable to Upgrade Resource State",
+				"This resource was implemented with an UpgradeStateSteps() method, "+
+					fmt.Sprintf("however the implementation for version %d upgrade is missing the required PriorSchema.\n\n", version)+
+					"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorSchema := *resourceStateUpgraders[req.Version].PriorSchema
+
+	rawStateValue, err := rawStateUnmarshal(ctx, req.RawState, priorSchema)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Previously Saved State for UpgradeResourceState",
+			fmt.Sprintf("There was an error reading the saved resource state using the prior resource schema defined for version %d upgrade.\n\n", req.Version)+
+				"Please report this to the provider developer:\n\n"+err.Error(),
+		)
+		return
+	}
+
+	upgradeResourceStateRequest := tfsdk.UpgradeResourceStateRequest{
+		ProviderData: resourceData,
+		RawState:     req.RawState,
+		State: &tfsdk.State{
+			Raw:    rawStateValue,
+			Schema: priorSchema,
+		},
+	}
+
+	for version := req.Version; version < req.ResourceSchema.Version; version++ {
+		nextSchema := req.ResourceSchema
+
+		if version+1 < req.ResourceSchema.Version {
+			nextSchema = *resourceStateUpgraders[version+1].PriorSchema
+		}
+
+		upgradeResourceStateResponse := tfsdk.UpgradeResourceStateResponse{
+			State: tfsdk.State{
+				Schema: nextSchema,
+				// Raw is intentionally not set.
+			},
+		}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined StateUpgrader")
+		callProviderDefined(ctx, &upgradeResourceStateResponse.Diagnostics, "StateUpgrader", path.Empty(), func() {
+			resourceStateUpgraders[version].StateUpgrader(ctx, upgradeResourceStateRequest, &upgradeResourceStateResponse)
+		})
+		logging.FrameworkDebug(ctx, "Called provider defined StateUpgrader")
+
+		resp.Diagnostics.Append(upgradeResourceStateResponse.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		upgradedState, diags := upgradeResourceStateResponseState(ctx, nextSchema, version+1, upgradeResourceStateResponse)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// RawState is only available in the stored state version, so later
+		// steps must use the upgraded State of the previous step.
+		upgradeResourceStateRequest = tfsdk.UpgradeResourceStateRequest{
+			ProviderData: resourceData,
+			State:        upgradedState,
+		}
+	}
+
+	resp.UpgradedState = upgradeResourceStateRequest.State
+}
+
+// rawStateUnmarshal returns the RawState data decoded using the given schema.
+// Unlike (tfprotov6.RawState).Unmarshal, this also supports the legacy
+// flatmap format written by Terraform CLI 0.11 and earlier.
+func rawStateUnmarshal(ctx context.Context, rawState *tfprotov6.RawState, schema tfsdk.Schema) (tftypes.Value, error) {
+	if rawState.JSON == nil && rawState.Flatmap != nil {
+		logging.FrameworkTrace(ctx, "RawState is in flatmap format, decoding with framework implementation")
+
+		return fromflatmap.Value(ctx, rawState.Flatmap, schema.TerraformType(ctx))
+	}
+
+	return rawState.Unmarshal(schema.TerraformType(ctx))
+}
+
+// upgradeResourceStateResponseState returns the upgraded State from the
+// provider defined StateUpgrader response, which must match the given
+// schema. The version is only used in diagnostics.
+func upgradeResourceStateResponseState(ctx context.Context, schema tfsdk.Schema, version int64, upgradeResourceStateResponse tfsdk.UpgradeResourceStateResponse) (*tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
 	if upgradeResourceStateResponse.DynamicValue != nil {
 		logging.FrameworkTrace(ctx, "UpgradeResourceStateResponse DynamicValue set, overriding State")
 
-		upgradedStateValue, err := upgradeResourceStateResponse.DynamicValue.Unmarshal(req.ResourceSchema.TerraformType(ctx))
+		upgradedStateValue, err := upgradeResourceStateResponse.DynamicValue.Unmarshal(schema.TerraformType(ctx))
 
 		if err != nil {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Unable to Upgrade Resource State",
-				fmt.Sprintf("After attempting a resource state upgrade to version %d, the provider returned state data that was not compatible with the current schema.\n\n", req.Version)+
+				fmt.Sprintf("After attempting a resource state upgrade to version %d, the provider returned state data that was not compatible with the current schema.\n\n", version)+
 					"This is always an issue with the Terraform Provider and should be reported to the provider developer:\n\n"+err.Error(),
 			)
-			return
+			return nil, diags
 		}
 
-		resp.UpgradedState = &tfsdk.State{
-			Schema: req.ResourceSchema,
+		return &tfsdk.State{
+			Schema: schema,
 			Raw:    upgradedStateValue,
-		}
-
-		return
+		}, diags
 	}
 
 	if upgradeResourceStateResponse.State.Raw.Type() == nil || upgradeResourceStateResponse.State.Raw.IsNull() {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Missing Upgraded Resource State",
-			fmt.Sprintf("After attempting a resource state upgrade to version %d, the provider did not return any state data. ", req.Version)+
+			fmt.Sprintf("After attempting a resource state upgrade to version %d, the provider did not return any state data. ", version)+
 				"Preventing the unexpected loss of resource state data. "+
 				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
 		)
-		return
+		return nil, diags
 	}
 
-	resp.UpgradedState = &upgradeResourceStateResponse.State
+	return &upgradeResourceStateResponse.State, diags
 }
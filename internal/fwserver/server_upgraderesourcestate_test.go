@@ -2,11 +2,18 @@ package fwserver_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/emptyprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // TODO: Migrate tfsdk.Provider bits of proto6server.testProviderServer to
@@ -20,6 +27,140 @@ import (
 func TestServerUpgradeResourceState(t *testing.T) {
 	t.Parallel()
 
+	ctx := context.Background()
+
+	schemaV0 := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed: true,
+				Type:     types.StringType,
+			},
+			"name": {
+				Required: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+	schemaV1 := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"display_name": {
+				Required: true,
+				Type:     types.StringType,
+			},
+			"id": {
+				Computed: true,
+				Type:     types.StringType,
+			},
+		},
+		Version: 1,
+	}
+	schemaV2 := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"display_name": {
+				Required: true,
+				Type:     types.StringType,
+			},
+			"enabled": {
+				Computed: true,
+				Type:     types.BoolType,
+			},
+			"id": {
+				Computed: true,
+				Type:     types.StringType,
+			},
+		},
+		Version: 2,
+	}
+
+	type modelV0 struct {
+		ID   types.String `tfsdk:"id"`
+		Name types.String `tfsdk:"name"`
+	}
+	type modelV1 struct {
+		DisplayName types.String `tfsdk:"display_name"`
+		ID          types.String `tfsdk:"id"`
+	}
+	type modelV2 struct {
+		DisplayName types.String `tfsdk:"display_name"`
+		Enabled     types.Bool   `tfsdk:"enabled"`
+		ID          types.String `tfsdk:"id"`
+	}
+
+	testUpgradeStateSteps := map[int64]tfsdk.ResourceStateUpgrader{
+		0: {
+			PriorSchema: &schemaV0,
+			StateUpgrader: func(ctx context.Context, req tfsdk.UpgradeResourceStateRequest, resp *tfsdk.UpgradeResourceStateResponse) {
+				var priorState modelV0
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, modelV1{
+					DisplayName: priorState.Name,
+					ID:          priorState.ID,
+				})...)
+			},
+		},
+		1: {
+			PriorSchema: &schemaV1,
+			StateUpgrader: func(ctx context.Context, req tfsdk.UpgradeResourceStateRequest, resp *tfsdk.UpgradeResourceStateResponse) {
+				var priorState modelV1
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, modelV2{
+					DisplayName: priorState.DisplayName,
+					Enabled:     types.Bool{Value: true},
+					ID:          priorState.ID,
+				})...)
+			},
+		},
+	}
+
+	testNewRawState := func(jsonMap map[string]interface{}) *tfprotov6.RawState {
+		rawStateJSON, err := json.Marshal(jsonMap)
+
+		if err != nil {
+			t.Fatalf("unable to create RawState JSON: %s", err)
+		}
+
+		return &tfprotov6.RawState{
+			JSON: rawStateJSON,
+		}
+	}
+
+	testResourceType := func(upgradeStateSteps map[int64]tfsdk.ResourceStateUpgrader) tfsdk.ResourceType {
+		return &testprovider.ResourceType{
+			GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+				return schemaV2, nil
+			},
+			NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+				return &testprovider.ResourceWithUpgradeStateSteps{
+					Resource: &testprovider.Resource{},
+					UpgradeStateStepsMethod: func(_ context.Context) map[int64]tfsdk.ResourceStateUpgrader {
+						return upgradeStateSteps
+					},
+				}, nil
+			},
+		}
+	}
+
+	expectedUpgradedState := &tfsdk.State{
+		Raw: tftypes.NewValue(schemaV2.TerraformType(ctx), map[string]tftypes.Value{
+			"display_name": tftypes.NewValue(tftypes.String, "test-name"),
+			"enabled":      tftypes.NewValue(tftypes.Bool, true),
+			"id":           tftypes.NewValue(tftypes.String, "test-id"),
+		}),
+		Schema: schemaV2,
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.UpgradeResourceStateRequest
@@ -31,6 +172,145 @@ func TestServerUpgradeResourceState(t *testing.T) {
 			},
 			expectedResponse: &fwserver.UpgradeResourceStateResponse{},
 		},
+		"ResourceWithUpgradeStateSteps-all-steps": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(map[string]interface{}{
+					"id":   "test-id",
+					"name": "test-name",
+				}),
+				ResourceSchema: schemaV2,
+				ResourceType:   testResourceType(testUpgradeStateSteps),
+				Version:        0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: expectedUpgradedState,
+			},
+		},
+		"ResourceWithUpgradeStateSteps-last-step": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(map[string]interface{}{
+					"display_name": "test-name",
+					"id":           "test-id",
+				}),
+				ResourceSchema: schemaV2,
+				ResourceType:   testResourceType(testUpgradeStateSteps),
+				Version:        1,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: expectedUpgradedState,
+			},
+		},
+		"ResourceWithUpgradeStateSteps-missing-step": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(map[string]interface{}{
+					"id":   "test-id",
+					"name": "test-name",
+				}),
+				ResourceSchema: schemaV2,
+				ResourceType: testResourceType(map[int64]tfsdk.ResourceStateUpgrader{
+					1: testUpgradeStateSteps[1],
+				}),
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource State",
+						"This resource was implemented with an UpgradeStateSteps() method, "+
+							"however Terraform was expecting an implementation for version 0 upgrade.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
+		"ResourceWithUpgradeStateSteps-missing-priorschema": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(map[string]interface{}{
+					"display_name": "test-name",
+					"id":           "test-id",
+				}),
+				ResourceSchema: schemaV2,
+				ResourceType: testResourceType(map[int64]tfsdk.ResourceStateUpgrader{
+					1: {
+						StateUpgrader: testUpgradeStateSteps[1].StateUpgrader,
+					},
+				}),
+				Version: 1,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource State",
+						"This resource was implemented with an UpgradeStateSteps() method, "+
+							"however the implementation for version 1 upgrade is missing the required PriorSchema.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
+		"ResourceWithUpgradeStateSteps-step-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(map[string]interface{}{
+					"id":   "test-id",
+					"name": "test-name",
+				}),
+				ResourceSchema: schemaV2,
+				ResourceType: testResourceType(map[int64]tfsdk.ResourceStateUpgrader{
+					0: testUpgradeStateSteps[0],
+					1: {
+						PriorSchema: &schemaV1,
+						StateUpgrader: func(_ context.Context, _ tfsdk.UpgradeResourceStateRequest, resp *tfsdk.UpgradeResourceStateResponse) {
+							resp.Diagnostics.AddError("error summary", "error detail")
+						},
+					},
+				}),
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("error summary", "error detail"),
+				},
+			},
+		},
+		"ResourceWithUpgradeStateSteps-version-greater-than-schema": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(map[string]interface{}{
+					"display_name": "test-name",
+					"id":           "test-id",
+				}),
+				ResourceSchema: schemaV2,
+				ResourceType:   testResourceType(testUpgradeStateSteps),
+				Version:        3,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource State",
+						"Terraform requested a resource state upgrade from version 3, which is greater than the current schema version 2. "+
+							"Resource state cannot be downgraded. "+
+							"This may occur when the resource state was last written by a newer version of the provider.",
+					),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
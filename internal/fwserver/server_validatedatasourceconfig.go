@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -27,9 +28,16 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 		return
 	}
 
+	_, dataSourceData, _ := s.configuredData(ctx)
+
 	// Always instantiate new DataSource instances.
 	logging.FrameworkDebug(ctx, "Calling provider defined DataSourceType NewDataSource")
-	dataSource, diags := req.DataSourceType.NewDataSource(ctx, s.Provider)
+	var dataSource tfsdk.DataSource
+	var diags diag.Diagnostics
+
+	callProviderDefined(ctx, &diags, "DataSourceType NewDataSource", path.Empty(), func() {
+		dataSource, diags = req.DataSourceType.NewDataSource(ctx, s.Provider)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined DataSourceType NewDataSource")
 
 	resp.Diagnostics.Append(diags...)
@@ -39,32 +47,43 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 	}
 
 	vdscReq := tfsdk.ValidateDataSourceConfigRequest{
-		Config: *req.Config,
+		Config:       *req.Config,
+		ProviderData: dataSourceData,
 	}
 
 	if dataSource, ok := dataSource.(tfsdk.DataSourceWithConfigValidators); ok {
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigValidators")
 
-		for _, configValidator := range dataSource.ConfigValidators(ctx) {
+		var configValidators []tfsdk.DataSourceConfigValidator
+
+		callProviderDefined(ctx, &resp.Diagnostics, "DataSource ConfigValidators", path.Empty(), func() {
+			configValidators = dataSource.ConfigValidators(ctx)
+		})
+
+		for _, configValidator := range configValidators {
 			vdscResp := &tfsdk.ValidateDataSourceConfigResponse{
 				Diagnostics: resp.Diagnostics,
 			}
 
-			logging.FrameworkDebug(
-				ctx,
-				"Calling provider defined DataSourceConfigValidator",
-				map[string]interface{}{
-					logging.KeyDescription: configValidator.Description(ctx),
-				},
-			)
-			configValidator.Validate(ctx, vdscReq, vdscResp)
-			logging.FrameworkDebug(
-				ctx,
-				"Called provider defined DataSourceConfigValidator",
-				map[string]interface{}{
-					logging.KeyDescription: configValidator.Description(ctx),
-				},
-			)
+			callProviderDefined(ctx, &vdscResp.Diagnostics, "DataSourceConfigValidator", path.Empty(), func() {
+				description := configValidator.Description(ctx)
+
+				logging.FrameworkDebug(
+					ctx,
+					"Calling provider defined DataSourceConfigValidator",
+					map[string]interface{}{
+						logging.KeyDescription: description,
+					},
+				)
+				configValidator.Validate(ctx, vdscReq, vdscResp)
+				logging.FrameworkDebug(
+					ctx,
+					"Called provider defined DataSourceConfigValidator",
+					map[string]interface{}{
+						logging.KeyDescription: description,
+					},
+				)
+			})
 
 			resp.Diagnostics = vdscResp.Diagnostics
 		}
@@ -78,14 +97,17 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 		}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined DataSource ValidateConfig")
-		dataSource.ValidateConfig(ctx, vdscReq, vdscResp)
+		callProviderDefined(ctx, &vdscResp.Diagnostics, "DataSource ValidateConfig", path.Empty(), func() {
+			dataSource.ValidateConfig(ctx, vdscReq, vdscResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined DataSource ValidateConfig")
 
 		resp.Diagnostics = vdscResp.Diagnostics
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config:       *req.Config,
+		ProviderData: dataSourceData,
 	}
 	validateSchemaResp := ValidateSchemaResponse{
 		Diagnostics: resp.Diagnostics,
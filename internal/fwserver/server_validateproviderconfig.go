@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -34,26 +35,36 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 	if provider, ok := s.Provider.(tfsdk.ProviderWithConfigValidators); ok {
 		logging.FrameworkTrace(ctx, "Provider implements ProviderWithConfigValidators")
 
-		for _, configValidator := range provider.ConfigValidators(ctx) {
+		var configValidators []tfsdk.ProviderConfigValidator
+
+		callProviderDefined(ctx, &resp.Diagnostics, "Provider ConfigValidators", path.Empty(), func() {
+			configValidators = provider.ConfigValidators(ctx)
+		})
+
+		for _, configValidator := range configValidators {
 			vpcRes := &tfsdk.ValidateProviderConfigResponse{
 				Diagnostics: resp.Diagnostics,
 			}
 
-			logging.FrameworkDebug(
-				ctx,
-				"Calling provider defined ProviderConfigValidator",
-				map[string]interface{}{
-					logging.KeyDescription: configValidator.Description(ctx),
-				},
-			)
-			configValidator.Validate(ctx, vpcReq, vpcRes)
-			logging.FrameworkDebug(
-				ctx,
-				"Called provider defined ProviderConfigValidator",
-				map[string]interface{}{
-					logging.KeyDescription: configValidator.Description(ctx),
-				},
-			)
+			callProviderDefined(ctx, &vpcRes.Diagnostics, "ProviderConfigValidator", path.Empty(), func() {
+				description := configValidator.Description(ctx)
+
+				logging.FrameworkDebug(
+					ctx,
+					"Calling provider defined ProviderConfigValidator",
+					map[string]interface{}{
+						logging.KeyDescription: description,
+					},
+				)
+				configValidator.Validate(ctx, vpcReq, vpcRes)
+				logging.FrameworkDebug(
+					ctx,
+					"Called provider defined ProviderConfigValidator",
+					map[string]interface{}{
+						logging.KeyDescription: description,
+					},
+				)
+			})
 
 			resp.Diagnostics = vpcRes.Diagnostics
 		}
@@ -67,7 +78,9 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 		}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Provider ValidateConfig")
-		provider.ValidateConfig(ctx, vpcReq, vpcRes)
+		callProviderDefined(ctx, &vpcRes.Diagnostics, "Provider ValidateConfig", path.Empty(), func() {
+			provider.ValidateConfig(ctx, vpcReq, vpcRes)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined Provider ValidateConfig")
 
 		resp.Diagnostics = vpcRes.Diagnostics
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -27,9 +28,16 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		return
 	}
 
+	resourceData, _, _ := s.configuredData(ctx)
+
 	// Always instantiate new Resource instances.
 	logging.FrameworkDebug(ctx, "Calling provider defined ResourceType NewResource")
-	resource, diags := req.ResourceType.NewResource(ctx, s.Provider)
+	var resource tfsdk.Resource
+	var diags diag.Diagnostics
+
+	callProviderDefined(ctx, &diags, "ResourceType NewResource", path.Empty(), func() {
+		resource, diags = req.ResourceType.NewResource(ctx, s.Provider)
+	})
 	logging.FrameworkDebug(ctx, "Called provider defined ResourceType NewResource")
 
 	resp.Diagnostics.Append(diags...)
@@ -39,32 +47,43 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 	}
 
 	vdscReq := tfsdk.ValidateResourceConfigRequest{
-		Config: *req.Config,
+		Config:       *req.Config,
+		ProviderData: resourceData,
 	}
 
 	if resource, ok := resource.(tfsdk.ResourceWithConfigValidators); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigValidators")
 
-		for _, configValidator := range resource.ConfigValidators(ctx) {
+		var configValidators []tfsdk.ResourceConfigValidator
+
+		callProviderDefined(ctx, &resp.Diagnostics, "Resource ConfigValidators", path.Empty(), func() {
+			configValidators = resource.ConfigValidators(ctx)
+		})
+
+		for _, configValidator := range configValidators {
 			vdscResp := &tfsdk.ValidateResourceConfigResponse{
 				Diagnostics: resp.Diagnostics,
 			}
 
-			logging.FrameworkDebug(
-				ctx,
-				"Calling provider defined ResourceConfigValidator",
-				map[string]interface{}{
-					logging.KeyDescription: configValidator.Description(ctx),
-				},
-			)
-			configValidator.Validate(ctx, vdscReq, vdscResp)
-			logging.FrameworkDebug(
-				ctx,
-				"Called provider defined ResourceConfigValidator",
-				map[string]interface{}{
-					logging.KeyDescription: configValidator.Description(ctx),
-				},
-			)
+			callProviderDefined(ctx, &vdscResp.Diagnostics, "ResourceConfigValidator", path.Empty(), func() {
+				description := configValidator.Description(ctx)
+
+				logging.FrameworkDebug(
+					ctx,
+					"Calling provider defined ResourceConfigValidator",
+					map[string]interface{}{
+						logging.KeyDescription: description,
+					},
+				)
+				configValidator.Validate(ctx, vdscReq, vdscResp)
+				logging.FrameworkDebug(
+					ctx,
+					"Called provider defined ResourceConfigValidator",
+					map[string]interface{}{
+						logging.KeyDescription: description,
+					},
+				)
+			})
 
 			resp.Diagnostics = vdscResp.Diagnostics
 		}
@@ -78,14 +97,17 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		}
 
 		logging.FrameworkDebug(ctx, "Calling provider defined Resource ValidateConfig")
-		resource.ValidateConfig(ctx, vdscReq, vdscResp)
+		callProviderDefined(ctx, &vdscResp.Diagnostics, "Resource ValidateConfig", path.Empty(), func() {
+			resource.ValidateConfig(ctx, vdscReq, vdscResp)
+		})
 		logging.FrameworkDebug(ctx, "Called provider defined Resource ValidateConfig")
 
 		resp.Diagnostics = vdscResp.Diagnostics
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config:       *req.Config,
+		ProviderData: resourceData,
 	}
 	validateSchemaResp := ValidateSchemaResponse{
 		Diagnostics: resp.Diagnostics,
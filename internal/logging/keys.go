@@ -22,6 +22,25 @@ const (
 	// Underlying Go error string when logging an error.
 	KeyError = "error"
 
+	// Value recovered from a panic in provider defined logic.
+	KeyPanic = "panic"
+
+	// Comma separated states which are expected while waiting for a state
+	// change.
+	KeyPendingStates = "pending_states"
+
 	// The type of resource being operated on, such as "random_pet"
 	KeyResourceType = "tf_resource_type"
+
+	// Goroutine stack trace when logging a recovered panic.
+	KeyStackTrace = "stack_trace"
+
+	// Current state of a remote object while waiting for a state change.
+	KeyState = "state"
+
+	// Comma separated states which complete waiting for a state change.
+	KeyTargetStates = "target_states"
+
+	// Duration string of a wait, such as between state refreshes.
+	KeyWait = "wait"
 )
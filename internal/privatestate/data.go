@@ -0,0 +1,364 @@
+package privatestate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// Data contains private state data for the framework and providers.
+type Data struct {
+	// Potential future usage:
+	// https://github.com/hashicorp/terraform-plugin-framework/issues/74
+	Framework map[string][]byte
+
+	// Provider contains private state data for provider usage.
+	Provider *ProviderData
+}
+
+// Bytes returns a JSON encoded slice of bytes containing the merged
+// framework and provider private state data.
+func (d *Data) Bytes(ctx context.Context) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if d == nil {
+		return nil, nil
+	}
+
+	if (d.Provider == nil || len(d.Provider.data) == 0) && len(d.Framework) == 0 {
+		return nil, nil
+	}
+
+	var providerData map[string][]byte
+
+	if d.Provider != nil {
+		providerData = d.Provider.data
+	}
+
+	mergedMap := make(map[string][]byte, len(d.Framework)+len(providerData))
+
+	for _, m := range []map[string][]byte{d.Framework, providerData} {
+		for k, v := range m {
+			if len(v) == 0 {
+				continue
+			}
+
+			// Values are never stored as invalid UTF-8 or JSON by the
+			// framework, however this is an additional safety check.
+			if !utf8.Valid(v) {
+				diags.AddError(
+					"Error Encoding Private State",
+					fmt.Sprintf("An error was encountered when validating private state value. "+
+						"This is always a problem with terraform-plugin-framework. Please report this to the provider developer:\n\n"+
+						"Value for key %q is not valid UTF-8.", k),
+				)
+
+				continue
+			}
+
+			if !json.Valid(v) {
+				diags.AddError(
+					"Error Encoding Private State",
+					fmt.Sprintf("An error was encountered when validating private state value. "+
+						"This is always a problem with terraform-plugin-framework. Please report this to the provider developer:\n\n"+
+						"Value for key %q is not valid JSON.", k),
+				)
+
+				continue
+			}
+
+			mergedMap[k] = v
+		}
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	bs, err := json.Marshal(mergedMap)
+
+	if err != nil {
+		diags.AddError(
+			"Error Encoding Private State",
+			"An error was encountered when encoding private state. "+
+				"This is always a problem with terraform-plugin-framework. Please report this to the provider developer:\n\n"+
+				err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return bs, diags
+}
+
+// NewData creates a new Data based on the given slice of bytes.
+// It must be a JSON encoded slice of bytes, that is map[string][]byte.
+func NewData(ctx context.Context, data []byte) (*Data, diag.Diagnostics) {
+	var (
+		dataMap map[string][]byte
+		diags   diag.Diagnostics
+	)
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	err := json.Unmarshal(data, &dataMap)
+
+	if err != nil {
+		diags.AddError(
+			"Error Decoding Private State",
+			"An error was encountered when decoding private state. "+
+				"This is always a problem with Terraform or terraform-plugin-framework. Please report this to the provider developer:\n\n"+
+				err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	output := Data{
+		Framework: make(map[string][]byte),
+		Provider: &ProviderData{
+			data: make(map[string][]byte),
+		},
+	}
+
+	for k, v := range dataMap {
+		if !utf8.Valid(v) {
+			diags.AddError(
+				"Error Decoding Private State",
+				fmt.Sprintf("An error was encountered when decoding private state. "+
+					"This is always a problem with Terraform or terraform-plugin-framework. Please report this to the provider developer:\n\n"+
+					"Value for key %q is not valid UTF-8.", k),
+			)
+
+			continue
+		}
+
+		if !json.Valid(v) {
+			diags.AddError(
+				"Error Decoding Private State",
+				fmt.Sprintf("An error was encountered when decoding private state. "+
+					"This is always a problem with Terraform or terraform-plugin-framework. Please report this to the provider developer:\n\n"+
+					"Value for key %q is not valid JSON.", k),
+			)
+
+			continue
+		}
+
+		if isFrameworkKey(k) {
+			output.Framework[k] = v
+
+			continue
+		}
+
+		output.Provider.data[k] = v
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &output, diags
+}
+
+// EmptyData creates an initialised but empty Data.
+func EmptyData(ctx context.Context) *Data {
+	return &Data{
+		Provider: EmptyProviderData(ctx),
+	}
+}
+
+// MustProviderData creates a ProviderData containing the given key/value
+// data. It panics if any key or value is invalid and is intended for testing.
+func MustProviderData(ctx context.Context, data map[string][]byte) *ProviderData {
+	providerData := EmptyProviderData(ctx)
+
+	for key, value := range data {
+		diags := providerData.SetKey(ctx, key, value)
+
+		if diags.HasError() {
+			panic(fmt.Sprintf("unable to set private state key %q: %v", key, diags))
+		}
+	}
+
+	return providerData
+}
+
+// ProviderData contains private state data for provider usage.
+type ProviderData struct {
+	data map[string][]byte
+}
+
+// EmptyProviderData creates a ProviderData containing initialised but empty
+// data.
+func EmptyProviderData(ctx context.Context) *ProviderData {
+	return &ProviderData{
+		data: make(map[string][]byte),
+	}
+}
+
+// Equal returns true if the given ProviderData is exactly equivalent. The
+// internal data is compared byte-for-byte, not accounting for semantic
+// equivalency such as JSON whitespace or property reordering.
+func (d *ProviderData) Equal(o *ProviderData) bool {
+	if d == nil && o == nil {
+		return true
+	}
+
+	if d == nil || o == nil {
+		return false
+	}
+
+	if len(d.data) != len(o.data) {
+		return false
+	}
+
+	for key, dValue := range d.data {
+		oValue, ok := o.data[key]
+
+		if !ok {
+			return false
+		}
+
+		if string(dValue) != string(oValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetKey returns the private state data associated with the given key.
+//
+// If the key is reserved for framework usage, an error diagnostic
+// is returned. If the key is valid, but private state data is not found,
+// nil is returned.
+//
+// The naming of keys only matters in context of a single resource,
+// however care should be taken that any historical keys are not reused
+// without accounting for older resource instances that may still have
+// older data at the key.
+func (d *ProviderData) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	diags := ValidateProviderDataKey(ctx, key)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if d == nil || d.data == nil {
+		return nil, diags
+	}
+
+	value, ok := d.data[key]
+
+	if !ok {
+		return nil, diags
+	}
+
+	return value, diags
+}
+
+// SetKey sets the private state data at the given key.
+//
+// If the key is reserved for framework usage, an error diagnostic
+// is returned. The data must be valid JSON and UTF-8 safe or an error
+// diagnostic is returned.
+//
+// The naming of keys only matters in context of a single resource,
+// however care should be taken that any historical keys are not reused
+// without accounting for older resource instances that may still have
+// older data at the key.
+//
+// Setting a nil or zero-length value removes the key.
+func (d *ProviderData) SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics {
+	diags := ValidateProviderDataKey(ctx, key)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if d == nil {
+		logging.FrameworkError(ctx, "ProviderData SetKey called on nil ProviderData")
+
+		diags.AddError(
+			"Error Setting Private State",
+			"An unexpected error was encountered when setting private state. "+
+				"This is always a problem with terraform-plugin-framework. Please report this to the provider developer:\n\n"+
+				"ProviderData is nil.",
+		)
+
+		return diags
+	}
+
+	if d.data == nil {
+		d.data = make(map[string][]byte)
+	}
+
+	if len(value) == 0 {
+		delete(d.data, key)
+
+		return diags
+	}
+
+	if !utf8.Valid(value) {
+		diags.AddError(
+			"UTF-8 Invalid",
+			"Values stored in private state must be valid UTF-8.\n\n"+
+				fmt.Sprintf("The value being supplied for key %q is invalid. Please verify that the value is valid UTF-8.", key),
+		)
+
+		return diags
+	}
+
+	if !json.Valid(value) {
+		diags.AddError(
+			"JSON Invalid",
+			"Values stored in private state must be valid JSON.\n\n"+
+				fmt.Sprintf("The value being supplied for key %q is invalid. Please verify that the value is valid JSON.", key),
+		)
+
+		return diags
+	}
+
+	d.data[key] = value
+
+	return diags
+}
+
+// ValidateProviderDataKey determines whether the key supplied is allowed on
+// the basis of any restrictions that are in place, such as key prefixes that
+// are reserved for use with framework private state data.
+func ValidateProviderDataKey(ctx context.Context, key string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if key == "" {
+		diags.AddError(
+			"Invalid Private State Key",
+			"Keys used in private state must not be empty.",
+		)
+
+		return diags
+	}
+
+	if isFrameworkKey(key) {
+		diags.AddError(
+			"Restricted Resource Private State Namespace",
+			"Using a period ('.') as a prefix for a key used in private state is not allowed.\n\n"+
+				fmt.Sprintf("The key %q is invalid. Please check the key you are supplying does not use a period ('.') as a prefix.", key),
+		)
+	}
+
+	return diags
+}
+
+// isFrameworkKey returns true if the key is within the namespace reserved
+// for framework private state data, which are keys prefixed with a period.
+func isFrameworkKey(key string) bool {
+	return strings.HasPrefix(key, ".")
+}
@@ -0,0 +1,327 @@
+package privatestate_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+)
+
+func TestDataBytes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data          *privatestate.Data
+		expected      []byte
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			data:     nil,
+			expected: nil,
+		},
+		"empty": {
+			data:     privatestate.EmptyData(context.Background()),
+			expected: nil,
+		},
+		"framework": {
+			data: &privatestate.Data{
+				Framework: map[string][]byte{
+					".frameworkKey": []byte(`{"k": "v"}`),
+				},
+			},
+			expected: []byte(`{".frameworkKey":"eyJrIjogInYifQ=="}`),
+		},
+		"provider": {
+			data: &privatestate.Data{
+				Provider: privatestate.MustProviderData(context.Background(), map[string][]byte{
+					"providerKey": []byte(`{"key": "value"}`),
+				}),
+			},
+			expected: []byte(`{"providerKey":"eyJrZXkiOiAidmFsdWUifQ=="}`),
+		},
+		"framework-and-provider": {
+			data: &privatestate.Data{
+				Framework: map[string][]byte{
+					".frameworkKey": []byte(`{"k": "v"}`),
+				},
+				Provider: privatestate.MustProviderData(context.Background(), map[string][]byte{
+					"providerKey": []byte(`{"key": "value"}`),
+				}),
+			},
+			expected: []byte(`{".frameworkKey":"eyJrIjogInYifQ==","providerKey":"eyJrZXkiOiAidmFsdWUifQ=="}`),
+		},
+		"framework-invalid-json": {
+			data: &privatestate.Data{
+				Framework: map[string][]byte{
+					".frameworkKey": []byte(`{`),
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Error Encoding Private State",
+					"An error was encountered when validating private state value. "+
+						"This is always a problem with terraform-plugin-framework. Please report this to the provider developer:\n\n"+
+						"Value for key \".frameworkKey\" is not valid JSON.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.data.Bytes(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewData(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data          []byte
+		expected      *privatestate.Data
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			data:     nil,
+			expected: nil,
+		},
+		"empty": {
+			data: []byte(`{}`),
+			expected: &privatestate.Data{
+				Framework: map[string][]byte{},
+				Provider:  privatestate.EmptyProviderData(context.Background()),
+			},
+		},
+		"framework-and-provider": {
+			data: []byte(`{".frameworkKey":"eyJrIjogInYifQ==","providerKey":"eyJrZXkiOiAidmFsdWUifQ=="}`),
+			expected: &privatestate.Data{
+				Framework: map[string][]byte{
+					".frameworkKey": []byte(`{"k": "v"}`),
+				},
+				Provider: privatestate.MustProviderData(context.Background(), map[string][]byte{
+					"providerKey": []byte(`{"key": "value"}`),
+				}),
+			},
+		},
+		"invalid-encoding": {
+			data: []byte(`{`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Error Decoding Private State",
+					"An error was encountered when decoding private state. "+
+						"This is always a problem with Terraform or terraform-plugin-framework. Please report this to the provider developer:\n\n"+
+						"unexpected end of JSON input",
+				),
+			},
+		},
+		"invalid-json-value": {
+			// base64 encoding of: {
+			data: []byte(`{"providerKey":"ew=="}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Error Decoding Private State",
+					"An error was encountered when decoding private state. "+
+						"This is always a problem with Terraform or terraform-plugin-framework. Please report this to the provider developer:\n\n"+
+						"Value for key \"providerKey\" is not valid JSON.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := privatestate.NewData(context.Background(), testCase.data)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderDataGetKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerData  *privatestate.ProviderData
+		key           string
+		expected      []byte
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			providerData: nil,
+			key:          "key",
+			expected:     nil,
+		},
+		"key-missing": {
+			providerData: privatestate.EmptyProviderData(context.Background()),
+			key:          "key",
+			expected:     nil,
+		},
+		"key-found": {
+			providerData: privatestate.MustProviderData(context.Background(), map[string][]byte{
+				"key": []byte(`{"key": "value"}`),
+			}),
+			key:      "key",
+			expected: []byte(`{"key": "value"}`),
+		},
+		"key-empty": {
+			providerData: privatestate.EmptyProviderData(context.Background()),
+			key:          "",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Private State Key",
+					"Keys used in private state must not be empty.",
+				),
+			},
+		},
+		"key-reserved": {
+			providerData: privatestate.EmptyProviderData(context.Background()),
+			key:          ".key",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Restricted Resource Private State Namespace",
+					"Using a period ('.') as a prefix for a key used in private state is not allowed.\n\n"+
+						"The key \".key\" is invalid. Please check the key you are supplying does not use a period ('.') as a prefix.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.providerData.GetKey(context.Background(), testCase.key)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderDataSetKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerData  *privatestate.ProviderData
+		key           string
+		value         []byte
+		expected      *privatestate.ProviderData
+		expectedDiags diag.Diagnostics
+	}{
+		"set": {
+			providerData: privatestate.EmptyProviderData(context.Background()),
+			key:          "key",
+			value:        []byte(`{"key": "value"}`),
+			expected: privatestate.MustProviderData(context.Background(), map[string][]byte{
+				"key": []byte(`{"key": "value"}`),
+			}),
+		},
+		"overwrite": {
+			providerData: privatestate.MustProviderData(context.Background(), map[string][]byte{
+				"key": []byte(`{"key": "value"}`),
+			}),
+			key:   "key",
+			value: []byte(`{"key": "new"}`),
+			expected: privatestate.MustProviderData(context.Background(), map[string][]byte{
+				"key": []byte(`{"key": "new"}`),
+			}),
+		},
+		"remove": {
+			providerData: privatestate.MustProviderData(context.Background(), map[string][]byte{
+				"key": []byte(`{"key": "value"}`),
+			}),
+			key:      "key",
+			value:    nil,
+			expected: privatestate.EmptyProviderData(context.Background()),
+		},
+		"key-reserved": {
+			providerData: privatestate.EmptyProviderData(context.Background()),
+			key:          ".key",
+			value:        []byte(`{"key": "value"}`),
+			expected:     privatestate.EmptyProviderData(context.Background()),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Restricted Resource Private State Namespace",
+					"Using a period ('.') as a prefix for a key used in private state is not allowed.\n\n"+
+						"The key \".key\" is invalid. Please check the key you are supplying does not use a period ('.') as a prefix.",
+				),
+			},
+		},
+		"value-invalid-json": {
+			providerData: privatestate.EmptyProviderData(context.Background()),
+			key:          "key",
+			value:        []byte(`{`),
+			expected:     privatestate.EmptyProviderData(context.Background()),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"JSON Invalid",
+					"Values stored in private state must be valid JSON.\n\n"+
+						"The value being supplied for key \"key\" is invalid. Please verify that the value is valid JSON.",
+				),
+			},
+		},
+		"value-invalid-utf8": {
+			providerData: privatestate.EmptyProviderData(context.Background()),
+			key:          "key",
+			value:        []byte{0xff, 0xfe},
+			expected:     privatestate.EmptyProviderData(context.Background()),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"UTF-8 Invalid",
+					"Values stored in private state must be valid UTF-8.\n\n"+
+						"The value being supplied for key \"key\" is invalid. Please verify that the value is valid UTF-8.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.providerData.SetKey(context.Background(), testCase.key, testCase.value)
+
+			if diff := cmp.Diff(testCase.providerData, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
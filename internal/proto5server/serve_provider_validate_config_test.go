@@ -0,0 +1,35 @@
+package proto5server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testServeProviderWithValidateConfig struct {
+	*testServeProvider
+}
+
+func (t *testServeProviderWithValidateConfig) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"string": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}, nil
+}
+
+var testServeProviderWithValidateConfigType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"string": tftypes.String,
+	},
+}
+
+func (p testServeProviderWithValidateConfig) ValidateConfig(ctx context.Context, req tfsdk.ValidateProviderConfigRequest, resp *tfsdk.ValidateProviderConfigResponse) {
+	p.validateProviderConfigImpl(ctx, req, resp)
+}
@@ -0,0 +1,56 @@
+package proto5server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// ApplyResourceChange satisfies the tfprotov5.ProviderServer interface.
+func (s *Server) ApplyResourceChange(ctx context.Context, proto5Req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	ctx = s.registerContext(ctx)
+	ctx = logging.InitContext(ctx)
+	ctx = fwserver.ContextWithRequestInfo(ctx, fwserver.RequestInfo{RPC: "ApplyResourceChange", ResourceType: proto5Req.TypeName})
+
+	fwResp := &fwserver.ApplyResourceChangeResponse{}
+
+	resourceType, diags := s.FrameworkServer.ResourceType(ctx, proto5Req.TypeName)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
+	}
+
+	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto5Req.TypeName)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
+	}
+
+	providerMetaSchema, diags := s.FrameworkServer.ProviderMetaSchema(ctx)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
+	}
+
+	fwReq, diags := fromproto5.ApplyResourceChangeRequest(ctx, proto5Req, resourceType, resourceSchema, providerMetaSchema)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
+	}
+
+	s.FrameworkServer.ApplyResourceChange(ctx, fwReq, fwResp)
+
+	return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
+}
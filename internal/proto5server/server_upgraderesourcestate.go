@@ -0,0 +1,53 @@
+package proto5server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// UpgradeResourceState satisfies the tfprotov5.ProviderServer interface.
+func (s *Server) UpgradeResourceState(ctx context.Context, proto5Req *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
+	ctx = s.registerContext(ctx)
+	ctx = logging.InitContext(ctx)
+
+	fwResp := &fwserver.UpgradeResourceStateResponse{}
+
+	if proto5Req == nil {
+		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
+	}
+
+	ctx = fwserver.ContextWithRequestInfo(ctx, fwserver.RequestInfo{RPC: "UpgradeResourceState", ResourceType: proto5Req.TypeName})
+
+	resourceType, diags := s.FrameworkServer.ResourceType(ctx, proto5Req.TypeName)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
+	}
+
+	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto5Req.TypeName)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
+	}
+
+	fwReq, diags := fromproto5.UpgradeResourceStateRequest(ctx, proto5Req, resourceType, resourceSchema)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
+	}
+
+	s.FrameworkServer.UpgradeResourceState(ctx, fwReq, fwResp)
+
+	return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
+}
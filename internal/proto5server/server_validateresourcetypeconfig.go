@@ -0,0 +1,48 @@
+package proto5server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// ValidateResourceTypeConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *Server) ValidateResourceTypeConfig(ctx context.Context, proto5Req *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	ctx = s.registerContext(ctx)
+	ctx = logging.InitContext(ctx)
+	ctx = fwserver.ContextWithRequestInfo(ctx, fwserver.RequestInfo{RPC: "ValidateResourceTypeConfig", ResourceType: proto5Req.TypeName})
+
+	fwResp := &fwserver.ValidateResourceConfigResponse{}
+
+	resourceType, diags := s.FrameworkServer.ResourceType(ctx, proto5Req.TypeName)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp), nil
+	}
+
+	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto5Req.TypeName)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp), nil
+	}
+
+	fwReq, diags := fromproto5.ValidateResourceTypeConfigRequest(ctx, proto5Req, resourceType, resourceSchema)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp), nil
+	}
+
+	s.FrameworkServer.ValidateResourceConfig(ctx, fwReq, fwResp)
+
+	return toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp), nil
+}
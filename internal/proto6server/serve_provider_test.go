@@ -131,13 +131,13 @@ func (t *testServeProvider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 				Type:     types.Float64Type,
 				Optional: true,
 			},
-			"list-string": {
+			"list_string": {
 				Type: types.ListType{
 					ElemType: types.StringType,
 				},
 				Optional: true,
 			},
-			"list-list-string": {
+			"list_list_string": {
 				Type: types.ListType{
 					ElemType: types.ListType{
 						ElemType: types.StringType,
@@ -145,7 +145,7 @@ func (t *testServeProvider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 				},
 				Optional: true,
 			},
-			"list-object": {
+			"list_object": {
 				Type: types.ListType{
 					ElemType: types.ObjectType{
 						AttrTypes: map[string]attr.Type{
@@ -170,7 +170,7 @@ func (t *testServeProvider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 				},
 				Optional: true,
 			},
-			"empty-object": {
+			"empty_object": {
 				Type:     types.ObjectType{},
 				Optional: true,
 			},
@@ -178,13 +178,13 @@ func (t *testServeProvider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 				Type:     types.MapType{ElemType: types.NumberType},
 				Optional: true,
 			},
-			"set-string": {
+			"set_string": {
 				Type: types.SetType{
 					ElemType: types.StringType,
 				},
 				Optional: true,
 			},
-			"set-set-string": {
+			"set_set_string": {
 				Type: types.SetType{
 					ElemType: types.SetType{
 						ElemType: types.StringType,
@@ -192,7 +192,7 @@ func (t *testServeProvider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 				},
 				Optional: true,
 			},
-			"set-object": {
+			"set_object": {
 				Type: types.SetType{
 					ElemType: types.ObjectType{
 						AttrTypes: map[string]attr.Type{
@@ -205,7 +205,7 @@ func (t *testServeProvider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 				Optional: true,
 			},
 			// TODO: add tuples when we support them
-			"single-nested-attributes": {
+			"single_nested_attributes": {
 				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
 					"foo": {
 						Type:     types.StringType,
@@ -219,7 +219,7 @@ func (t *testServeProvider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 				}),
 				Optional: true,
 			},
-			"list-nested-attributes": {
+			"list_nested_attributes": {
 				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
 					"foo": {
 						Type:     types.StringType,
@@ -233,7 +233,7 @@ func (t *testServeProvider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 				}),
 				Optional: true,
 			},
-			"map-nested-attributes": {
+			"map_nested_attributes": {
 				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
 					"foo": {
 						Type:     types.StringType,
@@ -247,7 +247,7 @@ func (t *testServeProvider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 				}),
 				Optional: true,
 			},
-			"set-nested-attributes": {
+			"set_nested_attributes": {
 				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
 					"foo": {
 						Type:     types.StringType,
@@ -263,7 +263,7 @@ func (t *testServeProvider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 			},
 		},
 		Blocks: map[string]tfsdk.Block{
-			"list-nested-blocks": {
+			"list_nested_blocks": {
 				Attributes: map[string]tfsdk.Attribute{
 					"foo": {
 						Type:     types.StringType,
@@ -277,7 +277,7 @@ func (t *testServeProvider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 				},
 				NestingMode: tfsdk.BlockNestingModeList,
 			},
-			"set-nested-blocks": {
+			"set_nested_blocks": {
 				Attributes: map[string]tfsdk.Attribute{
 					"foo": {
 						Type:     types.StringType,
@@ -308,9 +308,9 @@ var testServeProviderProviderType = tftypes.Object{
 		"bool":              tftypes.Bool,
 		"int64":             tftypes.Number,
 		"float64":           tftypes.Number,
-		"list-string":       tftypes.List{ElementType: tftypes.String},
-		"list-list-string":  tftypes.List{ElementType: tftypes.List{ElementType: tftypes.String}},
-		"list-object": tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"list_string":       tftypes.List{ElementType: tftypes.String},
+		"list_list_string":  tftypes.List{ElementType: tftypes.List{ElementType: tftypes.String}},
+		"list_object": tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 			"foo": tftypes.String,
 			"bar": tftypes.Bool,
 			"baz": tftypes.Number,
@@ -322,35 +322,35 @@ var testServeProviderProviderType = tftypes.Object{
 			"baz":  tftypes.Number,
 			"quux": tftypes.List{ElementType: tftypes.String},
 		}},
-		"set-string":     tftypes.Set{ElementType: tftypes.String},
-		"set-set-string": tftypes.Set{ElementType: tftypes.Set{ElementType: tftypes.String}},
-		"set-object": tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"set_string":     tftypes.Set{ElementType: tftypes.String},
+		"set_set_string": tftypes.Set{ElementType: tftypes.Set{ElementType: tftypes.String}},
+		"set_object": tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 			"foo": tftypes.String,
 			"bar": tftypes.Bool,
 			"baz": tftypes.Number,
 		}}},
-		"empty-object": tftypes.Object{AttributeTypes: map[string]tftypes.Type{}},
-		"single-nested-attributes": tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"empty_object": tftypes.Object{AttributeTypes: map[string]tftypes.Type{}},
+		"single_nested_attributes": tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 			"foo": tftypes.String,
 			"bar": tftypes.Number,
 		}},
-		"list-nested-attributes": tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"list_nested_attributes": tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 			"foo": tftypes.String,
 			"bar": tftypes.Number,
 		}}},
-		"list-nested-blocks": tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"list_nested_blocks": tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 			"foo": tftypes.String,
 			"bar": tftypes.Number,
 		}}},
-		"map-nested-attributes": tftypes.Map{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"map_nested_attributes": tftypes.Map{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 			"foo": tftypes.String,
 			"bar": tftypes.Number,
 		}}},
-		"set-nested-attributes": tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"set_nested_attributes": tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 			"foo": tftypes.String,
 			"bar": tftypes.Number,
 		}}},
-		"set-nested-blocks": tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"set_nested_blocks": tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 			"foo": tftypes.String,
 			"bar": tftypes.Number,
 		}}},
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/planmodifiers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -17,7 +18,8 @@ func (rt testServeResourceTypeAttributePlanModifiers) GetSchema(_ context.Contex
 		Version: 1,
 		Attributes: map[string]tfsdk.Attribute{
 			"name": {
-				Required: true,
+				Optional: true,
+				Computed: true,
 				Type:     types.StringType,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.TestWarningDiagModifier{},
@@ -31,7 +33,7 @@ func (rt testServeResourceTypeAttributePlanModifiers) GetSchema(_ context.Contex
 			"size": {
 				Required: true,
 				Type:     types.NumberType,
-				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplaceIf(func(ctx context.Context, state, config attr.Value, path *tftypes.AttributePath) (bool, diag.Diagnostics) {
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplaceIf(func(ctx context.Context, state, config attr.Value, path path.Path) (bool, diag.Diagnostics) {
 					if state == nil && config == nil {
 						return false, nil
 					}
@@ -60,7 +62,8 @@ func (rt testServeResourceTypeAttributePlanModifiers) GetSchema(_ context.Contex
 				Optional: true,
 				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
 					"id": {
-						Required: true,
+						Optional: true,
+						Computed: true,
 						Type:     types.StringType,
 						PlanModifiers: []tfsdk.AttributePlanModifier{
 							planmodifiers.TestAttrPlanValueModifierTwo{},
@@ -89,6 +92,7 @@ func (rt testServeResourceTypeAttributePlanModifiers) GetSchema(_ context.Contex
 			},
 			"region": {
 				Optional:      true,
+				Computed:      true,
 				Type:          types.StringType,
 				PlanModifiers: []tfsdk.AttributePlanModifier{planmodifiers.TestAttrDefaultValueModifier{}},
 			},
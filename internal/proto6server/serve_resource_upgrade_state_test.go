@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -134,7 +135,7 @@ func (r testServeResourceUpgradeState) UpgradeState(ctx context.Context) map[int
 
 					if err := rawState["optional_attribute"].As(&optionalAttribute); err != nil {
 						resp.Diagnostics.AddAttributeError(
-							tftypes.NewAttributePath().WithAttributeName("optional_attribute"),
+							path.Root("optional_attribute"),
 							"Unable to Convert Prior State",
 							err.Error(),
 						)
@@ -149,7 +150,7 @@ func (r testServeResourceUpgradeState) UpgradeState(ctx context.Context) map[int
 
 				if err := rawState["required_attribute"].As(&requiredAttribute); err != nil {
 					resp.Diagnostics.AddAttributeError(
-						tftypes.NewAttributePath().WithAttributeName("required_attribute"),
+						path.Root("required_attribute"),
 						"Unable to Convert Prior State",
 						err.Error(),
 					)
@@ -14,6 +14,7 @@ import (
 func (s *Server) ApplyResourceChange(ctx context.Context, proto6Req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = fwserver.ContextWithRequestInfo(ctx, fwserver.RequestInfo{RPC: "ApplyResourceChange", ResourceType: proto6Req.TypeName})
 
 	fwResp := &fwserver.ApplyResourceChangeResponse{}
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -71,6 +72,45 @@ func TestServerApplyResourceChange(t *testing.T) {
 				"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
 			}),
 		},
+		"one_create_private": {
+			plannedState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			config: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, nil),
+			}),
+			plannedPrivate: []byte(`{".frameworkKey":"eyJrIjogInYifQ==","providerKey":"eyJrZXkiOiAidmFsdWUifQ=="}`),
+			resource:       "test_one",
+			action:         "create",
+			resourceType:   testServeResourceTypeOneType,
+			create: func(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+				resp.Diagnostics.Append(resp.Private.SetKey(ctx, "createKey", []byte(`{"created": true}`))...)
+
+				resp.State.Raw = tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "hello, world"),
+					"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "red"),
+					}),
+					"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
+				})
+			},
+			expectedNewState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
+			}),
+			expectedPrivate: []byte(`{".frameworkKey":"eyJrIjogInYifQ==","createKey":"eyJjcmVhdGVkIjogdHJ1ZX0=","providerKey":"eyJrZXkiOiAidmFsdWUifQ=="}`),
+		},
 		"one_create_diags": {
 			plannedState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
 				"name": tftypes.NewValue(tftypes.String, "hello, world"),
@@ -98,7 +138,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 					"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
 				})
 				resp.Diagnostics.AddAttributeWarning(
-					tftypes.NewAttributePath().WithAttributeName("favorite_colors").WithElementKeyInt(0),
+					path.Root("favorite_colors").AtListIndex(0),
 					"This is a warning",
 					"I'm warning you",
 				)
@@ -169,6 +209,68 @@ func TestServerApplyResourceChange(t *testing.T) {
 				"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
 			}),
 		},
+		"one_update_private": {
+			priorState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
+			}),
+			plannedState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+					tftypes.NewValue(tftypes.String, "orange"),
+					tftypes.NewValue(tftypes.String, "yellow"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			config: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+					tftypes.NewValue(tftypes.String, "orange"),
+					tftypes.NewValue(tftypes.String, "yellow"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, nil),
+			}),
+			plannedPrivate: []byte(`{".frameworkKey":"eyJrIjogInYifQ==","providerKey":"eyJrZXkiOiAidmFsdWUifQ=="}`),
+			resource:       "test_one",
+			action:         "update",
+			resourceType:   testServeResourceTypeOneType,
+			update: func(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+				value, diags := req.Private.GetKey(ctx, "providerKey")
+
+				resp.Diagnostics.Append(diags...)
+
+				if string(value) != `{"key": "value"}` {
+					resp.Diagnostics.AddError("Unexpected Private Value", string(value))
+				}
+
+				resp.Diagnostics.Append(resp.Private.SetKey(ctx, "providerKey", nil)...)
+
+				resp.State.Raw = tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "hello, world"),
+					"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "red"),
+						tftypes.NewValue(tftypes.String, "orange"),
+						tftypes.NewValue(tftypes.String, "yellow"),
+					}),
+					"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
+				})
+			},
+			expectedNewState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+					tftypes.NewValue(tftypes.String, "orange"),
+					tftypes.NewValue(tftypes.String, "yellow"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
+			}),
+			expectedPrivate: []byte(`{".frameworkKey":"eyJrIjogInYifQ=="}`),
+		},
 		"one_update_diags": {
 			priorState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
 				"name": tftypes.NewValue(tftypes.String, "hello, world"),
@@ -209,7 +311,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 					"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
 				})
 				resp.Diagnostics.AddAttributeWarning(
-					tftypes.NewAttributePath().WithAttributeName("name"),
+					path.Root("name"),
 					"I'm warning you...",
 					"This is a warning!",
 				)
@@ -272,7 +374,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 					"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
 				})
 				resp.Diagnostics.AddAttributeError(
-					tftypes.NewAttributePath().WithAttributeName("name"),
+					path.Root("name"),
 					"Oops!",
 					"This is an error! Don't update the state!",
 				)
@@ -312,6 +414,29 @@ func TestServerApplyResourceChange(t *testing.T) {
 			},
 			expectedNewState: tftypes.NewValue(testServeResourceTypeOneType, nil),
 		},
+		"one_delete_private": {
+			priorState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
+			}),
+			plannedPrivate: []byte(`{".frameworkKey":"eyJrIjogInYifQ==","providerKey":"eyJrZXkiOiAidmFsdWUifQ=="}`),
+			resource:       "test_one",
+			action:         "delete",
+			resourceType:   testServeResourceTypeOneType,
+			destroy: func(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+				value, diags := req.Private.GetKey(ctx, "providerKey")
+
+				resp.Diagnostics.Append(diags...)
+
+				if string(value) != `{"key": "value"}` {
+					resp.Diagnostics.AddError("Unexpected Private Value", string(value))
+				}
+			},
+			expectedNewState: tftypes.NewValue(testServeResourceTypeOneType, nil),
+		},
 		"one_delete_diags": {
 			priorState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
 				"name": tftypes.NewValue(tftypes.String, "hello, world"),
@@ -327,7 +452,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 				// Removing the state prior to the framework should not generate errors
 				resp.State.RemoveResource(ctx)
 				resp.Diagnostics.AddAttributeWarning(
-					tftypes.NewAttributePath().WithAttributeName("created_timestamp"),
+					path.Root("created_timestamp"),
 					"This is a warning",
 					"just a warning diagnostic, no behavior changes",
 				)
@@ -404,7 +529,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 			destroy: func(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
 				// The framework should automatically call resp.State.RemoveResource()
 				resp.Diagnostics.AddAttributeWarning(
-					tftypes.NewAttributePath().WithAttributeName("created_timestamp"),
+					path.Root("created_timestamp"),
 					"This is a warning",
 					"just a warning diagnostic, no behavior changes",
 				)
@@ -1513,6 +1638,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 					Provider: s,
 				},
 			}
+			testServer.FrameworkServer.ConfigureProvider(context.Background(), nil, &tfsdk.ConfigureProviderResponse{})
 			var pmSchema tfsdk.Schema
 			if tc.providerMeta.Type() != nil {
 				testServer.FrameworkServer.Provider = &testServeProviderWithMetaSchema{s}
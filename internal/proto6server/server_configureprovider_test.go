@@ -34,11 +34,11 @@ func TestServerConfigureProvider(t *testing.T) {
 				"bool":              tftypes.NewValue(tftypes.Bool, true),
 				"int64":             tftypes.NewValue(tftypes.Number, 1234),
 				"float64":           tftypes.NewValue(tftypes.Number, 1234),
-				"list-string": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				"list_string": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
 					tftypes.NewValue(tftypes.String, "hello"),
 					tftypes.NewValue(tftypes.String, "world"),
 				}),
-				"list-list-string": tftypes.NewValue(tftypes.List{ElementType: tftypes.List{ElementType: tftypes.String}}, []tftypes.Value{
+				"list_list_string": tftypes.NewValue(tftypes.List{ElementType: tftypes.List{ElementType: tftypes.String}}, []tftypes.Value{
 					tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
 						tftypes.NewValue(tftypes.String, "red"),
 						tftypes.NewValue(tftypes.String, "blue"),
@@ -50,7 +50,7 @@ func TestServerConfigureProvider(t *testing.T) {
 						tftypes.NewValue(tftypes.String, "verde"),
 					}),
 				}),
-				"list-object": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"list_object": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Bool,
 					"baz": tftypes.Number,
@@ -79,7 +79,7 @@ func TestServerConfigureProvider(t *testing.T) {
 					"bar": tftypes.NewValue(tftypes.Number, 456),
 					"baz": tftypes.NewValue(tftypes.Number, 789),
 				}),
-				"map-nested-attributes": tftypes.NewValue(tftypes.Map{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"map_nested_attributes": tftypes.NewValue(tftypes.Map{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"bar": tftypes.Number,
 					"foo": tftypes.String,
 				}}}, map[string]tftypes.Value{
@@ -113,11 +113,11 @@ func TestServerConfigureProvider(t *testing.T) {
 						tftypes.NewValue(tftypes.String, "green"),
 					}),
 				}),
-				"set-string": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				"set_string": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
 					tftypes.NewValue(tftypes.String, "hello"),
 					tftypes.NewValue(tftypes.String, "world"),
 				}),
-				"set-set-string": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Set{ElementType: tftypes.String}}, []tftypes.Value{
+				"set_set_string": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Set{ElementType: tftypes.String}}, []tftypes.Value{
 					tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
 						tftypes.NewValue(tftypes.String, "red"),
 						tftypes.NewValue(tftypes.String, "blue"),
@@ -129,7 +129,7 @@ func TestServerConfigureProvider(t *testing.T) {
 						tftypes.NewValue(tftypes.String, "verde"),
 					}),
 				}),
-				"set-object": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"set_object": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Bool,
 					"baz": tftypes.Number,
@@ -153,15 +153,15 @@ func TestServerConfigureProvider(t *testing.T) {
 						"baz": tftypes.NewValue(tftypes.Number, 8675309),
 					}),
 				}),
-				"empty-object": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}, map[string]tftypes.Value{}),
-				"single-nested-attributes": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"empty_object": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}, map[string]tftypes.Value{}),
+				"single_nested_attributes": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Number,
 				}}, map[string]tftypes.Value{
 					"foo": tftypes.NewValue(tftypes.String, "almost done"),
 					"bar": tftypes.NewValue(tftypes.Number, 12),
 				}),
-				"list-nested-attributes": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"list_nested_attributes": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Number,
 				}}}, []tftypes.Value{
@@ -180,7 +180,7 @@ func TestServerConfigureProvider(t *testing.T) {
 						"bar": tftypes.NewValue(tftypes.Number, 14554216),
 					}),
 				}),
-				"list-nested-blocks": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"list_nested_blocks": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Number,
 				}}}, []tftypes.Value{
@@ -199,7 +199,7 @@ func TestServerConfigureProvider(t *testing.T) {
 						"bar": tftypes.NewValue(tftypes.Number, 14554216),
 					}),
 				}),
-				"set-nested-attributes": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"set_nested_attributes": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Number,
 				}}}, []tftypes.Value{
@@ -218,7 +218,7 @@ func TestServerConfigureProvider(t *testing.T) {
 						"bar": tftypes.NewValue(tftypes.Number, 14554216),
 					}),
 				}),
-				"set-nested-blocks": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"set_nested_blocks": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Number,
 				}}}, []tftypes.Value{
@@ -253,12 +253,12 @@ func TestServerConfigureProvider(t *testing.T) {
 				"bool":              tftypes.NewValue(tftypes.Bool, true),
 				"int64":             tftypes.NewValue(tftypes.Number, 1234),
 				"float64":           tftypes.NewValue(tftypes.Number, 1234),
-				"list-string": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				"list_string": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
 					tftypes.NewValue(tftypes.String, "hello"),
 					tftypes.NewValue(tftypes.String, "world"),
 				}),
-				"list-list-string": tftypes.NewValue(tftypes.List{ElementType: tftypes.List{ElementType: tftypes.String}}, tftypes.UnknownValue),
-				"list-object": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"list_list_string": tftypes.NewValue(tftypes.List{ElementType: tftypes.List{ElementType: tftypes.String}}, tftypes.UnknownValue),
+				"list_object": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Bool,
 					"baz": tftypes.Number,
@@ -274,25 +274,25 @@ func TestServerConfigureProvider(t *testing.T) {
 					"baz":  tftypes.NewValue(tftypes.Number, 123),
 					"quux": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
 				}),
-				"set-string": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				"set_string": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
 					tftypes.NewValue(tftypes.String, "hello"),
 					tftypes.NewValue(tftypes.String, "world"),
 				}),
-				"set-set-string": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Set{ElementType: tftypes.String}}, tftypes.UnknownValue),
-				"set-object": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"set_set_string": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Set{ElementType: tftypes.String}}, tftypes.UnknownValue),
+				"set_object": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Bool,
 					"baz": tftypes.Number,
 				}}}, tftypes.UnknownValue),
-				"empty-object": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}, map[string]tftypes.Value{}),
-				"single-nested-attributes": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"empty_object": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}, map[string]tftypes.Value{}),
+				"single_nested_attributes": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Number,
 				}}, map[string]tftypes.Value{
 					"foo": tftypes.NewValue(tftypes.String, "almost done"),
 					"bar": tftypes.NewValue(tftypes.Number, 12),
 				}),
-				"list-nested-attributes": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"list_nested_attributes": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Number,
 				}}}, tftypes.UnknownValue),
@@ -301,11 +301,11 @@ func TestServerConfigureProvider(t *testing.T) {
 					"bar": tftypes.NewValue(tftypes.Number, 456),
 					"baz": tftypes.NewValue(tftypes.Number, 789),
 				}),
-				"list-nested-blocks": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"list_nested_blocks": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Number,
 				}}}, tftypes.UnknownValue),
-				"map-nested-attributes": tftypes.NewValue(tftypes.Map{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"map_nested_attributes": tftypes.NewValue(tftypes.Map{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"bar": tftypes.Number,
 					"foo": tftypes.String,
 				}}}, map[string]tftypes.Value{
@@ -324,11 +324,11 @@ func TestServerConfigureProvider(t *testing.T) {
 						"foo": tftypes.NewValue(tftypes.String, "moon"),
 					}),
 				}),
-				"set-nested-attributes": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"set_nested_attributes": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Number,
 				}}}, tftypes.UnknownValue),
-				"set-nested-blocks": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"set_nested_blocks": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Number,
 				}}}, tftypes.UnknownValue),
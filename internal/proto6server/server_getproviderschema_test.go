@@ -295,6 +295,11 @@ func TestServerGetProviderSchema_logging(t *testing.T) {
 			"@message": "Called provider defined Provider GetSchema",
 			"@module":  "sdk.framework",
 		},
+		{
+			"@level":   "trace",
+			"@message": "Validating Provider schema implementation",
+			"@module":  "sdk.framework",
+		},
 		{
 			"@level":   "trace",
 			"@message": "Checking ResourceSchemas lock",
@@ -14,6 +14,7 @@ import (
 func (s *Server) ImportResourceState(ctx context.Context, proto6Req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = fwserver.ContextWithRequestInfo(ctx, fwserver.RequestInfo{RPC: "ImportResourceState", ResourceType: proto6Req.TypeName})
 
 	fwResp := &fwserver.ImportResourceStateResponse{}
 
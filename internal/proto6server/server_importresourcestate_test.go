@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -62,6 +63,49 @@ func TestServerImportResourceState(t *testing.T) {
 				},
 			},
 		},
+		"Set-Private": {
+			req: &tfprotov6.ImportResourceStateRequest{
+				ID:       "test",
+				TypeName: "test_import_state",
+			},
+
+			impl: func(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+				state := testServeResourceImportStateData{
+					Id: req.ID,
+				}
+
+				diags := resp.State.Set(ctx, state)
+				resp.Diagnostics.Append(diags...)
+
+				diags = resp.Private.SetKey(ctx, "providerKey", []byte(`{"key": "value"}`))
+				resp.Diagnostics.Append(diags...)
+			},
+			resp: &tfprotov6.ImportResourceStateResponse{
+				ImportedResources: []*tfprotov6.ImportedResource{
+					{
+						State: func() *tfprotov6.DynamicValue {
+							val, err := tfprotov6.NewDynamicValue(
+								testServeResourceTypeImportStateTftype,
+								tftypes.NewValue(
+									testServeResourceTypeImportStateTftype,
+									map[string]tftypes.Value{
+										"id":              tftypes.NewValue(tftypes.String, "test"),
+										"optional_string": tftypes.NewValue(tftypes.String, nil),
+										"required_string": tftypes.NewValue(tftypes.String, ""),
+									},
+								),
+							)
+							if err != nil {
+								panic(err)
+							}
+							return &val
+						}(),
+						Private:  []byte(`{"providerKey":"eyJrZXkiOiAidmFsdWUifQ=="}`),
+						TypeName: "test_import_state",
+					},
+				},
+			},
+		},
 		"SetAttribute": {
 			req: &tfprotov6.ImportResourceStateRequest{
 				ID:       "test",
@@ -69,7 +113,7 @@ func TestServerImportResourceState(t *testing.T) {
 			},
 
 			impl: func(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
-				tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+				tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 			},
 
 			resp: &tfprotov6.ImportResourceStateResponse{
@@ -170,6 +214,7 @@ func TestServerImportResourceState(t *testing.T) {
 					Provider: s,
 				},
 			}
+			testServer.FrameworkServer.ConfigureProvider(context.Background(), nil, &tfsdk.ConfigureProviderResponse{})
 
 			got, err := testServer.ImportResourceState(context.Background(), tc.req)
 
@@ -14,6 +14,7 @@ import (
 func (s *Server) PlanResourceChange(ctx context.Context, proto6Req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = fwserver.ContextWithRequestInfo(ctx, fwserver.RequestInfo{RPC: "PlanResourceChange", ResourceType: proto6Req.TypeName})
 
 	fwResp := &fwserver.PlanResourceChangeResponse{}
 
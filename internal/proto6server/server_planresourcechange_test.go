@@ -7,6 +7,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -110,6 +111,44 @@ func TestServerPlanResourceChange(t *testing.T) {
 				"created_timestamp": tftypes.NewValue(tftypes.String, "when the earth was young"),
 			}),
 		},
+		"one_not_changed_private": {
+			priorState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+					tftypes.NewValue(tftypes.String, "orange"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "when the earth was young"),
+			}),
+			proposedNewState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+					tftypes.NewValue(tftypes.String, "orange"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "when the earth was young"),
+			}),
+			config: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+					tftypes.NewValue(tftypes.String, "orange"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, nil),
+			}),
+			priorPrivate: []byte(`{".frameworkKey":"eyJrIjogInYifQ==","providerKey":"eyJrZXkiOiAidmFsdWUifQ=="}`),
+			resource:     "test_one",
+			resourceType: testServeResourceTypeOneType,
+			expectedPlannedState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+					tftypes.NewValue(tftypes.String, "orange"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "when the earth was young"),
+			}),
+			expectedPlannedPrivate: []byte(`{".frameworkKey":"eyJrIjogInYifQ==","providerKey":"eyJrZXkiOiAidmFsdWUifQ=="}`),
+		},
 		"one_nil_state_and_config": {
 			priorState:           tftypes.NewValue(testServeResourceTypeOneType, nil),
 			proposedNewState:     tftypes.NewValue(testServeResourceTypeOneType, nil),
@@ -126,6 +165,46 @@ func TestServerPlanResourceChange(t *testing.T) {
 			resourceType:         testServeResourceTypeTwoType,
 			expectedPlannedState: tftypes.NewValue(testServeResourceTypeTwoType, nil),
 		},
+		"two_modifyplan_private": {
+			priorState:       tftypes.NewValue(testServeResourceTypeTwoType, nil),
+			proposedNewState: tftypes.NewValue(testServeResourceTypeTwoType, nil),
+			config:           tftypes.NewValue(testServeResourceTypeTwoType, nil),
+			priorPrivate:     []byte(`{".frameworkKey":"eyJrIjogInYifQ==","providerKey":"eyJrZXkiOiAidmFsdWUifQ=="}`),
+			resource:         "test_two",
+			resourceType:     testServeResourceTypeTwoType,
+			modifyPlanFunc: func(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+				value, diags := req.Private.GetKey(ctx, "providerKey")
+
+				resp.Diagnostics.Append(diags...)
+
+				if string(value) != `{"key": "value"}` {
+					resp.Diagnostics.AddError("Unexpected Private Value", string(value))
+				}
+
+				resp.Diagnostics.Append(resp.Private.SetKey(ctx, "planKey", []byte(`{"planned": true}`))...)
+			},
+			expectedPlannedState:   tftypes.NewValue(testServeResourceTypeTwoType, nil),
+			expectedPlannedPrivate: []byte(`{".frameworkKey":"eyJrIjogInYifQ==","planKey":"eyJwbGFubmVkIjogdHJ1ZX0=","providerKey":"eyJrZXkiOiAidmFsdWUifQ=="}`),
+		},
+		"two_modifyplan_private_reserved_key": {
+			priorState:       tftypes.NewValue(testServeResourceTypeTwoType, nil),
+			proposedNewState: tftypes.NewValue(testServeResourceTypeTwoType, nil),
+			config:           tftypes.NewValue(testServeResourceTypeTwoType, nil),
+			resource:         "test_two",
+			resourceType:     testServeResourceTypeTwoType,
+			modifyPlanFunc: func(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+				resp.Diagnostics.Append(resp.Private.SetKey(ctx, ".frameworkKey", []byte(`{}`))...)
+			},
+			expectedPlannedState: tftypes.NewValue(testServeResourceTypeTwoType, nil),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Restricted Resource Private State Namespace",
+					Detail: "Using a period ('.') as a prefix for a key used in private state is not allowed.\n\n" +
+						"The key \".frameworkKey\" is invalid. Please check the key you are supplying does not use a period ('.') as a prefix.",
+				},
+			},
+		},
 		"two_delete": {
 			priorState: tftypes.NewValue(testServeResourceTypeTwoType, map[string]tftypes.Value{
 				"id": tftypes.NewValue(tftypes.String, "123456"),
@@ -861,7 +940,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 				}),
 			}),
 			modifyPlanFunc: func(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
-				resp.RequiresReplace = []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("id")}
+				resp.RequiresReplace = path.Paths{path.Root("id")}
 			},
 			expectedRequiresReplace: []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("id")},
 		},
@@ -1017,7 +1096,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 				}),
 			}),
 			modifyPlanFunc: func(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
-				resp.RequiresReplace = []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("id")}
+				resp.RequiresReplace = path.Paths{path.Root("id")}
 				resp.Diagnostics.AddWarning("I'm warning you", "You have been warned")
 			},
 			expectedRequiresReplace: []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("id")},
@@ -1181,7 +1260,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 				}),
 			}),
 			modifyPlanFunc: func(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
-				resp.RequiresReplace = []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("id")}
+				resp.RequiresReplace = path.Paths{path.Root("id")}
 				resp.Diagnostics.AddError("This is an error", "More details about the error")
 			},
 			expectedRequiresReplace: []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("id")},
@@ -2002,6 +2081,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 					Provider: s,
 				},
 			}
+			testServer.FrameworkServer.ConfigureProvider(context.Background(), nil, &tfsdk.ConfigureProviderResponse{})
 
 			priorStateDV, err := tfprotov6.NewDynamicValue(tc.resourceType, tc.priorState)
 			if err != nil {
@@ -14,6 +14,7 @@ import (
 func (s *Server) ReadDataSource(ctx context.Context, proto6Req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = fwserver.ContextWithRequestInfo(ctx, fwserver.RequestInfo{RPC: "ReadDataSource", DataSourceType: proto6Req.TypeName})
 
 	fwResp := &fwserver.ReadDataSourceResponse{}
 
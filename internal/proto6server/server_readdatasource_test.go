@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -133,7 +134,7 @@ func TestServerReadDataSource(t *testing.T) {
 					"id":     tftypes.NewValue(tftypes.String, "a random id or something I dunno"),
 				})
 				resp.Diagnostics.AddAttributeWarning(
-					tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+					path.Root("disks").AtListIndex(0),
 					"This is a warning",
 					"This is your final warning",
 				)
@@ -179,6 +180,7 @@ func TestServerReadDataSource(t *testing.T) {
 					Provider: s,
 				},
 			}
+			testServer.FrameworkServer.ConfigureProvider(context.Background(), nil, &tfsdk.ConfigureProviderResponse{})
 			var pmSchema tfsdk.Schema
 			if tc.providerMeta.Type() != nil {
 				testServer.FrameworkServer.Provider = &testServeProviderWithMetaSchema{s}
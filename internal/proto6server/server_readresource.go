@@ -14,6 +14,7 @@ import (
 func (s *Server) ReadResource(ctx context.Context, proto6Req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = fwserver.ContextWithRequestInfo(ctx, fwserver.RequestInfo{RPC: "ReadResource", ResourceType: proto6Req.TypeName})
 
 	fwResp := &fwserver.ReadResourceResponse{}
 
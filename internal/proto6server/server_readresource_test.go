@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -62,6 +63,78 @@ func TestServerReadResource(t *testing.T) {
 				"created_timestamp": tftypes.NewValue(tftypes.String, "now"),
 			}),
 		},
+		"one_panic": {
+			currentState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "foo"),
+				"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "a minute ago, but like, as a timestamp"),
+			}),
+			resource:     "test_one",
+			resourceType: testServeResourceTypeOneType,
+
+			impl: func(_ context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+				panic("test panic")
+			},
+
+			expectedNewState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "foo"),
+				"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "a minute ago, but like, as a timestamp"),
+			}),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Summary:  "Provider Panic",
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Detail: "An unexpected panic was recovered while calling provider defined Resource Read during the ReadResource RPC for the \"test_one\" resource type. " +
+						"This is always a problem with the provider and should be reported to the provider developers. " +
+						"The stack trace has been written to the Terraform logs.\n\n" +
+						"Panic: test panic",
+				},
+			},
+		},
+		"one_private": {
+			currentState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "foo"),
+				"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "a minute ago, but like, as a timestamp"),
+			}),
+			private:      []byte(`{".frameworkKey":"eyJrIjogInYifQ==","providerKey":"eyJrZXkiOiAidmFsdWUifQ=="}`),
+			resource:     "test_one",
+			resourceType: testServeResourceTypeOneType,
+
+			impl: func(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+				value, diags := req.Private.GetKey(ctx, "providerKey")
+
+				resp.Diagnostics.Append(diags...)
+
+				if string(value) != `{"key": "value"}` {
+					resp.Diagnostics.AddError("Unexpected Private Value", string(value))
+				}
+
+				resp.Diagnostics.Append(resp.Private.SetKey(ctx, "readKey", []byte(`{"read": true}`))...)
+
+				resp.State.Raw = tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "foo"),
+					"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "red"),
+						tftypes.NewValue(tftypes.String, "orange"),
+						tftypes.NewValue(tftypes.String, "yellow"),
+					}),
+					"created_timestamp": tftypes.NewValue(tftypes.String, "now"),
+				})
+			},
+
+			expectedNewState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "foo"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+					tftypes.NewValue(tftypes.String, "orange"),
+					tftypes.NewValue(tftypes.String, "yellow"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "now"),
+			}),
+			expectedPrivate: []byte(`{".frameworkKey":"eyJrIjogInYifQ==","providerKey":"eyJrZXkiOiAidmFsdWUifQ==","readKey":"eyJyZWFkIjogdHJ1ZX0="}`),
+		},
 		"one_provider_meta": {
 			currentState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
 				"name": tftypes.NewValue(tftypes.String, "my name"),
@@ -295,7 +368,7 @@ func TestServerReadResource(t *testing.T) {
 					}),
 				})
 				resp.Diagnostics.AddAttributeWarning(
-					tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+					path.Root("disks").AtListIndex(0),
 					"This is a warning",
 					"This is your final warning",
 				)
@@ -379,6 +452,7 @@ func TestServerReadResource(t *testing.T) {
 					Provider: s,
 				},
 			}
+			testServer.FrameworkServer.ConfigureProvider(context.Background(), nil, &tfsdk.ConfigureProviderResponse{})
 			var pmSchema tfsdk.Schema
 			if tc.providerMeta.Type() != nil {
 				testServer.FrameworkServer.Provider = &testServeProviderWithMetaSchema{s}
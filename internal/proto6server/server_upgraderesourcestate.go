@@ -21,6 +21,8 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto6Req *tfprotov6.
 		return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
 	}
 
+	ctx = fwserver.ContextWithRequestInfo(ctx, fwserver.RequestInfo{RPC: "UpgradeResourceState", ResourceType: proto6Req.TypeName})
+
 	resourceType, diags := s.FrameworkServer.ResourceType(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
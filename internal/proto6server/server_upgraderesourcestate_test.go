@@ -194,30 +194,67 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				},
 			},
 		},
-		"Version-current-flatmap": {
+		"Version-2-flatmap": {
 			request: &tfprotov6.UpgradeResourceStateRequest{
 				RawState: &tfprotov6.RawState{
 					Flatmap: map[string]string{
-						"flatmap": "is not supported",
+						"id":                 "test-id-value",
+						"required_attribute": "true",
 					},
 				},
-				TypeName: "test_upgrade_state_not_implemented", // Framework should allow non-ResourceWithUpgradeState
-				Version:  1,                                    // Must match current tfsdk.Schema version to trigger framework implementation
+				TypeName: "test_upgrade_state",
+				Version:  2,
+			},
+			expectedResponse: &tfprotov6.UpgradeResourceStateResponse{
+				UpgradedState: testNewDynamicValue(t, schemaType, map[string]tftypes.Value{
+					"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+					"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+					"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+				}),
+			},
+		},
+		"Version-2-flatmap-invalid": {
+			request: &tfprotov6.UpgradeResourceStateRequest{
+				RawState: &tfprotov6.RawState{
+					Flatmap: map[string]string{
+						"id":                 "test-id-value",
+						"required_attribute": "not-a-bool",
+					},
+				},
+				TypeName: "test_upgrade_state",
+				Version:  2,
 			},
 			expectedResponse: &tfprotov6.UpgradeResourceStateResponse{
 				Diagnostics: []*tfprotov6.Diagnostic{
 					{
 						Severity: tfprotov6.DiagnosticSeverityError,
 						Summary:  "Unable to Read Previously Saved State for UpgradeResourceState",
-						Detail: "There was an error reading the saved resource state using the current resource schema.\n\n" +
-							"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. " +
-							"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. " +
-							"Otherwise, please report this to the provider developer:\n\n" +
-							"flatmap states cannot be unmarshaled, only states written by Terraform 0.12 and higher can be unmarshaled",
+						Detail: "There was an error reading the saved resource state using the prior resource schema defined for version 2 upgrade.\n\n" +
+							"Please report this to the provider developer:\n\n" +
+							"invalid bool value for required_attribute: strconv.ParseBool: parsing \"not-a-bool\": invalid syntax",
 					},
 				},
 			},
 		},
+		"Version-current-flatmap": {
+			request: &tfprotov6.UpgradeResourceStateRequest{
+				RawState: &tfprotov6.RawState{
+					Flatmap: map[string]string{
+						"id":                 "test-id-value",
+						"required_attribute": "true",
+					},
+				},
+				TypeName: "test_upgrade_state_not_implemented", // Framework should allow non-ResourceWithUpgradeState
+				Version:  1,                                    // Must match current tfsdk.Schema version to trigger framework implementation
+			},
+			expectedResponse: &tfprotov6.UpgradeResourceStateResponse{
+				UpgradedState: testNewDynamicValue(t, schemaType, map[string]tftypes.Value{
+					"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+					"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+					"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+				}),
+			},
+		},
 		"Version-current-json-match": {
 			request: &tfprotov6.UpgradeResourceStateRequest{
 				RawState: testNewRawState(t, map[string]interface{}{
@@ -249,7 +286,6 @@ func TestServerUpgradeResourceState(t *testing.T) {
 						Severity: tfprotov6.DiagnosticSeverityError,
 						Summary:  "Unable to Read Previously Saved State for UpgradeResourceState",
 						Detail: "There was an error reading the saved resource state using the current resource schema.\n\n" +
-							"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. " +
 							"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. " +
 							"Otherwise, please report this to the provider developer:\n\n" +
 							"ElementKeyValue(tftypes.String<unknown>): unsupported attribute \"nonexistent_attribute\"",
@@ -14,6 +14,7 @@ import (
 func (s *Server) ValidateDataResourceConfig(ctx context.Context, proto6Req *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = fwserver.ContextWithRequestInfo(ctx, fwserver.RequestInfo{RPC: "ValidateDataResourceConfig", DataSourceType: proto6Req.TypeName})
 
 	fwResp := &fwserver.ValidateDataSourceConfigResponse{}
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -91,7 +92,7 @@ func TestServerValidateDataResourceConfig(t *testing.T) {
 			impl: func(_ context.Context, req tfsdk.ValidateDataSourceConfigRequest, resp *tfsdk.ValidateDataSourceConfigResponse) {
 				if len(resp.Diagnostics) == 0 {
 					resp.Diagnostics.AddAttributeWarning(
-						tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+						path.Root("disks").AtListIndex(0),
 						"This is a warning",
 						"This is your final warning",
 					)
@@ -101,7 +102,7 @@ func TestServerValidateDataResourceConfig(t *testing.T) {
 					)
 				} else {
 					resp.Diagnostics.AddAttributeWarning(
-						tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+						path.Root("disks").AtListIndex(0),
 						"This is another warning",
 						"This is really your final warning",
 					)
@@ -179,7 +180,7 @@ func TestServerValidateDataResourceConfig(t *testing.T) {
 
 			impl: func(_ context.Context, req tfsdk.ValidateDataSourceConfigRequest, resp *tfsdk.ValidateDataSourceConfigResponse) {
 				resp.Diagnostics.AddAttributeWarning(
-					tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+					path.Root("disks").AtListIndex(0),
 					"This is a warning",
 					"This is your final warning",
 				)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -38,11 +39,11 @@ func TestServerValidateProviderConfig(t *testing.T) {
 				"bool":              tftypes.NewValue(tftypes.Bool, true),
 				"int64":             tftypes.NewValue(tftypes.Number, 1234),
 				"float64":           tftypes.NewValue(tftypes.Number, 1234),
-				"list-string": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				"list_string": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
 					tftypes.NewValue(tftypes.String, "hello"),
 					tftypes.NewValue(tftypes.String, "world"),
 				}),
-				"list-list-string": tftypes.NewValue(tftypes.List{ElementType: tftypes.List{ElementType: tftypes.String}}, []tftypes.Value{
+				"list_list_string": tftypes.NewValue(tftypes.List{ElementType: tftypes.List{ElementType: tftypes.String}}, []tftypes.Value{
 					tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
 						tftypes.NewValue(tftypes.String, "red"),
 						tftypes.NewValue(tftypes.String, "blue"),
@@ -54,7 +55,7 @@ func TestServerValidateProviderConfig(t *testing.T) {
 						tftypes.NewValue(tftypes.String, "verde"),
 					}),
 				}),
-				"list-object": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"list_object": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Bool,
 					"baz": tftypes.Number,
@@ -93,11 +94,11 @@ func TestServerValidateProviderConfig(t *testing.T) {
 						tftypes.NewValue(tftypes.String, "green"),
 					}),
 				}),
-				"set-string": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				"set_string": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
 					tftypes.NewValue(tftypes.String, "hello"),
 					tftypes.NewValue(tftypes.String, "world"),
 				}),
-				"set-set-string": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Set{ElementType: tftypes.String}}, []tftypes.Value{
+				"set_set_string": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Set{ElementType: tftypes.String}}, []tftypes.Value{
 					tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
 						tftypes.NewValue(tftypes.String, "red"),
 						tftypes.NewValue(tftypes.String, "blue"),
@@ -109,7 +110,7 @@ func TestServerValidateProviderConfig(t *testing.T) {
 						tftypes.NewValue(tftypes.String, "verde"),
 					}),
 				}),
-				"set-object": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"set_object": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Bool,
 					"baz": tftypes.Number,
@@ -133,15 +134,15 @@ func TestServerValidateProviderConfig(t *testing.T) {
 						"baz": tftypes.NewValue(tftypes.Number, 8675309),
 					}),
 				}),
-				"empty-object": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}, map[string]tftypes.Value{}),
-				"single-nested-attributes": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"empty_object": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}, map[string]tftypes.Value{}),
+				"single_nested_attributes": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Number,
 				}}, map[string]tftypes.Value{
 					"foo": tftypes.NewValue(tftypes.String, "almost done"),
 					"bar": tftypes.NewValue(tftypes.Number, 12),
 				}),
-				"list-nested-attributes": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"list_nested_attributes": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Number,
 				}}}, []tftypes.Value{
@@ -160,7 +161,7 @@ func TestServerValidateProviderConfig(t *testing.T) {
 						"bar": tftypes.NewValue(tftypes.Number, 14554216),
 					}),
 				}),
-				"list-nested-blocks": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"list_nested_blocks": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Number,
 				}}}, []tftypes.Value{
@@ -184,7 +185,7 @@ func TestServerValidateProviderConfig(t *testing.T) {
 					"bar": tftypes.NewValue(tftypes.Number, 456),
 					"baz": tftypes.NewValue(tftypes.Number, 789),
 				}),
-				"map-nested-attributes": tftypes.NewValue(tftypes.Map{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"map_nested_attributes": tftypes.NewValue(tftypes.Map{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"bar": tftypes.Number,
 					"foo": tftypes.String,
 				}}}, map[string]tftypes.Value{
@@ -203,7 +204,7 @@ func TestServerValidateProviderConfig(t *testing.T) {
 						"foo": tftypes.NewValue(tftypes.String, "moon"),
 					}),
 				}),
-				"set-nested-attributes": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"set_nested_attributes": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Number,
 				}}}, []tftypes.Value{
@@ -222,7 +223,7 @@ func TestServerValidateProviderConfig(t *testing.T) {
 						"bar": tftypes.NewValue(tftypes.Number, 14554216),
 					}),
 				}),
-				"set-nested-blocks": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"set_nested_blocks": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
 					"foo": tftypes.String,
 					"bar": tftypes.Number,
 				}}}, []tftypes.Value{
@@ -317,7 +318,7 @@ func TestServerValidateProviderConfig(t *testing.T) {
 					validateProviderConfigImpl: func(_ context.Context, req tfsdk.ValidateProviderConfigRequest, resp *tfsdk.ValidateProviderConfigResponse) {
 						if len(resp.Diagnostics) == 0 {
 							resp.Diagnostics.AddAttributeWarning(
-								tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+								path.Root("disks").AtListIndex(0),
 								"This is a warning",
 								"This is your final warning",
 							)
@@ -327,7 +328,7 @@ func TestServerValidateProviderConfig(t *testing.T) {
 							)
 						} else {
 							resp.Diagnostics.AddAttributeWarning(
-								tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+								path.Root("disks").AtListIndex(0),
 								"This is another warning",
 								"This is really your final warning",
 							)
@@ -411,7 +412,7 @@ func TestServerValidateProviderConfig(t *testing.T) {
 				&testServeProvider{
 					validateProviderConfigImpl: func(_ context.Context, req tfsdk.ValidateProviderConfigRequest, resp *tfsdk.ValidateProviderConfigResponse) {
 						resp.Diagnostics.AddAttributeWarning(
-							tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+							path.Root("disks").AtListIndex(0),
 							"This is a warning",
 							"This is your final warning",
 						)
@@ -14,6 +14,7 @@ import (
 func (s *Server) ValidateResourceConfig(ctx context.Context, proto6Req *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = fwserver.ContextWithRequestInfo(ctx, fwserver.RequestInfo{RPC: "ValidateResourceConfig", ResourceType: proto6Req.TypeName})
 
 	fwResp := &fwserver.ValidateResourceConfigResponse{}
 
@@ -0,0 +1,243 @@
+package reflect
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Interface builds a new Go value of the empty interface type of `target`,
+// using the data in `val`. Since the empty interface can hold any value, the
+// Go type of the result is chosen based on the type of `val`:
+//
+//   - strings become string
+//   - numbers become *big.Float
+//   - bools become bool
+//   - lists, sets, and tuples become []interface{}
+//   - maps and objects become map[string]interface{}
+//   - null values become nil
+//
+// This is primarily useful for reading values of attributes whose type is
+// only known at runtime, such as those using tftypes.DynamicPseudoType.
+//
+// Interface is meant to be called from Into, not directly.
+func Interface(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if target.Kind() != reflect.Interface || target.Type().NumMethod() != 0 {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("expected an empty interface type, got %s", target.Type()),
+		}))
+		return target, diags
+	}
+
+	goVal, err := interfaceFromTerraform(val, opts, path)
+	if err != nil {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: target.Type(),
+			Err:        err,
+		}))
+		return target, diags
+	}
+
+	result := reflect.New(target.Type()).Elem()
+	if goVal != nil {
+		result.Set(reflect.ValueOf(goVal))
+	}
+	return result, diags
+}
+
+// interfaceFromTerraform recursively converts `val` into the Go value
+// documented on Interface.
+func interfaceFromTerraform(val tftypes.Value, opts Options, path path.Path) (interface{}, error) {
+	if !val.IsKnown() {
+		if !opts.UnhandledUnknownAsEmpty {
+			return nil, pathErrorf(path, "unhandled unknown value")
+		}
+		return nil, nil
+	}
+
+	if val.IsNull() {
+		return nil, nil
+	}
+
+	typ := val.Type()
+
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		err := val.As(&s)
+		return s, err
+	case typ.Is(tftypes.Number):
+		n := big.NewFloat(0)
+		err := val.As(&n)
+		return n, err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := val.As(&b)
+		return b, err
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := val.As(&elems); err != nil {
+			return nil, err
+		}
+		result := make([]interface{}, 0, len(elems))
+		for pos, elem := range elems {
+			elemPath := path.AtListIndex(pos)
+			if typ.Is(tftypes.Set{}) {
+				elemPath = path.AtSetValue(elem)
+			}
+			goElem, err := interfaceFromTerraform(elem, opts, elemPath)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, goElem)
+		}
+		return result, nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		if err := val.As(&elems); err != nil {
+			return nil, err
+		}
+		result := make(map[string]interface{}, len(elems))
+		for key, elem := range elems {
+			elemPath := path.AtName(key)
+			if typ.Is(tftypes.Map{}) {
+				elemPath = path.AtMapKey(key)
+			}
+			goElem, err := interfaceFromTerraform(elem, opts, elemPath)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = goElem
+		}
+		return result, nil
+	default:
+		return nil, pathErrorf(path, "don't know how to reflect %s into an interface", typ)
+	}
+}
+
+// FromDynamic returns an attr.Value as produced by `typ` using the data in
+// `val`, where `typ` is a type whose Terraform type is
+// tftypes.DynamicPseudoType. Since there is no element or attribute type
+// information available, a nil `val` becomes a null value, slices and arrays
+// become tuples, and maps with string keys become objects. FromDynamic will
+// recurse into FromValue for each element, using `typ` as the element type.
+//
+// It is meant to be called through FromValue, not directly.
+func FromDynamic(ctx context.Context, typ attr.Type, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var tfVal tftypes.Value
+
+	switch {
+	case !val.IsValid(), (val.Kind() == reflect.Slice || val.Kind() == reflect.Map) && val.IsNil():
+		tfVal = tftypes.NewValue(tftypes.DynamicPseudoType, nil)
+	case val.Kind() == reflect.Slice, val.Kind() == reflect.Array:
+		elemTypes := make([]tftypes.Type, 0, val.Len())
+		elemVals := make([]tftypes.Value, 0, val.Len())
+
+		for i := 0; i < val.Len(); i++ {
+			elemVal, elemDiags := fromDynamicElement(ctx, typ, val.Index(i), path.AtListIndex(i))
+			diags.Append(elemDiags...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			elemTypes = append(elemTypes, elemVal.Type())
+			elemVals = append(elemVals, elemVal)
+		}
+
+		tfVal = tftypes.NewValue(tftypes.Tuple{ElementTypes: elemTypes}, elemVals)
+	case val.Kind() == reflect.Map:
+		if val.Type().Key().Kind() != reflect.String {
+			err := fmt.Errorf("map keys must be strings, got %s", val.Type().Key())
+			diags.AddAttributeError(
+				path,
+				"Value Conversion Error",
+				"An unexpected error was encountered trying to convert from dynamic value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return nil, diags
+		}
+
+		keys := make([]string, 0, val.Len())
+		for _, key := range val.MapKeys() {
+			keys = append(keys, key.String())
+		}
+		sort.Strings(keys)
+
+		attrTypes := make(map[string]tftypes.Type, len(keys))
+		attrVals := make(map[string]tftypes.Value, len(keys))
+
+		for _, key := range keys {
+			attrVal, attrDiags := fromDynamicElement(ctx, typ, val.MapIndex(reflect.ValueOf(key).Convert(val.Type().Key())), path.AtName(key))
+			diags.Append(attrDiags...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			attrTypes[key] = attrVal.Type()
+			attrVals[key] = attrVal
+		}
+
+		tfVal = tftypes.NewValue(tftypes.Object{AttributeTypes: attrTypes}, attrVals)
+	default:
+		err := fmt.Errorf("cannot use %s as a dynamic value", val.Type())
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert from dynamic value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, diags
+	}
+
+	if typeWithValidate, ok := typ.(attr.TypeWithValidate); ok {
+		diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	attrVal, err := typ.ValueFromTerraform(ctx, tfVal)
+	if err != nil {
+		return nil, append(diags, valueFromTerraformErrorDiag(err, path))
+	}
+
+	return attrVal, diags
+}
+
+// fromDynamicElement converts a single element of a slice or map into a
+// tftypes.Value by recursing into FromValue with the dynamic type `typ`.
+func fromDynamicElement(ctx context.Context, typ attr.Type, val reflect.Value, path path.Path) (tftypes.Value, diag.Diagnostics) {
+	var elem interface{}
+
+	// elements of []interface{} and map[string]interface{} are interfaces
+	// themselves, which may be nil
+	if val.Kind() != reflect.Interface || !val.IsNil() {
+		elem = val.Interface()
+	}
+
+	attrVal, diags := FromValue(ctx, typ, elem, path)
+	if diags.HasError() {
+		return tftypes.Value{}, diags
+	}
+
+	tfVal, err := attrVal.ToTerraformValue(ctx)
+	if err != nil {
+		return tftypes.Value{}, append(diags, toTerraformValueErrorDiag(err, path))
+	}
+
+	return tfVal, diags
+}
@@ -0,0 +1,195 @@
+package reflect_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestInterface(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val      tftypes.Value
+		expected interface{}
+	}{
+		"string": {
+			val:      tftypes.NewValue(tftypes.String, "hello"),
+			expected: "hello",
+		},
+		"number": {
+			val:      tftypes.NewValue(tftypes.Number, 123),
+			expected: big.NewFloat(123),
+		},
+		"bool": {
+			val:      tftypes.NewValue(tftypes.Bool, true),
+			expected: true,
+		},
+		"null": {
+			val:      tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+			expected: nil,
+		},
+		"object": {
+			val: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"list": tftypes.List{ElementType: tftypes.String},
+					"map":  tftypes.Map{ElementType: tftypes.Bool},
+					"null": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+					tftypes.NewValue(tftypes.String, "b"),
+				}),
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.Bool}, map[string]tftypes.Value{
+					"key": tftypes.NewValue(tftypes.Bool, false),
+				}),
+				"null": tftypes.NewValue(tftypes.String, nil),
+			}),
+			expected: map[string]interface{}{
+				"list": []interface{}{"a", "b"},
+				"map": map[string]interface{}{
+					"key": false,
+				},
+				"null": nil,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var target interface{}
+			diags := refl.Into(context.Background(), types.DynamicType{}, tc.val, &target, refl.Options{})
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(target, tc.expected, cmp.Comparer(func(x, y *big.Float) bool { return x.Cmp(y) == 0 })); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestInterface_notEmptyInterface(t *testing.T) {
+	t.Parallel()
+
+	var target attr.Type
+	val := tftypes.NewValue(tftypes.String, "hello")
+	expectedDiags := diag.Diagnostics{
+		diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: reflect.TypeOf(&target).Elem(),
+			Err:        fmt.Errorf("expected an empty interface type, got attr.Type"),
+		}),
+	}
+
+	_, diags := refl.Interface(context.Background(), types.DynamicType{}, val, reflect.ValueOf(&target).Elem(), refl.Options{}, path.Empty())
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFromValue_dynamic(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val      interface{}
+		expected attr.Value
+	}{
+		"nil": {
+			val: nil,
+			expected: types.Dynamic{
+				Null: true,
+			},
+		},
+		"string": {
+			val: "hello",
+			expected: types.Dynamic{
+				Value: types.String{Value: "hello"},
+			},
+		},
+		"slice": {
+			val: []interface{}{"hello", true, nil},
+			expected: types.Dynamic{
+				Value: types.Tuple{
+					ElemTypes: []attr.Type{types.StringType, types.BoolType, types.DynamicType{}},
+					Elems: []attr.Value{
+						types.String{Value: "hello"},
+						types.Bool{Value: true},
+						types.Dynamic{Null: true},
+					},
+				},
+			},
+		},
+		"map": {
+			val: map[string]interface{}{
+				"string": "hello",
+				"nested": map[string]bool{
+					"bool": false,
+				},
+			},
+			expected: types.Dynamic{
+				Value: types.Object{
+					AttrTypes: map[string]attr.Type{
+						"string": types.StringType,
+						"nested": types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"bool": types.BoolType,
+							},
+						},
+					},
+					Attrs: map[string]attr.Value{
+						"string": types.String{Value: "hello"},
+						"nested": types.Object{
+							AttrTypes: map[string]attr.Type{
+								"bool": types.BoolType,
+							},
+							Attrs: map[string]attr.Value{
+								"bool": types.Bool{Value: false},
+							},
+						},
+					},
+				},
+			},
+		},
+		"attr-value": {
+			val:      types.Int64{Value: 123},
+			expected: types.Int64{Value: 123},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := refl.FromValue(context.Background(), types.DynamicType{}, tc.val, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -19,8 +20,11 @@ import (
 // method. Structs use reflection: each exported struct field must have a
 // "tfsdk" tag with the name of the field in the tftypes.Value, and all fields
 // in the tftypes.Value must have a corresponding property in the struct. Into
-// will be called for each struct field. Slices will have Into called for each
-// element.
+// will be called for each struct field. Tuples may also populate structs, in
+// which case the exported struct fields are matched to the tuple elements by
+// position. Slices will have Into called for each element. Empty interface
+// targets are populated with Go values chosen based on the type of `val`, see
+// Interface.
 func Into(ctx context.Context, typ attr.Type, val tftypes.Value, target interface{}, opts Options) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -33,7 +37,7 @@ func Into(ctx context.Context, typ attr.Type, val tftypes.Value, target interfac
 		)
 		return diags
 	}
-	result, diags := BuildValue(ctx, typ, val, v.Elem(), opts, tftypes.NewAttributePath())
+	result, diags := BuildValue(ctx, typ, val, v.Elem(), opts, path.Empty())
 	if diags.HasError() {
 		return diags
 	}
@@ -46,7 +50,7 @@ func Into(ctx context.Context, typ attr.Type, val tftypes.Value, target interfac
 // to set, making it safe for use with pointer types which may be nil. It tries
 // to give consumers the ability to override its default behaviors wherever
 // possible.
-func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path *tftypes.AttributePath) (reflect.Value, diag.Diagnostics) {
+func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// if this isn't a valid reflect.Value, bail before we accidentally
@@ -139,6 +143,11 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		)
 		return target, diags
 	}
+	// time.Time, time.Duration, net.IP, and net.IPNet are structs,
+	// numbers, or slices, but we want them parsed from strings
+	if isStandardLibraryString(target.Type(), val.Type()) {
+		return StandardLibraryString(ctx, typ, val, target, path)
+	}
 	// *big.Float and *big.Int are technically pointers, but we want them
 	// handled as numbers
 	if target.Type() == reflect.TypeOf(big.NewFloat(0)) || target.Type() == reflect.TypeOf(big.NewInt(0)) {
@@ -146,6 +155,11 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 	}
 	switch target.Kind() {
 	case reflect.Struct:
+		if val.Type().Is(tftypes.Tuple{}) {
+			val, valDiags := TupleStruct(ctx, typ, val, target, opts, path)
+			diags.Append(valDiags...)
+			return val, diags
+		}
 		val, valDiags := Struct(ctx, typ, val, target, opts, path)
 		diags.Append(valDiags...)
 		return val, diags
@@ -177,6 +191,10 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		val, valDiags := Pointer(ctx, typ, val, target, opts, path)
 		diags.Append(valDiags...)
 		return val, diags
+	case reflect.Interface:
+		val, valDiags := Interface(ctx, typ, val, target, opts, path)
+		diags.Append(valDiags...)
+		return val, diags
 	default:
 		err := fmt.Errorf("don't know how to reflect %s into %s", val.Type(), target.Type())
 		diags.AddAttributeError(
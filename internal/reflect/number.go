@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -24,7 +25,7 @@ import (
 // things, as a general rule of thumb.
 //
 // It is meant to be called through Into, not directly.
-func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path *tftypes.AttributePath) (reflect.Value, diag.Diagnostics) {
+func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	result := big.NewFloat(0)
 	err := val.As(&result)
@@ -236,7 +237,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 // FromInt creates an attr.Value using `typ` from an int64.
 //
 // It is meant to be called through FromValue, not directly.
-func FromInt(ctx context.Context, typ attr.Type, val int64, path *tftypes.AttributePath) (attr.Value, diag.Diagnostics) {
+func FromInt(ctx context.Context, typ attr.Type, val int64, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	err := tftypes.ValidateValue(tftypes.Number, val)
 	if err != nil {
@@ -263,7 +264,7 @@ func FromInt(ctx context.Context, typ attr.Type, val int64, path *tftypes.Attrib
 // FromUint creates an attr.Value using `typ` from a uint64.
 //
 // It is meant to be called through FromValue, not directly.
-func FromUint(ctx context.Context, typ attr.Type, val uint64, path *tftypes.AttributePath) (attr.Value, diag.Diagnostics) {
+func FromUint(ctx context.Context, typ attr.Type, val uint64, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	err := tftypes.ValidateValue(tftypes.Number, val)
 	if err != nil {
@@ -290,7 +291,7 @@ func FromUint(ctx context.Context, typ attr.Type, val uint64, path *tftypes.Attr
 // FromFloat creates an attr.Value using `typ` from a float64.
 //
 // It is meant to be called through FromValue, not directly.
-func FromFloat(ctx context.Context, typ attr.Type, val float64, path *tftypes.AttributePath) (attr.Value, diag.Diagnostics) {
+func FromFloat(ctx context.Context, typ attr.Type, val float64, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	err := tftypes.ValidateValue(tftypes.Number, val)
 	if err != nil {
@@ -317,7 +318,7 @@ func FromFloat(ctx context.Context, typ attr.Type, val float64, path *tftypes.At
 // FromBigFloat creates an attr.Value using `typ` from a *big.Float.
 //
 // It is meant to be called through FromValue, not directly.
-func FromBigFloat(ctx context.Context, typ attr.Type, val *big.Float, path *tftypes.AttributePath) (attr.Value, diag.Diagnostics) {
+func FromBigFloat(ctx context.Context, typ attr.Type, val *big.Float, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	err := tftypes.ValidateValue(tftypes.Number, val)
 	if err != nil {
@@ -344,7 +345,7 @@ func FromBigFloat(ctx context.Context, typ attr.Type, val *big.Float, path *tfty
 // FromBigInt creates an attr.Value using `typ` from a *big.Int.
 //
 // It is meant to be called through FromValue, not directly.
-func FromBigInt(ctx context.Context, typ attr.Type, val *big.Int, path *tftypes.AttributePath) (attr.Value, diag.Diagnostics) {
+func FromBigInt(ctx context.Context, typ attr.Type, val *big.Int, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	fl := big.NewFloat(0).SetInt(val)
 	err := tftypes.ValidateValue(tftypes.Number, fl)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -15,7 +16,7 @@ import (
 // into an attr.Value using the attr.Type supplied. `val` will first be
 // transformed into a tftypes.Value, then passed to `typ`'s ValueFromTerraform
 // method.
-func FromValue(ctx context.Context, typ attr.Type, val interface{}, path *tftypes.AttributePath) (attr.Value, diag.Diagnostics) {
+func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	if v, ok := val.(attr.Value); ok {
@@ -36,10 +37,26 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path *tftype
 	if bi, ok := val.(*big.Int); ok {
 		return FromBigInt(ctx, typ, bi, path)
 	}
+	if typ != nil && isStandardLibraryString(reflect.TypeOf(val), typ.TerraformType(ctx)) {
+		return FromStandardLibraryString(ctx, typ, val, path)
+	}
 	value := reflect.ValueOf(val)
 	kind := value.Kind()
+
+	// values without type information in `typ` need their type inferred
+	// from the Go value
+	if typ != nil && typ.TerraformType(ctx).Is(tftypes.DynamicPseudoType) {
+		switch kind {
+		case reflect.Invalid, reflect.Slice, reflect.Array, reflect.Map:
+			return FromDynamic(ctx, typ, value, path)
+		}
+	}
+
 	switch kind {
 	case reflect.Struct:
+		if t, ok := typ.(attr.TypeWithElementTypes); ok {
+			return FromTupleStruct(ctx, t, value, path)
+		}
 		t, ok := typ.(attr.TypeWithAttributeTypes)
 		if !ok {
 			err := fmt.Errorf("cannot use type %T as schema type %T; %T must be an attr.TypeWithAttributeTypes to hold %T", val, typ, typ, val)
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -19,7 +20,7 @@ func TestPrimitive_string(t *testing.T) {
 
 	var s string
 
-	result, diags := refl.Primitive(context.Background(), types.StringType, tftypes.NewValue(tftypes.String, "hello"), reflect.ValueOf(s), tftypes.NewAttributePath())
+	result, diags := refl.Primitive(context.Background(), types.StringType, tftypes.NewValue(tftypes.String, "hello"), reflect.ValueOf(s), path.Empty())
 	if diags.HasError() {
 		t.Errorf("Unexpected error: %v", diags)
 	}
@@ -35,7 +36,7 @@ func TestPrimitive_stringAlias(t *testing.T) {
 	type testString string
 	var s testString
 
-	result, diags := refl.Primitive(context.Background(), types.StringType, tftypes.NewValue(tftypes.String, "hello"), reflect.ValueOf(s), tftypes.NewAttributePath())
+	result, diags := refl.Primitive(context.Background(), types.StringType, tftypes.NewValue(tftypes.String, "hello"), reflect.ValueOf(s), path.Empty())
 	if diags.HasError() {
 		t.Errorf("Unexpected error: %v", diags)
 	}
@@ -45,12 +46,28 @@ func TestPrimitive_stringAlias(t *testing.T) {
 	}
 }
 
+func TestPrimitive_enumNamedString(t *testing.T) {
+	t.Parallel()
+
+	type testProtocol string
+	var s testProtocol
+
+	result, diags := refl.Primitive(context.Background(), types.EnumType{AllowedValues: []string{"tcp", "udp"}}, tftypes.NewValue(tftypes.String, "udp"), reflect.ValueOf(s), path.Empty())
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&s).Elem().Set(result)
+	if s != "udp" {
+		t.Errorf("Expected %q, got %q", "udp", s)
+	}
+}
+
 func TestPrimitive_bool(t *testing.T) {
 	t.Parallel()
 
 	var b bool
 
-	result, diags := refl.Primitive(context.Background(), types.BoolType, tftypes.NewValue(tftypes.Bool, true), reflect.ValueOf(b), tftypes.NewAttributePath())
+	result, diags := refl.Primitive(context.Background(), types.BoolType, tftypes.NewValue(tftypes.Bool, true), reflect.ValueOf(b), path.Empty())
 	if diags.HasError() {
 		t.Errorf("Unexpected error: %v", diags)
 	}
@@ -66,7 +83,7 @@ func TestPrimitive_boolAlias(t *testing.T) {
 	type testBool bool
 	var b testBool
 
-	result, diags := refl.Primitive(context.Background(), types.BoolType, tftypes.NewValue(tftypes.Bool, true), reflect.ValueOf(b), tftypes.NewAttributePath())
+	result, diags := refl.Primitive(context.Background(), types.BoolType, tftypes.NewValue(tftypes.Bool, true), reflect.ValueOf(b), path.Empty())
 	if diags.HasError() {
 		t.Errorf("Unexpected error: %v", diags)
 	}
@@ -92,6 +109,25 @@ func TestFromString(t *testing.T) {
 				Value: "mystring",
 			},
 		},
+		"enum": {
+			val: "udp",
+			typ: types.EnumType{AllowedValues: []string{"tcp", "udp"}},
+			expected: types.Enum{
+				Value:         "udp",
+				AllowedValues: []string{"tcp", "udp"},
+			},
+		},
+		"enum-not-allowed": {
+			val: "icmp",
+			typ: types.EnumType{AllowedValues: []string{"tcp", "udp"}},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Enum Type Validation Error",
+					`Value "icmp" must be one of: "tcp", "udp".`,
+				),
+			},
+		},
 		"WithValidateWarning": {
 			val: "mystring",
 			typ: testtypes.StringTypeWithValidateWarning{},
@@ -102,14 +138,14 @@ func TestFromString(t *testing.T) {
 				CreatedBy: testtypes.StringTypeWithValidateWarning{},
 			},
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath()),
+				testtypes.TestWarningDiagnostic(path.Empty()),
 			},
 		},
 		"WithValidateError": {
 			val: "mystring",
 			typ: testtypes.StringTypeWithValidateError{},
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestErrorDiagnostic(tftypes.NewAttributePath()),
+				testtypes.TestErrorDiagnostic(path.Empty()),
 			},
 		},
 	}
@@ -119,7 +155,7 @@ func TestFromString(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, diags := refl.FromString(context.Background(), tc.typ, tc.val, tftypes.NewAttributePath())
+			got, diags := refl.FromString(context.Background(), tc.typ, tc.val, path.Empty())
 
 			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
 				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
@@ -165,14 +201,14 @@ func TestFromBool(t *testing.T) {
 				CreatedBy: testtypes.BoolTypeWithValidateWarning{},
 			},
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath()),
+				testtypes.TestWarningDiagnostic(path.Empty()),
 			},
 		},
 		"WithValidateError": {
 			val: true,
 			typ: testtypes.BoolTypeWithValidateError{},
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestErrorDiagnostic(tftypes.NewAttributePath()),
+				testtypes.TestErrorDiagnostic(path.Empty()),
 			},
 		},
 	}
@@ -182,7 +218,7 @@ func TestFromBool(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, diags := refl.FromBool(context.Background(), tc.typ, tc.val, tftypes.NewAttributePath())
+			got, diags := refl.FromBool(context.Background(), tc.typ, tc.val, path.Empty())
 
 			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
 				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
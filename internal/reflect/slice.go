@@ -7,12 +7,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // build a slice of elements, matching the type of `target`, and fill it with
 // the data in `val`.
-func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path *tftypes.AttributePath) (reflect.Value, diag.Diagnostics) {
+func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// this only works with slices, so check that out first
@@ -25,14 +26,19 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 		return target, diags
 	}
 	// TODO: check that the val is a list or set or tuple
+	var elemAttrTypes []attr.Type
 	elemTyper, ok := typ.(attr.TypeWithElementType)
 	if !ok {
-		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
-			Val:        val,
-			TargetType: target.Type(),
-			Err:        fmt.Errorf("cannot reflect %s using type information provided by %T, %T must be an attr.TypeWithElementType", val.Type(), typ, typ),
-		}))
-		return target, diags
+		elemsTyper, ok := typ.(attr.TypeWithElementTypes)
+		if !ok {
+			diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+				Val:        val,
+				TargetType: target.Type(),
+				Err:        fmt.Errorf("cannot reflect %s using type information provided by %T, %T must be an attr.TypeWithElementType or attr.TypeWithElementTypes", val.Type(), typ, typ),
+			}))
+			return target, diags
+		}
+		elemAttrTypes = elemsTyper.ElementTypes()
 	}
 
 	// we need our value to become a list of values so we can iterate over
@@ -48,9 +54,18 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 		return target, diags
 	}
 
+	// tuples have a fixed number of elements, each with its own type
+	if elemTyper == nil && len(values) != len(elemAttrTypes) {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("expected %d tuple elements, got %d", len(elemAttrTypes), len(values)),
+		}))
+		return target, diags
+	}
+
 	// we need to know the type the slice is wrapping
 	elemType := target.Type().Elem()
-	elemAttrType := elemTyper.ElementType()
 
 	// we want an empty version of the slice
 	slice := reflect.MakeSlice(target.Type(), 0, len(values))
@@ -62,10 +77,17 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 		targetValue := reflect.Zero(elemType)
 
 		// update our path so we can have nice errors
-		valPath := path.WithElementKeyInt(pos)
+		valPath := path.AtListIndex(pos)
 
 		if typ.TerraformType(ctx).Is(tftypes.Set{}) {
-			valPath = path.WithElementKeyValue(value)
+			valPath = path.AtSetValue(value)
+		}
+
+		var elemAttrType attr.Type
+		if elemTyper != nil {
+			elemAttrType = elemTyper.ElementType()
+		} else {
+			elemAttrType = elemAttrTypes[pos]
 		}
 
 		// reflect the value into our new target
@@ -88,13 +110,14 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 // attr.TypeWithElementTypes. If the slice is nil, the representation of null
 // for `typ` will be returned. Otherwise, FromSlice will recurse into FromValue
 // for each element in the slice, using the element type or types defined on
-// `typ` to construct values for them.
+// `typ` to construct values for them. When `typ` is an
+// attr.TypeWithElementTypes, the slice must have exactly one element per
+// element type.
 //
 // It is meant to be called through FromValue, not directly.
-func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path *tftypes.AttributePath) (attr.Value, diag.Diagnostics) {
+func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	// TODO: support tuples, which are attr.TypeWithElementTypes
 	tfType := typ.TerraformType(ctx)
 
 	if val.IsNil() {
@@ -122,26 +145,48 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path *tfty
 		return attrVal, diags
 	}
 
+	var elemTypes []attr.Type
 	t, ok := typ.(attr.TypeWithElementType)
 	if !ok {
-		err := fmt.Errorf("cannot use type %T as schema type %T; %T must be an attr.TypeWithElementType to hold %T", val, typ, typ, val)
-		diags.AddAttributeError(
-			path,
-			"Value Conversion Error",
-			"An unexpected error was encountered trying to convert from slice value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
-		)
-		return nil, diags
+		tupleType, ok := typ.(attr.TypeWithElementTypes)
+		if !ok {
+			err := fmt.Errorf("cannot use type %T as schema type %T; %T must be an attr.TypeWithElementType or attr.TypeWithElementTypes to hold %T", val, typ, typ, val)
+			diags.AddAttributeError(
+				path,
+				"Value Conversion Error",
+				"An unexpected error was encountered trying to convert from slice value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return nil, diags
+		}
+
+		elemTypes = tupleType.ElementTypes()
+
+		if val.Len() != len(elemTypes) {
+			err := fmt.Errorf("cannot use %T with %d elements as schema type %T with %d element types", val.Interface(), val.Len(), typ, len(elemTypes))
+			diags.AddAttributeError(
+				path,
+				"Value Conversion Error",
+				"An unexpected error was encountered trying to convert from slice value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return nil, diags
+		}
 	}
 
-	elemType := t.ElementType()
 	tfElems := make([]tftypes.Value, 0, val.Len())
 	for i := 0; i < val.Len(); i++ {
+		var elemType attr.Type
+		if t != nil {
+			elemType = t.ElementType()
+		} else {
+			elemType = elemTypes[i]
+		}
+
 		// The underlying reflect.Slice is fetched by Index(). For set types,
 		// the path is value-based instead of index-based. Since there is only
 		// the index until the value is retrieved, this will pass the
 		// technically incorrect index-based path at first for framework
 		// debugging purposes, then correct the path afterwards.
-		valPath := path.WithElementKeyInt(i)
+		valPath := path.AtListIndex(i)
 
 		val, valDiags := FromValue(ctx, elemType, val.Index(i).Interface(), valPath)
 		diags.Append(valDiags...)
@@ -156,7 +201,7 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path *tfty
 		}
 
 		if tfType.Is(tftypes.Set{}) {
-			valPath = path.WithElementKeyValue(tfVal)
+			valPath = path.AtSetValue(tfVal)
 		}
 
 		if typeWithValidate, ok := elemType.(attr.TypeWithValidate); ok {
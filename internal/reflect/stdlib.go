@@ -0,0 +1,128 @@
+package reflect
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	ipNetType    = reflect.TypeOf(net.IPNet{})
+	ipType       = reflect.TypeOf(net.IP{})
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// isStandardLibraryString returns true if `target` is a standard library type
+// which can be parsed from and formatted into a string value, rather than
+// being reflected by its kind.
+func isStandardLibraryString(target reflect.Type, tfType tftypes.Type) bool {
+	if tfType == nil || !tfType.Is(tftypes.String) {
+		return false
+	}
+
+	switch target {
+	case durationType, ipNetType, ipType, timeType:
+		return true
+	default:
+		return false
+	}
+}
+
+// StandardLibraryString builds a time.Time, time.Duration, net.IP, or
+// net.IPNet, depending on the type of `target`, by parsing the string data in
+// `val`. Times must be formatted as RFC 3339, durations must be accepted by
+// time.ParseDuration, and networks must be in CIDR notation.
+//
+// It is meant to be called through Into, not directly.
+func StandardLibraryString(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, path path.Path) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var s string
+
+	err := val.As(&s)
+
+	if err != nil {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: target.Type(),
+			Err:        err,
+		}))
+		return target, diags
+	}
+
+	var result interface{}
+
+	switch target.Type() {
+	case durationType:
+		result, err = time.ParseDuration(s)
+	case ipNetType:
+		var ipNet *net.IPNet
+
+		_, ipNet, err = net.ParseCIDR(s)
+
+		if err == nil {
+			result = *ipNet
+		}
+	case ipType:
+		ip := net.ParseIP(s)
+
+		if ip == nil {
+			err = fmt.Errorf("cannot parse %q as an IP address", s)
+		}
+
+		result = ip
+	case timeType:
+		result, err = time.Parse(time.RFC3339, s)
+	default:
+		err = fmt.Errorf("unsupported standard library type %s", target.Type())
+	}
+
+	if err != nil {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: target.Type(),
+			Err:        err,
+		}))
+		return target, diags
+	}
+
+	return reflect.ValueOf(result), diags
+}
+
+// FromStandardLibraryString returns an attr.Value as produced by `typ` from a
+// time.Time, time.Duration, net.IP, or net.IPNet, formatted as a string.
+// Times are formatted as RFC 3339 and networks in CIDR notation.
+//
+// It is meant to be called through FromValue, not directly.
+func FromStandardLibraryString(ctx context.Context, typ attr.Type, val interface{}, path path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var s string
+
+	switch v := val.(type) {
+	case time.Duration:
+		s = v.String()
+	case net.IPNet:
+		s = v.String()
+	case net.IP:
+		s = v.String()
+	case time.Time:
+		s = v.Format(time.RFC3339Nano)
+	default:
+		err := fmt.Errorf("unsupported standard library type %T", val)
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, diags
+	}
+
+	return FromString(ctx, typ, s, path)
+}
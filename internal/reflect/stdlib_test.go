@@ -0,0 +1,192 @@
+package reflect_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestInto_standardLibraryString(t *testing.T) {
+	t.Parallel()
+
+	ipNet := func(s string) net.IPNet {
+		_, n, err := net.ParseCIDR(s)
+
+		if err != nil {
+			t.Fatalf("unexpected error parsing CIDR: %s", err)
+		}
+
+		return *n
+	}
+
+	testCases := map[string]struct {
+		val           tftypes.Value
+		target        interface{}
+		expected      interface{}
+		expectedDiags diag.Diagnostics
+	}{
+		"duration": {
+			val:      tftypes.NewValue(tftypes.String, "1h30m"),
+			target:   new(time.Duration),
+			expected: 90 * time.Minute,
+		},
+		"duration-invalid": {
+			val:    tftypes.NewValue(tftypes.String, "soon"),
+			target: new(time.Duration),
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
+					Val:        tftypes.NewValue(tftypes.String, "soon"),
+					TargetType: reflect.TypeOf(time.Duration(0)),
+					Err:        errors.New(`time: invalid duration "soon"`),
+				}),
+			},
+		},
+		"ip": {
+			val:      tftypes.NewValue(tftypes.String, "2001:db8::1"),
+			target:   new(net.IP),
+			expected: net.ParseIP("2001:db8::1"),
+		},
+		"ip-invalid": {
+			val:    tftypes.NewValue(tftypes.String, "256.0.0.1"),
+			target: new(net.IP),
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
+					Val:        tftypes.NewValue(tftypes.String, "256.0.0.1"),
+					TargetType: reflect.TypeOf(net.IP{}),
+					Err:        errors.New(`cannot parse "256.0.0.1" as an IP address`),
+				}),
+			},
+		},
+		"ipnet-pointer": {
+			val:      tftypes.NewValue(tftypes.String, "10.0.0.0/16"),
+			target:   new(*net.IPNet),
+			expected: func() *net.IPNet { n := ipNet("10.0.0.0/16"); return &n }(),
+		},
+		"ipnet-invalid": {
+			val:    tftypes.NewValue(tftypes.String, "10.0.0.0"),
+			target: new(net.IPNet),
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
+					Val:        tftypes.NewValue(tftypes.String, "10.0.0.0"),
+					TargetType: reflect.TypeOf(net.IPNet{}),
+					Err:        errors.New("invalid CIDR address: 10.0.0.0"),
+				}),
+			},
+		},
+		"time": {
+			val:      tftypes.NewValue(tftypes.String, "2022-07-01T12:30:00Z"),
+			target:   new(time.Time),
+			expected: time.Date(2022, 7, 1, 12, 30, 0, 0, time.UTC),
+		},
+		"time-invalid": {
+			val:    tftypes.NewValue(tftypes.String, "2022-07-01"),
+			target: new(time.Time),
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
+					Val:        tftypes.NewValue(tftypes.String, "2022-07-01"),
+					TargetType: reflect.TypeOf(time.Time{}),
+					Err:        errors.New(`parsing time "2022-07-01" as "2006-01-02T15:04:05Z07:00": cannot parse "" as "T"`),
+				}),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := refl.Into(context.Background(), types.StringType, tc.val, tc.target, refl.Options{})
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Fatalf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if tc.expectedDiags.HasError() {
+				return
+			}
+
+			got := reflect.ValueOf(tc.target).Elem().Interface()
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromValue_standardLibraryString(t *testing.T) {
+	t.Parallel()
+
+	_, ipNet, err := net.ParseCIDR("10.0.0.0/16")
+
+	if err != nil {
+		t.Fatalf("unexpected error parsing CIDR: %s", err)
+	}
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		val           interface{}
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"duration": {
+			typ:      types.StringType,
+			val:      90 * time.Minute,
+			expected: types.String{Value: "1h30m0s"},
+		},
+		"duration-number": {
+			typ:      types.Int64Type,
+			val:      time.Microsecond,
+			expected: types.Int64{Value: 1000},
+		},
+		"ip": {
+			typ:      types.StringType,
+			val:      net.ParseIP("2001:0db8:0000::1"),
+			expected: types.String{Value: "2001:db8::1"},
+		},
+		"ipnet": {
+			typ:      types.StringType,
+			val:      *ipNet,
+			expected: types.String{Value: "10.0.0.0/16"},
+		},
+		"ipnet-pointer": {
+			typ:      types.StringType,
+			val:      ipNet,
+			expected: types.String{Value: "10.0.0.0/16"},
+		},
+		"time": {
+			typ:      types.StringType,
+			val:      time.Date(2022, 7, 1, 12, 30, 0, 500, time.UTC),
+			expected: types.String{Value: "2022-07-01T12:30:00.0000005Z"},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := refl.FromValue(context.Background(), tc.typ, tc.val, path.Empty())
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Fatalf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package reflect
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TupleStruct builds a new struct using the data in `tuple`, as long as
+// `tuple` is a `tftypes.Tuple`. It will take the struct type from `target`,
+// which must be a struct type.
+//
+// Tuple elements have no names, so they are mapped to the exported properties
+// of `target` in the order the properties are declared. Properties with a
+// `tfsdk:"-"` tag are skipped. The number of remaining properties must match
+// the number of elements in `tuple`.
+//
+// TupleStruct is meant to be called from Into, not directly.
+func TupleStruct(ctx context.Context, typ attr.Type, tuple tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if target.Kind() != reflect.Struct {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        tuple,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("expected a struct type, got %s", target.Type()),
+		}))
+		return target, diags
+	}
+	if !tuple.Type().Is(tftypes.Tuple{}) {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        tuple,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("cannot reflect %s into a struct by position, must be a tuple", tuple.Type().String()),
+		}))
+		return target, diags
+	}
+	elemsType, ok := typ.(attr.TypeWithElementTypes)
+	if !ok {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        tuple,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("cannot reflect tuple using type information provided by %T, %T must be an attr.TypeWithElementTypes", typ, typ),
+		}))
+		return target, diags
+	}
+
+	var values []tftypes.Value
+	err := tuple.As(&values)
+	if err != nil {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        tuple,
+			TargetType: target.Type(),
+			Err:        err,
+		}))
+		return target, diags
+	}
+
+	targetFields := getTupleStructFields(target)
+	elemTypes := elemsType.ElementTypes()
+
+	if len(targetFields) != len(values) || len(elemTypes) != len(values) {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        tuple,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("mismatch between struct and tuple: struct defines %d fields, tuple has %d elements", len(targetFields), len(values)),
+		}))
+		return target, diags
+	}
+
+	result := reflect.New(target.Type()).Elem()
+	for pos, structFieldPos := range targetFields {
+		structField := result.Field(structFieldPos)
+		fieldVal, fieldValDiags := BuildValue(ctx, elemTypes[pos], values[pos], structField, opts, path.AtListIndex(pos))
+		diags.Append(fieldValDiags...)
+
+		if diags.HasError() {
+			return target, diags
+		}
+		structField.Set(fieldVal)
+	}
+	return result, diags
+}
+
+// FromTupleStruct builds an attr.Value as produced by `typ` from the data in
+// `val`. `val` must be a struct type, and its exported properties, except
+// those with a `tfsdk:"-"` tag, must be a 1:1 positional match with the
+// element types reported by `typ`. FromTupleStruct will recurse into
+// FromValue for each element, using the type of the element as reported by
+// `typ`.
+//
+// It is meant to be called through FromValue, not directly.
+func FromTupleStruct(ctx context.Context, typ attr.TypeWithElementTypes, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	targetFields := getTupleStructFields(val)
+	elemTypes := typ.ElementTypes()
+
+	if len(targetFields) != len(elemTypes) {
+		err := fmt.Errorf("mismatch between struct and tuple: struct defines %d fields, tuple type has %d elements", len(targetFields), len(elemTypes))
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert from struct value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, diags
+	}
+
+	tfTypes := make([]tftypes.Type, 0, len(elemTypes))
+	tfValues := make([]tftypes.Value, 0, len(elemTypes))
+
+	for pos, fieldNo := range targetFields {
+		path := path.AtListIndex(pos)
+		elemType := elemTypes[pos]
+
+		if elemType == nil {
+			err := fmt.Errorf("couldn't find type information for element in supplied attr.Type %T", typ)
+			diags.AddAttributeError(
+				path,
+				"Value Conversion Error",
+				"An unexpected error was encountered trying to convert from struct value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return nil, diags
+		}
+
+		elemVal, elemValDiags := FromValue(ctx, elemType, val.Field(fieldNo).Interface(), path)
+		diags.Append(elemValDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		tfElemVal, err := elemVal.ToTerraformValue(ctx)
+		if err != nil {
+			return nil, append(diags, toTerraformValueErrorDiag(err, path))
+		}
+
+		if typeWithValidate, ok := elemType.(attr.TypeWithValidate); ok {
+			diags.Append(typeWithValidate.Validate(ctx, tfElemVal, path)...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		tfTypes = append(tfTypes, elemType.TerraformType(ctx))
+		tfValues = append(tfValues, tfElemVal)
+	}
+
+	tfVal := tftypes.NewValue(tftypes.Tuple{
+		ElementTypes: tfTypes,
+	}, tfValues)
+
+	if typeWithValidate, ok := typ.(attr.TypeWithValidate); ok {
+		diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	ret, err := typ.ValueFromTerraform(ctx, tfVal)
+	if err != nil {
+		return nil, append(diags, valueFromTerraformErrorDiag(err, path))
+	}
+
+	return ret, diags
+}
+
+// getTupleStructFields returns the field indexes of the exported properties
+// of `in`, in declaration order, skipping properties with a `tfsdk:"-"` tag.
+func getTupleStructFields(in reflect.Value) []int {
+	var fields []int
+	typ := trueReflectValue(in).Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			// skip unexported fields
+			continue
+		}
+		if field.Tag.Get(`tfsdk`) == "-" {
+			// skip explicitly excluded fields
+			continue
+		}
+		fields = append(fields, i)
+	}
+	return fields
+}
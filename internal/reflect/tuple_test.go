@@ -0,0 +1,148 @@
+package reflect_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTupleStruct_notATuple(t *testing.T) {
+	t.Parallel()
+
+	var s struct{}
+	expectedDiags := diag.Diagnostics{
+		diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
+			Val:        tftypes.NewValue(tftypes.String, "hello"),
+			TargetType: reflect.TypeOf(s),
+			Err:        fmt.Errorf("cannot reflect %s into a struct by position, must be a tuple", tftypes.String),
+		}),
+	}
+
+	_, diags := refl.TupleStruct(context.Background(), types.StringType, tftypes.NewValue(tftypes.String, "hello"), reflect.ValueOf(s), refl.Options{}, path.Empty())
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+	}
+}
+
+func TestTupleStruct_fieldCountMismatch(t *testing.T) {
+	t.Parallel()
+
+	val := tftypes.NewValue(tftypes.Tuple{
+		ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool},
+	}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "hello"),
+		tftypes.NewValue(tftypes.Bool, true),
+	})
+
+	var s struct {
+		A string
+	}
+	expectedDiags := diag.Diagnostics{
+		diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: reflect.TypeOf(s),
+			Err:        fmt.Errorf("mismatch between struct and tuple: struct defines 1 fields, tuple has 2 elements"),
+		}),
+	}
+
+	_, diags := refl.TupleStruct(context.Background(), types.TupleType{
+		ElemTypes: []attr.Type{types.StringType, types.BoolType},
+	}, val, reflect.ValueOf(s), refl.Options{}, path.Empty())
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFromValue_tuple(t *testing.T) {
+	t.Parallel()
+
+	tupleType := types.TupleType{
+		ElemTypes: []attr.Type{types.StringType, types.Int64Type},
+	}
+
+	testCases := map[string]struct {
+		val           interface{}
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"struct": {
+			val: struct {
+				Name    string
+				Count   int64
+				ignored bool
+			}{
+				Name:  "hello",
+				Count: 123,
+			},
+			expected: types.Tuple{
+				ElemTypes: []attr.Type{types.StringType, types.Int64Type},
+				Elems: []attr.Value{
+					types.String{Value: "hello"},
+					types.Int64{Value: 123},
+				},
+			},
+		},
+		"slice": {
+			val: []attr.Value{
+				types.String{Value: "hello"},
+				types.Int64{Value: 123},
+			},
+			expected: types.Tuple{
+				ElemTypes: []attr.Type{types.StringType, types.Int64Type},
+				Elems: []attr.Value{
+					types.String{Value: "hello"},
+					types.Int64{Value: 123},
+				},
+			},
+		},
+		"slice-nil": {
+			val: []attr.Value(nil),
+			expected: types.Tuple{
+				ElemTypes: []attr.Type{types.StringType, types.Int64Type},
+				Null:      true,
+			},
+		},
+		"slice-length-mismatch": {
+			val: []attr.Value{
+				types.String{Value: "hello"},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert from slice value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"cannot use []attr.Value with 1 elements as schema type types.TupleType with 2 element types",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := refl.FromValue(context.Background(), tupleType, tc.val, path.Empty())
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -41,6 +41,20 @@ func (t TestErrorDiagModifier) MarkdownDescription(ctx context.Context) string {
 	return "This plan modifier is for use during testing only"
 }
 
+type TestPanicModifier struct{}
+
+func (t TestPanicModifier) Modify(ctx context.Context, req tfsdk.ModifyAttributePlanRequest, resp *tfsdk.ModifyAttributePlanResponse) {
+	panic("test panic")
+}
+
+func (t TestPanicModifier) Description(ctx context.Context) string {
+	return "This plan modifier is for use during testing only"
+}
+
+func (t TestPanicModifier) MarkdownDescription(ctx context.Context) string {
+	return "This plan modifier is for use during testing only"
+}
+
 type TestAttrPlanValueModifierOne struct{}
 
 func (t TestAttrPlanValueModifierOne) Modify(ctx context.Context, req tfsdk.ModifyAttributePlanRequest, resp *tfsdk.ModifyAttributePlanResponse) {
@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.Resource = &ResourceWithTimeouts{}
+var _ tfsdk.ResourceWithTimeouts = &ResourceWithTimeouts{}
+
+// Declarative tfsdk.ResourceWithTimeouts for unit testing.
+type ResourceWithTimeouts struct {
+	*Resource
+
+	// ResourceWithTimeouts interface methods
+	TimeoutsMethod func(context.Context) tfsdk.Timeouts
+}
+
+// Timeouts satisfies the tfsdk.ResourceWithTimeouts interface.
+func (r *ResourceWithTimeouts) Timeouts(ctx context.Context) tfsdk.Timeouts {
+	if r.TimeoutsMethod == nil {
+		return tfsdk.Timeouts{}
+	}
+
+	return r.TimeoutsMethod(ctx)
+}
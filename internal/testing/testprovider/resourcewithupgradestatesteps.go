@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.Resource = &ResourceWithUpgradeStateSteps{}
+var _ tfsdk.ResourceWithUpgradeStateSteps = &ResourceWithUpgradeStateSteps{}
+
+// Declarative tfsdk.ResourceWithUpgradeStateSteps for unit testing.
+type ResourceWithUpgradeStateSteps struct {
+	*Resource
+
+	// ResourceWithUpgradeStateSteps interface methods
+	UpgradeStateStepsMethod func(context.Context) map[int64]tfsdk.ResourceStateUpgrader
+}
+
+// UpgradeStateSteps satisfies the tfsdk.ResourceWithUpgradeStateSteps interface.
+func (r *ResourceWithUpgradeStateSteps) UpgradeStateSteps(ctx context.Context) map[int64]tfsdk.ResourceStateUpgrader {
+	if r.UpgradeStateStepsMethod == nil {
+		return nil
+	}
+
+	return r.UpgradeStateStepsMethod(ctx)
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ attr.Type                    = StringTypeWithSemanticEquals{}
+	_ attr.ValueWithSemanticEquals = StringWithSemanticEquals{}
+)
+
+// StringTypeWithSemanticEquals is a string type whose values are
+// semantically equal when they only differ in case.
+type StringTypeWithSemanticEquals struct {
+	StringType
+
+	// SemanticEqualsDiagnostics are returned by every SemanticEquals call.
+	SemanticEqualsDiagnostics diag.Diagnostics
+}
+
+func (t StringTypeWithSemanticEquals) Equal(o attr.Type) bool {
+	_, ok := o.(StringTypeWithSemanticEquals)
+
+	return ok
+}
+
+func (t StringTypeWithSemanticEquals) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	res, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	newString := res.(String)
+	newString.CreatedBy = t
+	return StringWithSemanticEquals{
+		String:                    newString,
+		SemanticEqualsDiagnostics: t.SemanticEqualsDiagnostics,
+	}, nil
+}
+
+type StringWithSemanticEquals struct {
+	String
+
+	SemanticEqualsDiagnostics diag.Diagnostics
+}
+
+func (s StringWithSemanticEquals) Equal(o attr.Value) bool {
+	os, ok := o.(StringWithSemanticEquals)
+	if !ok {
+		return false
+	}
+	return s.String.Equal(os.String)
+}
+
+func (s StringWithSemanticEquals) SemanticEquals(_ context.Context, o attr.Value) (bool, diag.Diagnostics) {
+	os, ok := o.(StringWithSemanticEquals)
+	if !ok {
+		return false, nil
+	}
+	return strings.EqualFold(s.String.Value, os.String.Value), s.SemanticEqualsDiagnostics
+}
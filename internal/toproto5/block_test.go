@@ -0,0 +1,603 @@
+package toproto5_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestBlock(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name        string
+		block       tfsdk.Block
+		path        *tftypes.AttributePath
+		expected    *tfprotov5.SchemaNestedBlock
+		expectedErr string
+	}
+
+	tests := map[string]testCase{
+		"nestingmode-invalid": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+			},
+			path:        tftypes.NewAttributePath(),
+			expectedErr: "unrecognized nesting mode 0",
+		},
+		"nestingmode-list-attributes": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				NestingMode: tfsdk.BlockNestingModeList,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_test",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+				},
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+				TypeName: "test",
+			},
+		},
+		"nestingmode-list-attributes-and-blocks": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_attr": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				Blocks: map[string]tfsdk.Block{
+					"sub_block": {
+						Attributes: map[string]tfsdk.Attribute{
+							"sub_block_attr": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						},
+						NestingMode: tfsdk.BlockNestingModeList,
+					},
+				},
+				NestingMode: tfsdk.BlockNestingModeList,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_attr",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{
+										Name:     "sub_block_attr",
+										Optional: true,
+										Type:     tftypes.String,
+									},
+								},
+							},
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+							TypeName: "sub_block",
+						},
+					},
+				},
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+				TypeName: "test",
+			},
+		},
+		"nestingmode-list-blocks": {
+			name: "test",
+			block: tfsdk.Block{
+				Blocks: map[string]tfsdk.Block{
+					"sub_block": {
+						Attributes: map[string]tfsdk.Attribute{
+							"sub_block_attr": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						},
+						NestingMode: tfsdk.BlockNestingModeList,
+					},
+				},
+				NestingMode: tfsdk.BlockNestingModeList,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{
+										Name:     "sub_block_attr",
+										Optional: true,
+										Type:     tftypes.String,
+									},
+								},
+							},
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+							TypeName: "sub_block",
+						},
+					},
+				},
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+				TypeName: "test",
+			},
+		},
+		"nestingmode-set-attributes": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				NestingMode: tfsdk.BlockNestingModeSet,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_test",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+				},
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+				TypeName: "test",
+			},
+		},
+		"nestingmode-set-attributes-and-blocks": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_attr": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				Blocks: map[string]tfsdk.Block{
+					"sub_block": {
+						Attributes: map[string]tfsdk.Attribute{
+							"sub_block_attr": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						},
+						NestingMode: tfsdk.BlockNestingModeSet,
+					},
+				},
+				NestingMode: tfsdk.BlockNestingModeSet,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_attr",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{
+										Name:     "sub_block_attr",
+										Optional: true,
+										Type:     tftypes.String,
+									},
+								},
+							},
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+							TypeName: "sub_block",
+						},
+					},
+				},
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+				TypeName: "test",
+			},
+		},
+		"nestingmode-set-blocks": {
+			name: "test",
+			block: tfsdk.Block{
+				Blocks: map[string]tfsdk.Block{
+					"sub_block": {
+						Attributes: map[string]tfsdk.Attribute{
+							"sub_block_attr": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						},
+						NestingMode: tfsdk.BlockNestingModeSet,
+					},
+				},
+				NestingMode: tfsdk.BlockNestingModeSet,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{
+										Name:     "sub_block_attr",
+										Optional: true,
+										Type:     tftypes.String,
+									},
+								},
+							},
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+							TypeName: "sub_block",
+						},
+					},
+				},
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+				TypeName: "test",
+			},
+		},
+		"nestingmode-single-attributes": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				NestingMode: tfsdk.BlockNestingModeSingle,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_test",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+				},
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+				TypeName: "test",
+			},
+		},
+		"nestingmode-single-attributes-and-blocks": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_attr": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				Blocks: map[string]tfsdk.Block{
+					"sub_block": {
+						Attributes: map[string]tfsdk.Attribute{
+							"sub_block_attr": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						},
+						NestingMode: tfsdk.BlockNestingModeSingle,
+					},
+				},
+				MinItems:    1,
+				MaxItems:    1,
+				NestingMode: tfsdk.BlockNestingModeSingle,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_attr",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{
+										Name:     "sub_block_attr",
+										Optional: true,
+										Type:     tftypes.String,
+									},
+								},
+							},
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+							TypeName: "sub_block",
+						},
+					},
+				},
+				MinItems: 1,
+				MaxItems: 1,
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+				TypeName: "test",
+			},
+		},
+		"nestingmode-single-maxitems-invalid": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				MaxItems:    2,
+				NestingMode: tfsdk.BlockNestingModeSingle,
+			},
+			path:        tftypes.NewAttributePath(),
+			expectedErr: "MinItems and MaxItems must both be 0 or both be 1 for nesting mode 3",
+		},
+		"nestingmode-single-maxitems-mismatch": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				MaxItems:    1,
+				NestingMode: tfsdk.BlockNestingModeSingle,
+			},
+			path:        tftypes.NewAttributePath(),
+			expectedErr: "MinItems and MaxItems must both be 0 or both be 1 for nesting mode 3",
+		},
+		"nestingmode-single-minitems-mismatch": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				MinItems:    1,
+				NestingMode: tfsdk.BlockNestingModeSingle,
+			},
+			path:        tftypes.NewAttributePath(),
+			expectedErr: "MinItems and MaxItems must both be 0 or both be 1 for nesting mode 3",
+		},
+		"deprecationmessage": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				DeprecationMessage: "deprecated, use something else instead",
+				NestingMode:        tfsdk.BlockNestingModeList,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_test",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+					Deprecated: true,
+				},
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+				TypeName: "test",
+			},
+		},
+		"description": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				Description: "test description",
+				NestingMode: tfsdk.BlockNestingModeList,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_test",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+					Description:     "test description",
+					DescriptionKind: tfprotov5.StringKindPlain,
+				},
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+				TypeName: "test",
+			},
+		},
+		"description-and-markdowndescription": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				Description:         "test plain description",
+				MarkdownDescription: "test markdown description",
+				NestingMode:         tfsdk.BlockNestingModeList,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_test",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+					Description:     "test markdown description",
+					DescriptionKind: tfprotov5.StringKindMarkdown,
+				},
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+				TypeName: "test",
+			},
+		},
+		"markdowndescription": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				MarkdownDescription: "test description",
+				NestingMode:         tfsdk.BlockNestingModeList,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_test",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+					Description:     "test description",
+					DescriptionKind: tfprotov5.StringKindMarkdown,
+				},
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+				TypeName: "test",
+			},
+		},
+		"maxitems": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				MaxItems:    10,
+				NestingMode: tfsdk.BlockNestingModeList,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_test",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+				},
+				MaxItems: 10,
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+				TypeName: "test",
+			},
+		},
+		"minitems": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				MinItems:    10,
+				NestingMode: tfsdk.BlockNestingModeList,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaNestedBlock{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "sub_test",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+				},
+				MinItems: 10,
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+				TypeName: "test",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := toproto5.Block(context.Background(), tc.name, tc.path, tc.block)
+			if err != nil {
+				if tc.expectedErr == "" {
+					t.Errorf("Unexpected error: %s", err)
+					return
+				}
+				if err.Error() != tc.expectedErr {
+					t.Errorf("Expected error to be %q, got %q", tc.expectedErr, err.Error())
+					return
+				}
+				// got expected error
+				return
+			}
+			if err == nil && tc.expectedErr != "" {
+				t.Errorf("Expected error to be %q, got nil", tc.expectedErr)
+				return
+			}
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package toproto5
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// Diagnostics converts the diagnostics into the tfprotov5 collection type.
+func Diagnostics(ctx context.Context, diagnostics diag.Diagnostics) []*tfprotov5.Diagnostic {
+	var results []*tfprotov5.Diagnostic
+
+	for _, diagnostic := range diagnostics {
+		tfprotov5Diagnostic := &tfprotov5.Diagnostic{
+			Detail:   diagnostic.Detail(),
+			Severity: diagnostic.Severity().ToTfprotov5DiagnosticSeverity(),
+			Summary:  diagnostic.Summary(),
+		}
+
+		if diagWithPath, ok := diagnostic.(diag.DiagnosticWithPath); ok {
+			tfprotov5Attribute, err := totftypes.AttributePath(ctx, diagWithPath.Path())
+
+			if err != nil {
+				results = append(results, &tfprotov5.Diagnostic{
+					Detail:   "An unexpected error was encountered trying to convert the diagnostic attribute path. This is always an error in the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "Diagnostic Attribute Path Conversion Error",
+				})
+			}
+
+			tfprotov5Diagnostic.Attribute = tfprotov5Attribute
+		}
+
+		results = append(results, tfprotov5Diagnostic)
+	}
+
+	return results
+}
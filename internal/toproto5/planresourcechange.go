@@ -0,0 +1,45 @@
+package toproto5
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// PlanResourceChangeResponse returns the *tfprotov5.PlanResourceChangeResponse
+// equivalent of a *fwserver.PlanResourceChangeResponse.
+func PlanResourceChangeResponse(ctx context.Context, fw *fwserver.PlanResourceChangeResponse) *tfprotov5.PlanResourceChangeResponse {
+	if fw == nil {
+		return nil
+	}
+
+	proto5 := &tfprotov5.PlanResourceChangeResponse{
+		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
+	}
+
+	plannedState, diags := State(ctx, fw.PlannedState)
+
+	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
+	proto5.PlannedState = plannedState
+
+	requiresReplace, err := totftypes.AttributePaths(ctx, fw.RequiresReplace)
+
+	if err != nil {
+		proto5.Diagnostics = append(proto5.Diagnostics, &tfprotov5.Diagnostic{
+			Detail:   "An unexpected error was encountered trying to convert the RequiresReplace attribute paths. This is always an error in the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "RequiresReplace Attribute Path Conversion Error",
+		})
+	}
+
+	proto5.RequiresReplace = requiresReplace
+
+	privateData, diags := fw.PlannedPrivate.Bytes(ctx)
+
+	proto5.Diagnostics = append(proto5.Diagnostics, Diagnostics(ctx, diags)...)
+	proto5.PlannedPrivate = privateData
+
+	return proto5
+}
@@ -0,0 +1,93 @@
+package toproto5
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SchemaAttribute returns the *tfprotov5.SchemaAttribute equivalent of an
+// Attribute. Errors will be tftypes.AttributePathErrors based on `path`.
+// `name` is the name of the attribute.
+func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePath, a tfsdk.Attribute) (*tfprotov5.SchemaAttribute, error) {
+	if a.Attributes != nil && len(a.Attributes.GetAttributes()) > 0 && a.Type != nil {
+		return nil, path.NewErrorf("cannot have both Attributes and Type set")
+	}
+
+	if (a.Attributes == nil || len(a.Attributes.GetAttributes()) == 0) && a.Type == nil {
+		return nil, path.NewErrorf("must have Attributes or Type set")
+	}
+
+	// Protocol version 5 has no equivalent of nested attributes.
+	if a.Attributes != nil && len(a.Attributes.GetAttributes()) > 0 {
+		return nil, path.NewErrorf("protocol version 5 does not support nested attributes, use Blocks or Type instead")
+	}
+
+	if !a.Required && !a.Optional && !a.Computed {
+		return nil, path.NewErrorf("must have Required, Optional, or Computed set")
+	}
+
+	if a.Required && a.Default != nil {
+		return nil, path.NewErrorf("cannot have Default set when Required is set")
+	}
+
+	schemaAttribute := &tfprotov5.SchemaAttribute{
+		Name: name,
+		// Defaults are set by the provider and are therefore Computed.
+		Computed:  a.Computed || a.Default != nil,
+		Optional:  a.Optional,
+		Required:  a.Required,
+		Sensitive: a.Sensitive,
+	}
+
+	if a.DeprecationMessage != "" {
+		schemaAttribute.Deprecated = true
+	}
+
+	description := a.Description
+	markdownDescription := a.MarkdownDescription
+
+	// Type descriptions, such as allowed values, follow the same precedence
+	// as default value descriptions.
+	if markdownDescription != "" {
+		if t, ok := a.Type.(attr.TypeWithMarkdownDescription); ok {
+			markdownDescription = joinDescription(markdownDescription, t.MarkdownDescription(ctx))
+		}
+	} else if t, ok := a.Type.(attr.TypeWithPlaintextDescription); ok {
+		description = joinDescription(description, t.Description(ctx))
+	}
+
+	if a.Default != nil {
+		// The plain text default description is only used when the
+		// attribute has no Markdown description, which takes precedence.
+		if markdownDescription != "" {
+			markdownDescription = joinDescription(markdownDescription, a.Default.MarkdownDescription(ctx))
+		} else {
+			description = joinDescription(description, a.Default.Description(ctx))
+		}
+	}
+
+	if description != "" {
+		schemaAttribute.Description = description
+		schemaAttribute.DescriptionKind = tfprotov5.StringKindPlain
+	}
+
+	if markdownDescription != "" {
+		schemaAttribute.Description = markdownDescription
+		schemaAttribute.DescriptionKind = tfprotov5.StringKindMarkdown
+	}
+
+	schemaAttribute.Type = a.Type.TerraformType(ctx)
+
+	return schemaAttribute, nil
+}
+
+// joinDescription appends the additional description to the existing
+// description, separated by a space.
+func joinDescription(description string, additional string) string {
+	return strings.TrimSpace(description + " " + additional)
+}
@@ -38,6 +38,12 @@ func Block(ctx context.Context, name string, path *tftypes.AttributePath, b tfsd
 		schemaNestedBlock.Nesting = tfprotov6.SchemaNestedBlockNestingModeList
 	case tfsdk.BlockNestingModeSet:
 		schemaNestedBlock.Nesting = tfprotov6.SchemaNestedBlockNestingModeSet
+	case tfsdk.BlockNestingModeSingle:
+		if b.MinItems != b.MaxItems || b.MinItems > 1 {
+			return nil, path.NewErrorf("MinItems and MaxItems must both be 0 or both be 1 for nesting mode %v", nm)
+		}
+
+		schemaNestedBlock.Nesting = tfprotov6.SchemaNestedBlockNestingModeSingle
 	default:
 		return nil, path.NewErrorf("unrecognized nesting mode %v", nm)
 	}
@@ -271,6 +271,133 @@ func TestBlock(t *testing.T) {
 				TypeName: "test",
 			},
 		},
+		"nestingmode-single-attributes": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				NestingMode: tfsdk.BlockNestingModeSingle,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaNestedBlock{
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "sub_test",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+				},
+				Nesting:  tfprotov6.SchemaNestedBlockNestingModeSingle,
+				TypeName: "test",
+			},
+		},
+		"nestingmode-single-attributes-and-blocks": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_attr": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				Blocks: map[string]tfsdk.Block{
+					"sub_block": {
+						Attributes: map[string]tfsdk.Attribute{
+							"sub_block_attr": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						},
+						NestingMode: tfsdk.BlockNestingModeSingle,
+					},
+				},
+				MinItems:    1,
+				MaxItems:    1,
+				NestingMode: tfsdk.BlockNestingModeSingle,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaNestedBlock{
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "sub_attr",
+							Optional: true,
+							Type:     tftypes.String,
+						},
+					},
+					BlockTypes: []*tfprotov6.SchemaNestedBlock{
+						{
+							Block: &tfprotov6.SchemaBlock{
+								Attributes: []*tfprotov6.SchemaAttribute{
+									{
+										Name:     "sub_block_attr",
+										Optional: true,
+										Type:     tftypes.String,
+									},
+								},
+							},
+							Nesting:  tfprotov6.SchemaNestedBlockNestingModeSingle,
+							TypeName: "sub_block",
+						},
+					},
+				},
+				MinItems: 1,
+				MaxItems: 1,
+				Nesting:  tfprotov6.SchemaNestedBlockNestingModeSingle,
+				TypeName: "test",
+			},
+		},
+		"nestingmode-single-maxitems-invalid": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				MaxItems:    2,
+				NestingMode: tfsdk.BlockNestingModeSingle,
+			},
+			path:        tftypes.NewAttributePath(),
+			expectedErr: "MinItems and MaxItems must both be 0 or both be 1 for nesting mode 3",
+		},
+		"nestingmode-single-maxitems-mismatch": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				MaxItems:    1,
+				NestingMode: tfsdk.BlockNestingModeSingle,
+			},
+			path:        tftypes.NewAttributePath(),
+			expectedErr: "MinItems and MaxItems must both be 0 or both be 1 for nesting mode 3",
+		},
+		"nestingmode-single-minitems-mismatch": {
+			name: "test",
+			block: tfsdk.Block{
+				Attributes: map[string]tfsdk.Attribute{
+					"sub_test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				MinItems:    1,
+				NestingMode: tfsdk.BlockNestingModeSingle,
+			},
+			path:        tftypes.NewAttributePath(),
+			expectedErr: "MinItems and MaxItems must both be 0 or both be 1 for nesting mode 3",
+		},
 		"deprecationmessage": {
 			name: "test",
 			block: tfsdk.Block{
@@ -1,12 +1,15 @@
 package toproto6
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
 // Diagnostics converts the diagnostics into the tfprotov6 collection type.
-func Diagnostics(diagnostics diag.Diagnostics) []*tfprotov6.Diagnostic {
+func Diagnostics(ctx context.Context, diagnostics diag.Diagnostics) []*tfprotov6.Diagnostic {
 	var results []*tfprotov6.Diagnostic
 
 	for _, diagnostic := range diagnostics {
@@ -17,7 +20,17 @@ func Diagnostics(diagnostics diag.Diagnostics) []*tfprotov6.Diagnostic {
 		}
 
 		if diagWithPath, ok := diagnostic.(diag.DiagnosticWithPath); ok {
-			tfprotov6Diagnostic.Attribute = diagWithPath.Path()
+			tfprotov6Attribute, err := totftypes.AttributePath(ctx, diagWithPath.Path())
+
+			if err != nil {
+				results = append(results, &tfprotov6.Diagnostic{
+					Detail:   "An unexpected error was encountered trying to convert the diagnostic attribute path. This is always an error in the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Diagnostic Attribute Path Conversion Error",
+				})
+			}
+
+			tfprotov6Diagnostic.Attribute = tfprotov6Attribute
 		}
 
 		results = append(results, tfprotov6Diagnostic)
@@ -16,7 +16,6 @@ func ImportedResource(ctx context.Context, fw *fwserver.ImportedResource) (*tfpr
 	}
 
 	proto6 := &tfprotov6.ImportedResource{
-		Private:  fw.Private,
 		TypeName: fw.TypeName,
 	}
 
@@ -24,5 +23,10 @@ func ImportedResource(ctx context.Context, fw *fwserver.ImportedResource) (*tfpr
 
 	proto6.State = state
 
+	newPrivate, privateDiags := fw.Private.Bytes(ctx)
+
+	diags.Append(privateDiags...)
+	proto6.Private = newPrivate
+
 	return proto6, diags
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -15,15 +16,30 @@ func PlanResourceChangeResponse(ctx context.Context, fw *fwserver.PlanResourceCh
 	}
 
 	proto6 := &tfprotov6.PlanResourceChangeResponse{
-		Diagnostics:    Diagnostics(fw.Diagnostics),
-		PlannedPrivate: fw.PlannedPrivate,
+		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
 	}
 
 	plannedState, diags := State(ctx, fw.PlannedState)
 
-	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(diags)...)
+	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 	proto6.PlannedState = plannedState
-	proto6.RequiresReplace = fw.RequiresReplace
+
+	requiresReplace, err := totftypes.AttributePaths(ctx, fw.RequiresReplace)
+
+	if err != nil {
+		proto6.Diagnostics = append(proto6.Diagnostics, &tfprotov6.Diagnostic{
+			Detail:   "An unexpected error was encountered trying to convert the RequiresReplace attribute paths. This is always an error in the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "RequiresReplace Attribute Path Conversion Error",
+		})
+	}
+
+	proto6.RequiresReplace = requiresReplace
+
+	privateData, diags := fw.PlannedPrivate.Bytes(ctx)
+
+	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
+	proto6.PlannedPrivate = privateData
 
 	return proto6
 }
@@ -7,7 +7,9 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -124,10 +126,17 @@ func TestPlanResourceChangeResponse(t *testing.T) {
 		},
 		"plannedprivate": {
 			input: &fwserver.PlanResourceChangeResponse{
-				PlannedPrivate: []byte("{}"),
+				PlannedPrivate: &privatestate.Data{
+					Framework: map[string][]byte{
+						".frameworkKey": []byte(`{"k": "v"}`),
+					},
+					Provider: privatestate.MustProviderData(context.Background(), map[string][]byte{
+						"providerKey": []byte(`{"key": "value"}`),
+					}),
+				},
 			},
 			expected: &tfprotov6.PlanResourceChangeResponse{
-				PlannedPrivate: []byte("{}"),
+				PlannedPrivate: []byte(`{".frameworkKey":"eyJrIjogInYifQ==","providerKey":"eyJrZXkiOiAidmFsdWUifQ=="}`),
 			},
 		},
 		"plannedstate": {
@@ -140,8 +149,8 @@ func TestPlanResourceChangeResponse(t *testing.T) {
 		},
 		"requiresreplace": {
 			input: &fwserver.PlanResourceChangeResponse{
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test"),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
 				},
 			},
 			expected: &tfprotov6.PlanResourceChangeResponse{
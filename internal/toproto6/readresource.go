@@ -15,14 +15,18 @@ func ReadResourceResponse(ctx context.Context, fw *fwserver.ReadResourceResponse
 	}
 
 	proto6 := &tfprotov6.ReadResourceResponse{
-		Diagnostics: Diagnostics(fw.Diagnostics),
-		Private:     fw.Private,
+		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
 	}
 
 	newState, diags := State(ctx, fw.NewState)
 
-	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(diags)...)
+	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 	proto6.NewState = newState
 
+	privateData, diags := fw.Private.Bytes(ctx)
+
+	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
+	proto6.Private = privateData
+
 	return proto6
 }
@@ -3,7 +3,9 @@ package toproto6
 import (
 	"context"
 	"sort"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -25,11 +27,16 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 		return nil, path.NewErrorf("must have Required, Optional, or Computed set")
 	}
 
+	if a.Required && a.Default != nil {
+		return nil, path.NewErrorf("cannot have Default set when Required is set")
+	}
+
 	schemaAttribute := &tfprotov6.SchemaAttribute{
-		Name:      name,
-		Required:  a.Required,
+		Name: name,
+		// Defaults are set by the provider and are therefore Computed.
+		Computed:  a.Computed || a.Default != nil,
 		Optional:  a.Optional,
-		Computed:  a.Computed,
+		Required:  a.Required,
 		Sensitive: a.Sensitive,
 	}
 
@@ -37,13 +44,36 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 		schemaAttribute.Deprecated = true
 	}
 
-	if a.Description != "" {
-		schemaAttribute.Description = a.Description
+	description := a.Description
+	markdownDescription := a.MarkdownDescription
+
+	// Type descriptions, such as allowed values, follow the same precedence
+	// as default value descriptions.
+	if markdownDescription != "" {
+		if t, ok := a.Type.(attr.TypeWithMarkdownDescription); ok {
+			markdownDescription = joinDescription(markdownDescription, t.MarkdownDescription(ctx))
+		}
+	} else if t, ok := a.Type.(attr.TypeWithPlaintextDescription); ok {
+		description = joinDescription(description, t.Description(ctx))
+	}
+
+	if a.Default != nil {
+		// The plain text default description is only used when the
+		// attribute has no Markdown description, which takes precedence.
+		if markdownDescription != "" {
+			markdownDescription = joinDescription(markdownDescription, a.Default.MarkdownDescription(ctx))
+		} else {
+			description = joinDescription(description, a.Default.Description(ctx))
+		}
+	}
+
+	if description != "" {
+		schemaAttribute.Description = description
 		schemaAttribute.DescriptionKind = tfprotov6.StringKindPlain
 	}
 
-	if a.MarkdownDescription != "" {
-		schemaAttribute.Description = a.MarkdownDescription
+	if markdownDescription != "" {
+		schemaAttribute.Description = markdownDescription
 		schemaAttribute.DescriptionKind = tfprotov6.StringKindMarkdown
 	}
 
@@ -53,6 +83,9 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 		return schemaAttribute, nil
 	}
 
+	// MinItems and MaxItems of nested attributes are not forwarded, as
+	// SchemaObject in this protocol version cannot represent them. They are
+	// instead enforced by the framework during configuration validation.
 	object := &tfprotov6.SchemaObject{}
 	nm := a.Attributes.GetNestingMode()
 	switch nm {
@@ -94,3 +127,9 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 
 	return schemaAttribute, nil
 }
+
+// joinDescription appends the additional description to the existing
+// description, separated by a space.
+func joinDescription(description string, additional string) string {
+	return strings.TrimSpace(description + " " + additional)
+}
@@ -2,6 +2,7 @@ package toproto6_test
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -89,6 +90,181 @@ func TestSchemaAttribute(t *testing.T) {
 				DescriptionKind: tfprotov6.StringKindMarkdown,
 			},
 		},
+		"type-description": {
+			name: "string",
+			attr: tfsdk.Attribute{
+				Type:        types.EnumType{AllowedValues: []string{"tcp", "udp"}},
+				Optional:    true,
+				Description: "A protocol.",
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name:            "string",
+				Type:            tftypes.String,
+				Optional:        true,
+				Description:     "A protocol. Must be one of: \"tcp\", \"udp\".",
+				DescriptionKind: tfprotov6.StringKindPlain,
+			},
+		},
+		"type-description-markdown": {
+			name: "string",
+			attr: tfsdk.Attribute{
+				Type:                types.EnumType{AllowedValues: []string{"tcp", "udp"}},
+				Optional:            true,
+				Description:         "A protocol.",
+				MarkdownDescription: "A `protocol`.",
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name:            "string",
+				Type:            tftypes.String,
+				Optional:        true,
+				Description:     "A `protocol`. Must be one of: `\"tcp\"`, `\"udp\"`.",
+				DescriptionKind: tfprotov6.StringKindMarkdown,
+			},
+		},
+		"type-description-default": {
+			name: "string",
+			attr: tfsdk.Attribute{
+				Type:     types.EnumType{AllowedValues: []string{"tcp", "udp"}},
+				Optional: true,
+				Default:  tfsdk.StaticDefault(types.Enum{Value: "tcp", AllowedValues: []string{"tcp", "udp"}}),
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name:            "string",
+				Type:            tftypes.String,
+				Optional:        true,
+				Computed:        true,
+				Description:     "Must be one of: \"tcp\", \"udp\". Defaults to \"tcp\".",
+				DescriptionKind: tfprotov6.StringKindPlain,
+			},
+		},
+		"default-static": {
+			name: "string",
+			attr: tfsdk.Attribute{
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "A string attribute.",
+				Default:     tfsdk.StaticDefault(types.String{Value: "test"}),
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name:            "string",
+				Type:            tftypes.String,
+				Optional:        true,
+				Computed:        true,
+				Description:     "A string attribute. Defaults to \"test\".",
+				DescriptionKind: tfprotov6.StringKindPlain,
+			},
+		},
+		"default-static-markdown": {
+			name: "string",
+			attr: tfsdk.Attribute{
+				Type:                types.StringType,
+				Optional:            true,
+				Description:         "A string attribute.",
+				MarkdownDescription: "A `string` attribute.",
+				Default:             tfsdk.StaticDefault(types.String{Value: "test"}),
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name:            "string",
+				Type:            tftypes.String,
+				Optional:        true,
+				Computed:        true,
+				Description:     "A `string` attribute. Defaults to `\"test\"`.",
+				DescriptionKind: tfprotov6.StringKindMarkdown,
+			},
+		},
+		"default-static-no-description": {
+			name: "number",
+			attr: tfsdk.Attribute{
+				Type:     types.NumberType,
+				Optional: true,
+				Default:  tfsdk.StaticDefault(types.Number{Value: big.NewFloat(1.5)}),
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name:            "number",
+				Type:            tftypes.Number,
+				Optional:        true,
+				Computed:        true,
+				Description:     "Defaults to 1.5.",
+				DescriptionKind: tfprotov6.StringKindPlain,
+			},
+		},
+		"default-static-nested-attributes": {
+			name: "single_nested",
+			attr: tfsdk.Attribute{
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"string": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				}),
+				Optional: true,
+				Default: tfsdk.StaticDefault(types.Object{
+					AttrTypes: map[string]attr.Type{
+						"string": types.StringType,
+					},
+					Attrs: map[string]attr.Value{
+						"string": types.String{Value: "test"},
+					},
+				}),
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name:            "single_nested",
+				Optional:        true,
+				Computed:        true,
+				Description:     "Defaults to {string = \"test\"}.",
+				DescriptionKind: tfprotov6.StringKindPlain,
+				NestedType: &tfprotov6.SchemaObject{
+					Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "string",
+							Type:     tftypes.String,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+		"default-func": {
+			name: "string",
+			attr: tfsdk.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+				Default: tfsdk.DefaultFunc(
+					func(_ context.Context, _ tfsdk.AttributeDefaultRequest, resp *tfsdk.AttributeDefaultResponse) {
+						resp.Value = types.String{Value: "test"}
+					},
+					"Defaults to the provider region.",
+					"Defaults to the provider `region`.",
+				),
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name:            "string",
+				Type:            tftypes.String,
+				Optional:        true,
+				Computed:        true,
+				Description:     "Defaults to the provider region.",
+				DescriptionKind: tfprotov6.StringKindPlain,
+			},
+		},
+		"default-required": {
+			name: "string",
+			attr: tfsdk.Attribute{
+				Type:     types.StringType,
+				Required: true,
+				Default:  tfsdk.StaticDefault(types.String{Value: "test"}),
+			},
+			path:        tftypes.NewAttributePath().WithAttributeName("string"),
+			expectedErr: "AttributeName(\"string\"): cannot have Default set when Required is set",
+		},
 		"attr-string": {
 			name: "string",
 			attr: tfsdk.Attribute{
@@ -102,6 +278,36 @@ func TestSchemaAttribute(t *testing.T) {
 				Optional: true,
 			},
 		},
+		"attr-dynamic": {
+			name: "dynamic",
+			attr: tfsdk.Attribute{
+				Type:     types.DynamicType{},
+				Optional: true,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name:     "dynamic",
+				Type:     tftypes.DynamicPseudoType,
+				Optional: true,
+			},
+		},
+		"attr-tuple": {
+			name: "tuple",
+			attr: tfsdk.Attribute{
+				Type: types.TupleType{
+					ElemTypes: []attr.Type{types.StringType, types.DynamicType{}},
+				},
+				Optional: true,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name: "tuple",
+				Type: tftypes.Tuple{
+					ElementTypes: []tftypes.Type{tftypes.String, tftypes.DynamicPseudoType},
+				},
+				Optional: true,
+			},
+		},
 		"attr-bool": {
 			name: "bool",
 			attr: tfsdk.Attribute{
@@ -0,0 +1,65 @@
+package totftypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AttributePath returns the *tftypes.AttributePath equivalent of a path.Path.
+func AttributePath(ctx context.Context, fw path.Path) (*tftypes.AttributePath, error) {
+	var tfTypeSteps []tftypes.AttributePathStep
+
+	for _, step := range fw.Steps() {
+		tfTypeStep, err := AttributePathStep(ctx, step)
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert attribute path %s: %w", fw, err)
+		}
+
+		tfTypeSteps = append(tfTypeSteps, tfTypeStep)
+	}
+
+	return tftypes.NewAttributePathWithSteps(tfTypeSteps), nil
+}
+
+// AttributePaths returns the []*tftypes.AttributePath equivalent of a
+// path.Paths.
+func AttributePaths(ctx context.Context, fw path.Paths) ([]*tftypes.AttributePath, error) {
+	if fw == nil {
+		return nil, nil
+	}
+
+	result := make([]*tftypes.AttributePath, 0, len(fw))
+
+	for _, fwPath := range fw {
+		tfType, err := AttributePath(ctx, fwPath)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, tfType)
+	}
+
+	return result, nil
+}
+
+// AttributePathStep returns the tftypes.AttributePathStep equivalent of a
+// path.PathStep.
+func AttributePathStep(_ context.Context, fw path.PathStep) (tftypes.AttributePathStep, error) {
+	switch step := fw.(type) {
+	case path.PathStepAttributeName:
+		return tftypes.AttributeName(string(step)), nil
+	case path.PathStepElementKeyInt:
+		return tftypes.ElementKeyInt(int64(step)), nil
+	case path.PathStepElementKeyString:
+		return tftypes.ElementKeyString(string(step)), nil
+	case path.PathStepElementKeyValue:
+		return tftypes.ElementKeyValue(step.Value), nil
+	default:
+		return nil, fmt.Errorf("unknown path.PathStep: %#v", step)
+	}
+}
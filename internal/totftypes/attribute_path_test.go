@@ -0,0 +1,109 @@
+package totftypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAttributePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fw       path.Path
+		expected *tftypes.AttributePath
+	}{
+		"empty": {
+			fw:       path.Empty(),
+			expected: tftypes.NewAttributePath(),
+		},
+		"AttributeName": {
+			fw:       path.Root("test"),
+			expected: tftypes.NewAttributePath().WithAttributeName("test"),
+		},
+		"AttributeName-ElementKeyInt": {
+			fw:       path.Root("test").AtListIndex(1),
+			expected: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(1),
+		},
+		"AttributeName-ElementKeyString": {
+			fw:       path.Root("test").AtMapKey("key"),
+			expected: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+		},
+		"AttributeName-ElementKeyValue": {
+			fw:       path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+			expected: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+		},
+		"AttributeName-ElementKeyInt-AttributeName": {
+			fw:       path.Root("test").AtTupleIndex(0).AtName("nested"),
+			expected: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("nested"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := totftypes.AttributePath(context.Background(), testCase.fw)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributePaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fw       path.Paths
+		expected []*tftypes.AttributePath
+	}{
+		"nil": {
+			fw:       nil,
+			expected: nil,
+		},
+		"empty": {
+			fw:       path.Paths{},
+			expected: []*tftypes.AttributePath{},
+		},
+		"multiple": {
+			fw: path.Paths{
+				path.Root("test1"),
+				path.Root("test2").AtListIndex(0),
+			},
+			expected: []*tftypes.AttributePath{
+				tftypes.NewAttributePath().WithAttributeName("test1"),
+				tftypes.NewAttributePath().WithAttributeName("test2").WithElementKeyInt(0),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := totftypes.AttributePaths(context.Background(), testCase.fw)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
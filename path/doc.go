@@ -0,0 +1,20 @@
+// Package path implements attribute path functionality, which defines
+// transversals into schema-based data.
+//
+// A Path is an exact location within a schema or schema-based data, such as
+// State, Plan, or Config. Each Path is made up of PathSteps, such as
+// attribute names, list or tuple indexes, map keys, and set values.
+//
+// An Expression is a representation of one or more Paths, which can include
+// steps that match any element of a collection or steps that traverse back
+// to a parent. Expressions can either be absolute, starting from the root of
+// the schema, or relative, starting from a given Path. Expressions are
+// resolved into Paths against schema-based data, such as with the
+// PathMatches methods of Config, Plan, and State.
+//
+// Both Paths and Expressions can be rendered with their String methods and
+// parsed with the Parse and ParseExpression functions, using the form
+// practitioners are familiar with from Terraform configuration:
+//
+//	root_attribute.nested_attribute[0].map_attribute["key"]
+package path
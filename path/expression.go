@@ -0,0 +1,238 @@
+package path
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Expression represents an attribute path with expression steps, which can
+// represent zero, one, or more actual Paths.
+//
+// Use the MatchRoot() function to create an Expression starting from the
+// root of the schema, or the MatchRelative() function to create an
+// Expression relative to another Path or Expression, such as the path of
+// the attribute currently being validated. Expression functionality follows
+// a builder pattern, which allows for chaining method calls to construct a
+// full expression. The available traversal steps after Expression creation
+// are:
+//
+//   - AtAnyElement(): Step into a list, map, set, or tuple at any element
+//   - AtListIndex(): Step into a list at a specific 0-based index
+//   - AtMapKey(): Step into a map at a specific key
+//   - AtName(): Step into an attribute or block with a specific name
+//   - AtParent(): Step backwards to the parent of the previous step
+//   - AtSetValue(): Step into a set at a specific value
+//   - AtTupleIndex(): Step into a tuple at a specific 0-based index
+//
+// For example, to express any element of a root list attribute named
+// "some_attribute":
+//
+//	path.MatchRoot("some_attribute").AtAnyElement()
+//
+// Or to express a sibling attribute named "other_attribute" of the current
+// attribute:
+//
+//	path.MatchRelative().AtParent().AtName("other_attribute")
+//
+// Expressions are resolved into Paths against schema-based data, such as
+// with the Config.PathMatches method.
+type Expression struct {
+	// root stores whether the expression is intended to begin at the root
+	// of the schema, rather than relative to another path or expression.
+	root bool
+
+	// steps is the transversals included with the expression. In general,
+	// operations against the expression should protect against
+	// modification of the original.
+	steps ExpressionSteps
+}
+
+// MatchRoot creates an attribute path expression starting with an
+// ExpressionStepAttributeNameExact.
+func MatchRoot(rootAttributeName string) Expression {
+	return Expression{
+		root: true,
+		steps: ExpressionSteps{
+			ExpressionStepAttributeNameExact(rootAttributeName),
+		},
+	}
+}
+
+// MatchRelative creates an empty attribute path expression that is
+// intended to be combined with an existing Path or Expression, such as the
+// path of the attribute being validated, via the Merge method.
+func MatchRelative() Expression {
+	return Expression{
+		steps: ExpressionSteps{},
+	}
+}
+
+// AtAnyElement returns a copied expression with a new step at the end
+// that matches any element of a list, map, set, or tuple. The returned
+// expression is safe to modify without affecting the original.
+func (e Expression) AtAnyElement() Expression {
+	return e.at(ExpressionStepElementAny{})
+}
+
+// AtListIndex returns a copied expression with a new list index step at
+// the end. The returned expression is safe to modify without affecting the
+// original.
+func (e Expression) AtListIndex(index int) Expression {
+	return e.at(ExpressionStepElementKeyIntExact(index))
+}
+
+// AtMapKey returns a copied expression with a new map key step at the end.
+// The returned expression is safe to modify without affecting the original.
+func (e Expression) AtMapKey(key string) Expression {
+	return e.at(ExpressionStepElementKeyStringExact(key))
+}
+
+// AtName returns a copied expression with a new attribute or block name
+// step at the end. The returned expression is safe to modify without
+// affecting the original.
+func (e Expression) AtName(name string) Expression {
+	return e.at(ExpressionStepAttributeNameExact(name))
+}
+
+// AtParent returns a copied expression with a new parent step at the end.
+// The returned expression is safe to modify without affecting the original.
+func (e Expression) AtParent() Expression {
+	return e.at(ExpressionStepParent{})
+}
+
+// AtSetValue returns a copied expression with a new set value step at the
+// end. The returned expression is safe to modify without affecting the
+// original.
+func (e Expression) AtSetValue(value tftypes.Value) Expression {
+	return e.at(ExpressionStepElementKeyValueExact{Value: value})
+}
+
+// AtTupleIndex returns a copied expression with a new tuple index step at
+// the end. The returned expression is safe to modify without affecting the
+// original.
+func (e Expression) AtTupleIndex(index int) Expression {
+	return e.at(ExpressionStepElementKeyIntExact(index))
+}
+
+// Copy returns a duplicate of the expression that is safe to modify without
+// affecting the original.
+func (e Expression) Copy() Expression {
+	return Expression{
+		root:  e.root,
+		steps: e.Steps(),
+	}
+}
+
+// Equal returns true if the given expression is exactly equivalent.
+func (e Expression) Equal(o Expression) bool {
+	if e.root != o.root {
+		return false
+	}
+
+	return e.steps.Equal(o.steps)
+}
+
+// IsRelative returns true if the expression is intended to be combined
+// with another Path or Expression via the Merge method.
+func (e Expression) IsRelative() bool {
+	return !e.root
+}
+
+// IsRoot returns true if the expression begins at the root of the schema.
+func (e Expression) IsRoot() bool {
+	return e.root
+}
+
+// Matches returns true if the given Path is fulfilled by the Expression.
+// The Expression is resolved before matching.
+func (e Expression) Matches(path Path) bool {
+	return e.steps.Matches(path.steps)
+}
+
+// MatchesParent returns true if the given Path leads to the parent of one
+// or more potential matches of the Expression. The Expression is resolved
+// before matching.
+func (e Expression) MatchesParent(path Path) bool {
+	return e.steps.MatchesParent(path.steps)
+}
+
+// Merge returns a copied expression either with the steps of the given
+// expression added to the end of the existing steps, or overwriting the
+// steps if the given expression is a root expression. The result is
+// resolved, which removes any parent steps.
+//
+// In general, this functionality is used to combine the path of an
+// attribute with a relative expression, such as one given to a validator.
+func (e Expression) Merge(other Expression) Expression {
+	if other.root {
+		return other.Resolve()
+	}
+
+	copiedExpression := e.Copy()
+
+	copiedExpression.steps.Append(other.steps...)
+
+	return copiedExpression.Resolve()
+}
+
+// MergeExpressions returns collection of expressions that calls Merge on
+// the current expression with each of the others.
+func (e Expression) MergeExpressions(others ...Expression) Expressions {
+	var result Expressions
+
+	for _, other := range others {
+		result.Append(e.Merge(other))
+	}
+
+	return result
+}
+
+// Resolve returns a copied expression with any parent steps removed, along
+// with the step each one refers to. Parent steps at the beginning of a root
+// expression, which would step above the root of the schema, are also
+// removed.
+func (e Expression) Resolve() Expression {
+	resolvedSteps := e.steps.Resolve()
+
+	if e.root {
+		for {
+			firstStep, remainingSteps := resolvedSteps.NextStep()
+
+			if _, ok := firstStep.(ExpressionStepParent); !ok {
+				break
+			}
+
+			resolvedSteps = remainingSteps
+		}
+	}
+
+	return Expression{
+		root:  e.root,
+		steps: resolvedSteps,
+	}
+}
+
+// Steps returns a copy of the underlying expression steps. Returns an empty
+// collection of steps if expression is nil.
+func (e Expression) Steps() ExpressionSteps {
+	if len(e.steps) == 0 {
+		return ExpressionSteps{}
+	}
+
+	return e.steps.Copy()
+}
+
+// String returns the human-readable representation of the expression.
+// It is intended for logging and error messages and is not protected by
+// compatibility guarantees.
+func (e Expression) String() string {
+	return e.steps.String()
+}
+
+// at returns a copy of the expression with the given step appended.
+func (e Expression) at(step ExpressionStep) Expression {
+	copiedExpression := e.Copy()
+
+	copiedExpression.steps.Append(step)
+
+	return copiedExpression
+}
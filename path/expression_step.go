@@ -0,0 +1,21 @@
+package path
+
+// ExpressionStep represents an expression of an attribute path step, which
+// may match zero, one, or more actual paths.
+type ExpressionStep interface {
+	// Equal should return true if the given ExpressionStep is exactly
+	// equivalent.
+	Equal(ExpressionStep) bool
+
+	// Matches should return true if the given PathStep can be fulfilled
+	// by the ExpressionStep.
+	Matches(PathStep) bool
+
+	// String should return a human-readable representation of the step
+	// intended for logging and error messages. There is no guarantee that
+	// the returned string can be parsed back into an ExpressionStep.
+	String() string
+
+	// unexported prevents outside types from satisfying the interface.
+	unexported()
+}
@@ -0,0 +1,36 @@
+package path
+
+// Ensure ExpressionStepElementAny satisfies the ExpressionStep interface.
+var _ ExpressionStep = ExpressionStepElementAny{}
+
+// ExpressionStepElementAny is an attribute path expression for a matching
+// any element of a list, map, set, or tuple. It does not match attribute
+// names within an object.
+type ExpressionStepElementAny struct{}
+
+// Equal returns true if the given ExpressionStep is a
+// ExpressionStepElementAny.
+func (s ExpressionStepElementAny) Equal(o ExpressionStep) bool {
+	_, ok := o.(ExpressionStepElementAny)
+
+	return ok
+}
+
+// Matches returns true if the given PathStep is any element key step.
+func (s ExpressionStepElementAny) Matches(pathStep PathStep) bool {
+	switch pathStep.(type) {
+	case PathStepElementKeyInt, PathStepElementKeyString, PathStepElementKeyValue:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the human-readable representation of the element
+// expression. It is also used in expression string building.
+func (s ExpressionStepElementAny) String() string {
+	return "[*]"
+}
+
+// unexported satisfies the ExpressionStep interface.
+func (s ExpressionStepElementAny) unexported() {}
@@ -0,0 +1,31 @@
+package path
+
+// Ensure ExpressionStepParent satisfies the ExpressionStep interface.
+var _ ExpressionStep = ExpressionStepParent{}
+
+// ExpressionStepParent is an attribute path expression for a traversal to
+// the parent step of the expression. It is removed when an expression is
+// resolved and never matches a PathStep itself.
+type ExpressionStepParent struct{}
+
+// Equal returns true if the given ExpressionStep is a ExpressionStepParent.
+func (s ExpressionStepParent) Equal(o ExpressionStep) bool {
+	_, ok := o.(ExpressionStepParent)
+
+	return ok
+}
+
+// Matches always returns false, as parent steps must be resolved before
+// matching.
+func (s ExpressionStepParent) Matches(_ PathStep) bool {
+	return false
+}
+
+// String returns the human-readable representation of the parent
+// expression. It is also used in expression string building.
+func (s ExpressionStepParent) String() string {
+	return "<"
+}
+
+// unexported satisfies the ExpressionStep interface.
+func (s ExpressionStepParent) unexported() {}
@@ -0,0 +1,157 @@
+package path
+
+import "strings"
+
+// ExpressionSteps represents an ordered collection of attribute path
+// expressions.
+type ExpressionSteps []ExpressionStep
+
+// Append adds the given ExpressionSteps to the end of the previous
+// ExpressionSteps and returns the combined result.
+func (s *ExpressionSteps) Append(steps ...ExpressionStep) ExpressionSteps {
+	if s == nil {
+		return steps
+	}
+
+	*s = append(*s, steps...)
+
+	return *s
+}
+
+// Copy returns a duplicate of the steps that is safe to modify without
+// affecting the original. Returns nil if the original steps is nil.
+func (s ExpressionSteps) Copy() ExpressionSteps {
+	if s == nil {
+		return nil
+	}
+
+	copiedExpressionSteps := make(ExpressionSteps, len(s))
+
+	copy(copiedExpressionSteps, s)
+
+	return copiedExpressionSteps
+}
+
+// Equal returns true if the given ExpressionSteps are equivalent.
+func (s ExpressionSteps) Equal(o ExpressionSteps) bool {
+	if len(s) != len(o) {
+		return false
+	}
+
+	for stepIndex, step := range s {
+		if !step.Equal(o[stepIndex]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// LastStep returns the final ExpressionStep and the remaining
+// ExpressionSteps.
+func (s ExpressionSteps) LastStep() (ExpressionStep, ExpressionSteps) {
+	if len(s) == 0 {
+		return nil, ExpressionSteps{}
+	}
+
+	if len(s) == 1 {
+		return s[0], ExpressionSteps{}
+	}
+
+	return s[len(s)-1], s[:len(s)-1]
+}
+
+// Matches returns true if the given PathSteps are fulfilled by the
+// ExpressionSteps. The ExpressionSteps are resolved before matching.
+func (s ExpressionSteps) Matches(pathSteps PathSteps) bool {
+	resolvedExpressionSteps := s.Resolve()
+
+	if len(resolvedExpressionSteps) != len(pathSteps) {
+		return false
+	}
+
+	for stepIndex, expressionStep := range resolvedExpressionSteps {
+		if !expressionStep.Matches(pathSteps[stepIndex]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MatchesParent returns true if the given PathSteps are fulfilled by the
+// beginning of the ExpressionSteps, meaning that the PathSteps lead to the
+// parent of one or more potential matches. The ExpressionSteps are resolved
+// before matching.
+func (s ExpressionSteps) MatchesParent(pathSteps PathSteps) bool {
+	resolvedExpressionSteps := s.Resolve()
+
+	if len(pathSteps) >= len(resolvedExpressionSteps) {
+		return false
+	}
+
+	for stepIndex, pathStep := range pathSteps {
+		if !resolvedExpressionSteps[stepIndex].Matches(pathStep) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NextStep returns the first ExpressionStep and the remaining
+// ExpressionSteps.
+func (s ExpressionSteps) NextStep() (ExpressionStep, ExpressionSteps) {
+	if len(s) == 0 {
+		return nil, s
+	}
+
+	return s[0], s[1:]
+}
+
+// Resolve returns a copy of the ExpressionSteps with ExpressionStepParent
+// removed, along with the step each one refers to. Parent steps which
+// cannot be resolved, such as those at the beginning of the steps, are
+// preserved.
+func (s ExpressionSteps) Resolve() ExpressionSteps {
+	result := make(ExpressionSteps, 0, len(s))
+
+	for _, step := range s {
+		if _, ok := step.(ExpressionStepParent); !ok {
+			result.Append(step)
+
+			continue
+		}
+
+		lastStep, remainingSteps := result.LastStep()
+
+		if _, ok := lastStep.(ExpressionStepParent); lastStep == nil || ok {
+			result.Append(step)
+
+			continue
+		}
+
+		result = remainingSteps
+	}
+
+	return result
+}
+
+// String returns the human-readable representation of the ExpressionSteps.
+// It is also used in expression string building.
+func (s ExpressionSteps) String() string {
+	var result strings.Builder
+
+	for stepIndex, step := range s {
+		switch step.(type) {
+		case ExpressionStepAttributeNameExact, ExpressionStepParent:
+			if stepIndex != 0 {
+				result.WriteString(".")
+			}
+		}
+
+		result.WriteString(step.String())
+	}
+
+	return result.String()
+}
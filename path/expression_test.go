@@ -0,0 +1,227 @@
+package path_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestExpressionMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression path.Expression
+		path       path.Path
+		expected   bool
+	}{
+		"root-match": {
+			expression: path.MatchRoot("test"),
+			path:       path.Root("test"),
+			expected:   true,
+		},
+		"root-mismatch": {
+			expression: path.MatchRoot("test"),
+			path:       path.Root("other"),
+			expected:   false,
+		},
+		"root-mismatch-length": {
+			expression: path.MatchRoot("test"),
+			path:       path.Root("test").AtName("nested"),
+			expected:   false,
+		},
+		"any-element-list": {
+			expression: path.MatchRoot("test").AtAnyElement().AtName("nested"),
+			path:       path.Root("test").AtListIndex(1).AtName("nested"),
+			expected:   true,
+		},
+		"any-element-map": {
+			expression: path.MatchRoot("test").AtAnyElement(),
+			path:       path.Root("test").AtMapKey("key"),
+			expected:   true,
+		},
+		"any-element-set": {
+			expression: path.MatchRoot("test").AtAnyElement(),
+			path:       path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+			expected:   true,
+		},
+		"any-element-attribute-name": {
+			expression: path.MatchRoot("test").AtAnyElement(),
+			path:       path.Root("test").AtName("nested"),
+			expected:   false,
+		},
+		"list-index-mismatch": {
+			expression: path.MatchRoot("test").AtListIndex(0),
+			path:       path.Root("test").AtListIndex(1),
+			expected:   false,
+		},
+		"map-key-match": {
+			expression: path.MatchRoot("test").AtMapKey("key"),
+			path:       path.Root("test").AtMapKey("key"),
+			expected:   true,
+		},
+		"set-value-match": {
+			expression: path.MatchRoot("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+			path:       path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+			expected:   true,
+		},
+		"parent": {
+			expression: path.MatchRoot("test").AtName("child").AtParent().AtName("sibling"),
+			path:       path.Root("test").AtName("sibling"),
+			expected:   true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.expression.Matches(tc.path)
+
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpressionMatchesParent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression path.Expression
+		path       path.Path
+		expected   bool
+	}{
+		"empty-path": {
+			expression: path.MatchRoot("test"),
+			path:       path.Empty(),
+			expected:   true,
+		},
+		"exact-match": {
+			expression: path.MatchRoot("test"),
+			path:       path.Root("test"),
+			expected:   false,
+		},
+		"parent": {
+			expression: path.MatchRoot("test").AtAnyElement().AtName("nested"),
+			path:       path.Root("test").AtListIndex(0),
+			expected:   true,
+		},
+		"not-parent": {
+			expression: path.MatchRoot("test").AtAnyElement().AtName("nested"),
+			path:       path.Root("other").AtListIndex(0),
+			expected:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.expression.MatchesParent(tc.path)
+
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpressionMerge(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression path.Expression
+		other      path.Expression
+		expected   path.Expression
+	}{
+		"relative-child": {
+			expression: path.MatchRoot("test"),
+			other:      path.MatchRelative().AtName("nested"),
+			expected:   path.MatchRoot("test").AtName("nested"),
+		},
+		"relative-sibling": {
+			expression: path.MatchRoot("test").AtAnyElement().AtName("nested"),
+			other:      path.MatchRelative().AtParent().AtName("sibling"),
+			expected:   path.MatchRoot("test").AtAnyElement().AtName("sibling"),
+		},
+		"relative-above-root": {
+			expression: path.MatchRoot("test"),
+			other:      path.MatchRelative().AtParent().AtParent().AtName("other"),
+			expected:   path.MatchRoot("other"),
+		},
+		"root": {
+			expression: path.MatchRoot("test"),
+			other:      path.MatchRoot("other"),
+			expected:   path.MatchRoot("other"),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.expression.Merge(tc.other)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpressionString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression path.Expression
+		expected   string
+	}{
+		"relative-empty": {
+			expression: path.MatchRelative(),
+			expected:   "",
+		},
+		"root": {
+			expression: path.MatchRoot("test"),
+			expected:   "test",
+		},
+		"any-element": {
+			expression: path.MatchRoot("test").AtAnyElement().AtName("nested"),
+			expected:   "test[*].nested",
+		},
+		"parent": {
+			expression: path.MatchRelative().AtParent().AtName("sibling"),
+			expected:   "<.sibling",
+		},
+		"map-key": {
+			expression: path.MatchRoot("test").AtMapKey("key"),
+			expected:   `test["key"]`,
+		},
+		"path": {
+			expression: path.Root("test").AtListIndex(0).Expression(),
+			expected:   "test[0]",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.expression.String()
+
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
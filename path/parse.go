@@ -0,0 +1,216 @@
+package path
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Parse returns the Path represented by the given string, which uses the
+// same form as the String method and Terraform configuration references,
+// such as:
+//
+//	root_attribute.nested_attribute[0].map_attribute["key"]
+//
+// Set value steps cannot be parsed and will return an error. An empty
+// string returns an empty Path.
+func Parse(input string) (Path, error) {
+	expressionSteps, err := parseExpressionSteps(input, false)
+
+	if err != nil {
+		return Path{}, err
+	}
+
+	pathSteps := make(PathSteps, 0, len(expressionSteps))
+
+	for _, expressionStep := range expressionSteps {
+		switch step := expressionStep.(type) {
+		case ExpressionStepAttributeNameExact:
+			pathSteps.Append(PathStepAttributeName(step))
+		case ExpressionStepElementKeyIntExact:
+			pathSteps.Append(PathStepElementKeyInt(step))
+		case ExpressionStepElementKeyStringExact:
+			pathSteps.Append(PathStepElementKeyString(step))
+		default:
+			return Path{}, fmt.Errorf("unable to parse path %q: unexpected step %s", input, expressionStep)
+		}
+	}
+
+	return Path{
+		steps: pathSteps,
+	}, nil
+}
+
+// ParseExpression returns the Expression represented by the given string.
+// In addition to the steps supported by Parse, expressions support
+// matching any element of a list, map, set, or tuple with [*] and stepping
+// back to the parent of the previous step with <, such as:
+//
+//	root_attribute.nested_attribute[*].<.other_attribute
+//
+// Expressions which begin with a parent step, such as <.other_attribute,
+// are relative and intended to be merged with another Path or Expression.
+// All other expressions begin at the root of the schema.
+func ParseExpression(input string) (Expression, error) {
+	expressionSteps, err := parseExpressionSteps(input, true)
+
+	if err != nil {
+		return Expression{}, err
+	}
+
+	expression := Expression{
+		root:  true,
+		steps: expressionSteps,
+	}
+
+	if firstStep, _ := expressionSteps.NextStep(); firstStep != nil {
+		if _, ok := firstStep.(ExpressionStepParent); ok {
+			expression.root = false
+		}
+	}
+
+	return expression, nil
+}
+
+// parseExpressionSteps converts the input string into ExpressionSteps. If
+// allowExpressions is false, only exact steps are accepted.
+func parseExpressionSteps(input string, allowExpressions bool) (ExpressionSteps, error) {
+	steps := ExpressionSteps{}
+
+	if input == "" {
+		return steps, nil
+	}
+
+	position := 0
+	expectName := true
+
+	for position < len(input) {
+		if expectName {
+			if allowExpressions && input[position] == '<' {
+				steps.Append(ExpressionStepParent{})
+				position++
+				expectName = false
+
+				continue
+			}
+
+			end := position
+
+			for end < len(input) && isNameCharacter(input[end]) {
+				end++
+			}
+
+			if end == position {
+				return nil, parseErrorf(input, position, "expected attribute name")
+			}
+
+			steps.Append(ExpressionStepAttributeNameExact(input[position:end]))
+			position = end
+			expectName = false
+
+			continue
+		}
+
+		switch input[position] {
+		case '.':
+			position++
+			expectName = true
+		case '[':
+			step, end, err := parseElementStep(input, position, allowExpressions)
+
+			if err != nil {
+				return nil, err
+			}
+
+			steps.Append(step)
+			position = end
+		default:
+			return nil, parseErrorf(input, position, "expected . or [")
+		}
+	}
+
+	if expectName {
+		return nil, parseErrorf(input, position, "expected attribute name")
+	}
+
+	return steps, nil
+}
+
+// parseElementStep converts the bracketed element step starting at
+// position into an ExpressionStep, returning the position after the closing
+// bracket.
+func parseElementStep(input string, position int, allowExpressions bool) (ExpressionStep, int, error) {
+	// skip the opening bracket
+	start := position + 1
+
+	if start >= len(input) {
+		return nil, 0, parseErrorf(input, start, "expected element key")
+	}
+
+	var step ExpressionStep
+	var end int
+
+	switch {
+	case input[start] == '*':
+		if !allowExpressions {
+			return nil, 0, parseErrorf(input, start, "element wildcards are only supported in expressions")
+		}
+
+		step = ExpressionStepElementAny{}
+		end = start + 1
+	case input[start] == '"':
+		quoted, err := strconv.QuotedPrefix(input[start:])
+
+		if err != nil {
+			return nil, 0, parseErrorf(input, start, "expected quoted map key")
+		}
+
+		key, err := strconv.Unquote(quoted)
+
+		if err != nil {
+			return nil, 0, parseErrorf(input, start, "expected quoted map key")
+		}
+
+		step = ExpressionStepElementKeyStringExact(key)
+		end = start + len(quoted)
+	case input[start] >= '0' && input[start] <= '9':
+		end = start
+
+		for end < len(input) && input[end] >= '0' && input[end] <= '9' {
+			end++
+		}
+
+		index, err := strconv.ParseInt(input[start:end], 10, 64)
+
+		if err != nil {
+			return nil, 0, parseErrorf(input, start, "expected list or tuple index")
+		}
+
+		step = ExpressionStepElementKeyIntExact(index)
+	default:
+		return nil, 0, parseErrorf(input, start, "expected list or tuple index, quoted map key, or *")
+	}
+
+	if end >= len(input) || input[end] != ']' {
+		return nil, 0, parseErrorf(input, end, "expected ]")
+	}
+
+	return step, end + 1, nil
+}
+
+// isNameCharacter returns true if the given byte can be used in an
+// attribute or block name.
+func isNameCharacter(b byte) bool {
+	switch {
+	case b >= 'a' && b <= 'z', b >= 'A' && b <= 'Z', b >= '0' && b <= '9':
+		return true
+	case b == '_', b == '-':
+		return true
+	default:
+		return false
+	}
+}
+
+// parseErrorf returns an error for the given input and position.
+func parseErrorf(input string, position int, format string, a ...interface{}) error {
+	return fmt.Errorf("unable to parse %q at position %d: %s", input, position, fmt.Sprintf(format, a...))
+}
@@ -0,0 +1,158 @@
+package path_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		expected    path.Path
+		expectedErr string
+	}{
+		"empty": {
+			input:    "",
+			expected: path.Empty(),
+		},
+		"root": {
+			input:    "test",
+			expected: path.Root("test"),
+		},
+		"nested": {
+			input:    `test[0].nested["key"].other_attr`,
+			expected: path.Root("test").AtListIndex(0).AtName("nested").AtMapKey("key").AtName("other_attr"),
+		},
+		"map-key-escaped": {
+			input:    `test["key.with \"quotes\""]`,
+			expected: path.Root("test").AtMapKey(`key.with "quotes"`),
+		},
+		"leading-element": {
+			input:       "[0]",
+			expectedErr: `unable to parse "[0]" at position 0: expected attribute name`,
+		},
+		"trailing-dot": {
+			input:       "test.",
+			expectedErr: `unable to parse "test." at position 5: expected attribute name`,
+		},
+		"unclosed-bracket": {
+			input:       "test[0",
+			expectedErr: `unable to parse "test[0" at position 6: expected ]`,
+		},
+		"unquoted-map-key": {
+			input:       "test[key]",
+			expectedErr: `unable to parse "test[key]" at position 5: expected list or tuple index, quoted map key, or *`,
+		},
+		"element-any": {
+			input:       "test[*]",
+			expectedErr: `unable to parse "test[*]" at position 5: element wildcards are only supported in expressions`,
+		},
+		"parent": {
+			input:       "<.test",
+			expectedErr: `unable to parse "<.test" at position 0: expected attribute name`,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := path.Parse(tc.input)
+
+			if err != nil {
+				if tc.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != tc.expectedErr {
+					t.Fatalf("expected error %q, got %q", tc.expectedErr, err)
+				}
+
+				return
+			}
+
+			if tc.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", tc.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if got.String() != tc.input {
+				t.Errorf("expected roundtrip string %q, got %q", tc.input, got.String())
+			}
+		})
+	}
+}
+
+func TestParseExpression(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		expected    path.Expression
+		expectedErr string
+	}{
+		"root": {
+			input:    "test",
+			expected: path.MatchRoot("test"),
+		},
+		"element-any": {
+			input:    "test[*].nested",
+			expected: path.MatchRoot("test").AtAnyElement().AtName("nested"),
+		},
+		"relative": {
+			input:    "<.sibling",
+			expected: path.MatchRelative().AtParent().AtName("sibling"),
+		},
+		"nested-parent": {
+			input:    "test[0].<.other",
+			expected: path.MatchRoot("test").AtListIndex(0).AtParent().AtName("other"),
+		},
+		"element-any-unclosed": {
+			input:       "test[*",
+			expectedErr: `unable to parse "test[*" at position 6: expected ]`,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := path.ParseExpression(tc.input)
+
+			if err != nil {
+				if tc.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != tc.expectedErr {
+					t.Fatalf("expected error %q, got %q", tc.expectedErr, err)
+				}
+
+				return
+			}
+
+			if tc.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", tc.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if got.String() != tc.input {
+				t.Errorf("expected roundtrip string %q, got %q", tc.input, got.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package path
+
+// PathStep represents a transversal for an attribute path. Only exported
+// PathStep types in this package are considered valid.
+type PathStep interface {
+	// Equal should return true if the given PathStep is exactly equivalent.
+	Equal(PathStep) bool
+
+	// ExpressionStep should return an ExpressionStep which exactly
+	// matches the PathStep.
+	ExpressionStep() ExpressionStep
+
+	// String should return a human-readable representation of the step
+	// intended for logging and error messages. There is no guarantee that
+	// the returned string can be parsed back into a PathStep.
+	String() string
+
+	// unexported prevents outside types from satisfying the interface.
+	unexported()
+}
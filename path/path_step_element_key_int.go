@@ -0,0 +1,36 @@
+package path
+
+import "strconv"
+
+// Ensure PathStepElementKeyInt satisfies the PathStep interface.
+var _ PathStep = PathStepElementKeyInt(0)
+
+// PathStepElementKeyInt is an attribute path transversal for an integer
+// element of a list or tuple.
+type PathStepElementKeyInt int64
+
+// Equal returns true if the given PathStep is a PathStepElementKeyInt and the
+// integer element key is equivalent.
+func (s PathStepElementKeyInt) Equal(o PathStep) bool {
+	other, ok := o.(PathStepElementKeyInt)
+
+	if !ok {
+		return false
+	}
+
+	return int64(s) == int64(other)
+}
+
+// ExpressionStep returns the ExpressionStep for the PathStep.
+func (s PathStepElementKeyInt) ExpressionStep() ExpressionStep {
+	return ExpressionStepElementKeyIntExact(s)
+}
+
+// String returns the human-readable representation of the element key.
+// It is also used in path and expression string building.
+func (s PathStepElementKeyInt) String() string {
+	return "[" + strconv.FormatInt(int64(s), 10) + "]"
+}
+
+// unexported satisfies the PathStep interface.
+func (s PathStepElementKeyInt) unexported() {}
@@ -0,0 +1,36 @@
+package path
+
+import "strconv"
+
+// Ensure PathStepElementKeyString satisfies the PathStep interface.
+var _ PathStep = PathStepElementKeyString("")
+
+// PathStepElementKeyString is an attribute path transversal for a string
+// key of a map.
+type PathStepElementKeyString string
+
+// Equal returns true if the given PathStep is a PathStepElementKeyString and
+// the string element key is equivalent.
+func (s PathStepElementKeyString) Equal(o PathStep) bool {
+	other, ok := o.(PathStepElementKeyString)
+
+	if !ok {
+		return false
+	}
+
+	return string(s) == string(other)
+}
+
+// ExpressionStep returns the ExpressionStep for the PathStep.
+func (s PathStepElementKeyString) ExpressionStep() ExpressionStep {
+	return ExpressionStepElementKeyStringExact(s)
+}
+
+// String returns the human-readable representation of the element key.
+// It is also used in path and expression string building.
+func (s PathStepElementKeyString) String() string {
+	return "[" + strconv.Quote(string(s)) + "]"
+}
+
+// unexported satisfies the PathStep interface.
+func (s PathStepElementKeyString) unexported() {}
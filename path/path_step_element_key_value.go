@@ -0,0 +1,42 @@
+package path
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure PathStepElementKeyValue satisfies the PathStep interface.
+var _ PathStep = PathStepElementKeyValue{}
+
+// PathStepElementKeyValue is an attribute path transversal for a set
+// element, which is identified by its entire value.
+type PathStepElementKeyValue struct {
+	// Value is the set element.
+	Value tftypes.Value
+}
+
+// Equal returns true if the given PathStep is a PathStepElementKeyValue and
+// the value element key is equivalent.
+func (s PathStepElementKeyValue) Equal(o PathStep) bool {
+	other, ok := o.(PathStepElementKeyValue)
+
+	if !ok {
+		return false
+	}
+
+	return s.Value.Equal(other.Value)
+}
+
+// ExpressionStep returns the ExpressionStep for the PathStep.
+func (s PathStepElementKeyValue) ExpressionStep() ExpressionStep {
+	return ExpressionStepElementKeyValueExact(s)
+}
+
+// String returns the human-readable representation of the element key.
+// It is also used in path and expression string building. Set values
+// cannot be parsed from this representation.
+func (s PathStepElementKeyValue) String() string {
+	return "[Value(" + s.Value.String() + ")]"
+}
+
+// unexported satisfies the PathStep interface.
+func (s PathStepElementKeyValue) unexported() {}
@@ -0,0 +1,229 @@
+package path_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPathAtName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     path.Path
+		name     string
+		expected path.Path
+	}{
+		"empty": {
+			path:     path.Empty(),
+			name:     "test",
+			expected: path.Root("test"),
+		},
+		"root": {
+			path:     path.Root("test"),
+			name:     "nested",
+			expected: path.Root("test").AtName("nested"),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.path.AtName(tc.name)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPathAtName_copy(t *testing.T) {
+	t.Parallel()
+
+	original := path.Root("test")
+	first := original.AtName("first")
+	second := original.AtName("second")
+
+	if !original.Equal(path.Root("test")) {
+		t.Errorf("original path was modified: %s", original)
+	}
+
+	if got, expected := first.String(), "test.first"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	if got, expected := second.String(), "test.second"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestPathEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     path.Path
+		other    path.Path
+		expected bool
+	}{
+		"empty-zero": {
+			path:     path.Empty(),
+			other:    path.Path{},
+			expected: true,
+		},
+		"root-equal": {
+			path:     path.Root("test"),
+			other:    path.Root("test"),
+			expected: true,
+		},
+		"root-different": {
+			path:     path.Root("test"),
+			other:    path.Root("other"),
+			expected: false,
+		},
+		"different-length": {
+			path:     path.Root("test"),
+			other:    path.Root("test").AtListIndex(0),
+			expected: false,
+		},
+		"different-step-type": {
+			path:     path.Root("test").AtListIndex(0),
+			other:    path.Root("test").AtMapKey("0"),
+			expected: false,
+		},
+		"set-value-equal": {
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+			other:    path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+			expected: true,
+		},
+		"set-value-different": {
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+			other:    path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "other")),
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.path.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestPathParentPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     path.Path
+		expected path.Path
+	}{
+		"empty": {
+			path:     path.Empty(),
+			expected: path.Empty(),
+		},
+		"root": {
+			path:     path.Root("test"),
+			expected: path.Empty(),
+		},
+		"nested": {
+			path:     path.Root("test").AtListIndex(0).AtName("nested"),
+			expected: path.Root("test").AtListIndex(0),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.path.ParentPath()
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPathString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     path.Path
+		expected string
+	}{
+		"empty": {
+			path:     path.Empty(),
+			expected: "",
+		},
+		"root": {
+			path:     path.Root("test"),
+			expected: "test",
+		},
+		"list-index": {
+			path:     path.Root("test").AtListIndex(0).AtName("nested"),
+			expected: "test[0].nested",
+		},
+		"map-key": {
+			path:     path.Root("test").AtMapKey("key.with \"quotes\"").AtName("nested"),
+			expected: `test["key.with \"quotes\""].nested`,
+		},
+		"set-value": {
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+			expected: `test[Value(tftypes.String<"value">)]`,
+		},
+		"tuple-index": {
+			path:     path.Root("test").AtTupleIndex(1),
+			expected: "test[1]",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.path.String()
+
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestPathsAppend(t *testing.T) {
+	t.Parallel()
+
+	var paths path.Paths
+
+	paths.Append(path.Root("one"), path.Root("two"), path.Root("one"))
+
+	expected := path.Paths{
+		path.Root("one"),
+		path.Root("two"),
+	}
+
+	if diff := cmp.Diff(paths, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if got, expected := paths.String(), "[one,two]"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
@@ -5,9 +5,41 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/emptyprovider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
+func TestNewProtocol5(t *testing.T) {
+	provider := &emptyprovider.Provider{}
+
+	providerServerFunc := NewProtocol5(provider)
+	providerServer := providerServerFunc()
+
+	// Simple verification
+	_, err := providerServer.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+}
+
+func TestNewProtocol5WithError(t *testing.T) {
+	provider := &emptyprovider.Provider{}
+
+	providerServer, err := NewProtocol5WithError(provider)()
+
+	if err != nil {
+		t.Fatalf("unexpected error creating ProviderServer: %s", err)
+	}
+
+	// Simple verification
+	_, err = providerServer.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+}
+
 func TestNewProtocol6(t *testing.T) {
 	provider := &emptyprovider.Provider{}
 
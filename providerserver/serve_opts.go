@@ -20,6 +20,17 @@ type ServeOpts struct {
 	// needed for Terraform CLI to connect to the provider is output to stdout.
 	// os.Interrupt (Ctrl-c) can be used to stop the provider.
 	Debug bool
+
+	// ProtocolVersion is the protocol version that should be used when
+	// serving the provider. Either protocol version 5 or protocol version 6
+	// can be used. Defaults to protocol version 6.
+	//
+	// Protocol version 5 has the following functionality limitations, which
+	// will raise an error during the GetProviderSchema or other RPCs:
+	//
+	//    - tfsdk.Attribute cannot use Attributes field (nested attributes).
+	//
+	ProtocolVersion int
 }
 
 // Validate a given provider address. This is only used for the Address field
@@ -50,6 +61,7 @@ func (opts ServeOpts) validateAddress(_ context.Context) error {
 //
 //    - If Address is not set
 //    - Address is a valid full provider address
+//    - ProtocolVersion, if set, is 5 or 6
 func (opts ServeOpts) validate(ctx context.Context) error {
 	if opts.Address == "" {
 		return fmt.Errorf("Address must be provided")
@@ -61,5 +73,12 @@ func (opts ServeOpts) validate(ctx context.Context) error {
 		return fmt.Errorf("unable to validate Address: %w", err)
 	}
 
+	switch opts.ProtocolVersion {
+	// 0 represents unset, which Serve will use default.
+	case 0, 5, 6:
+	default:
+		return fmt.Errorf("ProtocolVersion, if set, must be 5 or 6")
+	}
+
 	return nil
 }
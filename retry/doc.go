@@ -0,0 +1,21 @@
+// Package retry contains helpers for waiting on eventually consistent remote
+// systems, such as waiting for a newly created remote object to become
+// available before returning from the resource Create method.
+//
+// StateChangeConf repeatedly calls a StateRefreshFunc until the returned
+// state is one of the Target states:
+//
+//	stateConf := &retry.StateChangeConf{
+//		Pending: []string{"creating"},
+//		Target:  []string{"available"},
+//		Refresh: func(ctx context.Context) (interface{}, string, diag.Diagnostics) {
+//			// Fetch the remote object and return it with its status.
+//		},
+//	}
+//
+//	result, diags := stateConf.WaitForState(ctx)
+//
+// Waiting stops when the given context is done. The context of the resource
+// operation methods is canceled when Terraform stops the provider and has a
+// deadline of the resource timeout, if any, so it should be passed as-is.
+package retry
@@ -0,0 +1,294 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+const (
+	// DefaultNotFoundChecks is the number of consecutive refreshes without
+	// a result which are allowed by StateChangeConf when NotFoundChecks is
+	// zero.
+	DefaultNotFoundChecks = 20
+
+	// initialBackoffWait is the first wait between refreshes with
+	// exponential backoff, when PollInterval is zero.
+	initialBackoffWait = 100 * time.Millisecond
+
+	// maxBackoffWait is the longest wait between refreshes with exponential
+	// backoff, when PollInterval is zero.
+	maxBackoffWait = 10 * time.Second
+)
+
+// StateRefreshFunc returns the current remote object and its state, such as
+// a status string returned by the API.
+//
+// A nil result indicates the remote object was not found. If StateChangeConf
+// has no Target states, a nil result completes the wait, which is useful for
+// waiting on deletion. Otherwise, refreshing continues for up to
+// NotFoundChecks times.
+//
+// Returning an error diagnostic stops waiting.
+type StateRefreshFunc func(ctx context.Context) (result interface{}, state string, diags diag.Diagnostics)
+
+// StateChangeConf is the configuration for waiting until a remote object
+// reaches one of the Target states.
+type StateChangeConf struct {
+	// Pending are the states which are expected before reaching a Target
+	// state. If set, any state which is neither Pending nor Target stops
+	// waiting with an error diagnostic.
+	Pending []string
+
+	// Target are the states which complete the wait.
+	Target []string
+
+	// Refresh returns the current remote object and its state. It is
+	// required.
+	Refresh StateRefreshFunc
+
+	// Delay is the wait before the first refresh.
+	Delay time.Duration
+
+	// Timeout is the maximum duration of waiting. If zero, only the deadline
+	// of the context given to WaitForState, such as the resource timeout,
+	// applies.
+	Timeout time.Duration
+
+	// PollInterval is a fixed wait between refreshes. If zero, the wait
+	// starts at 100 milliseconds and doubles after each refresh, up to 10
+	// seconds or MinTimeout, whichever is longer.
+	PollInterval time.Duration
+
+	// MinTimeout is the smallest wait between refreshes with exponential
+	// backoff. It is ignored if PollInterval is set.
+	MinTimeout time.Duration
+
+	// NotFoundChecks is the number of consecutive refreshes without a result
+	// which are allowed before stopping with an error diagnostic. Defaults
+	// to DefaultNotFoundChecks.
+	NotFoundChecks int
+
+	// ContinuousTargetOccurence is the number of consecutive refreshes which
+	// must return a Target state to complete the wait. Defaults to 1.
+	ContinuousTargetOccurence int
+}
+
+// WaitForState calls Refresh until it returns one of the Target states, then
+// returns the last result. Waiting stops with an error diagnostic when an
+// unexpected state is returned, the remote object is not found, the Timeout
+// elapses, or the given context is done, such as when Terraform stops the
+// provider. The last result, if any, is returned along with the diagnostics.
+func (c *StateChangeConf) WaitForState(ctx context.Context) (interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if c.Refresh == nil {
+		diags.AddError(
+			"Invalid Wait Configuration",
+			"An unexpected error was encountered while waiting for the target state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"StateChangeConf is missing the Refresh function.",
+		)
+
+		return nil, diags
+	}
+
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	notFoundChecks := c.NotFoundChecks
+
+	if notFoundChecks <= 0 {
+		notFoundChecks = DefaultNotFoundChecks
+	}
+
+	continuousTargetOccurence := c.ContinuousTargetOccurence
+
+	if continuousTargetOccurence <= 0 {
+		continuousTargetOccurence = 1
+	}
+
+	logging.FrameworkDebug(
+		ctx,
+		"Waiting for target state",
+		map[string]interface{}{
+			logging.KeyPendingStates: strings.Join(c.Pending, ","),
+			logging.KeyTargetStates:  strings.Join(c.Target, ","),
+		},
+	)
+
+	var result interface{}
+	var state string
+	var notFoundCount, targetOccurence int
+
+	wait := initialBackoffWait
+
+	if !sleepContext(ctx, c.Delay) {
+		diags.Append(waitContextDiag(ctx, c.Target, state))
+
+		return result, diags
+	}
+
+	for {
+		var refreshDiags diag.Diagnostics
+
+		result, state, refreshDiags = c.Refresh(ctx)
+
+		diags.Append(refreshDiags...)
+
+		if ctx.Err() != nil {
+			diags.Append(waitContextDiag(ctx, c.Target, state))
+
+			return result, diags
+		}
+
+		if diags.HasError() {
+			return result, diags
+		}
+
+		logging.FrameworkTrace(ctx, "Refreshed state", map[string]interface{}{logging.KeyState: state})
+
+		switch {
+		case result == nil && len(c.Target) == 0:
+			targetOccurence++
+
+			if targetOccurence >= continuousTargetOccurence {
+				logging.FrameworkDebug(ctx, "Remote object not found, which is the target")
+
+				return result, diags
+			}
+		case result == nil:
+			targetOccurence = 0
+			notFoundCount++
+
+			if notFoundCount > notFoundChecks {
+				diags.AddError(
+					"Remote Object Not Found",
+					fmt.Sprintf("The remote object was not found after %d checks while waiting for the state to become %s.", notFoundCount, quotedStates(c.Target)),
+				)
+
+				return result, diags
+			}
+		case stateIn(state, c.Target):
+			notFoundCount = 0
+			targetOccurence++
+
+			if targetOccurence >= continuousTargetOccurence {
+				logging.FrameworkDebug(ctx, "Reached target state", map[string]interface{}{logging.KeyState: state})
+
+				return result, diags
+			}
+		case stateIn(state, c.Pending) || len(c.Pending) == 0:
+			notFoundCount = 0
+			targetOccurence = 0
+		default:
+			diags.AddError(
+				"Unexpected State",
+				fmt.Sprintf("The remote object returned the unexpected state %q while waiting for the state to become %s.", state, quotedStates(c.Target)),
+			)
+
+			return result, diags
+		}
+
+		wait = c.nextWait(wait)
+
+		logging.FrameworkTrace(ctx, "Waiting before next refresh", map[string]interface{}{logging.KeyWait: wait.String()})
+
+		if !sleepContext(ctx, wait) {
+			diags.Append(waitContextDiag(ctx, c.Target, state))
+
+			return result, diags
+		}
+
+		if c.PollInterval <= 0 {
+			wait *= 2
+		}
+	}
+}
+
+// nextWait returns the wait before the next refresh, given the exponential
+// backoff wait. The backoff is capped at 10 seconds, or MinTimeout if it is
+// longer, and never below MinTimeout.
+func (c *StateChangeConf) nextWait(backoff time.Duration) time.Duration {
+	if c.PollInterval > 0 {
+		return c.PollInterval
+	}
+
+	maxWait := maxBackoffWait
+
+	if c.MinTimeout > maxWait {
+		maxWait = c.MinTimeout
+	}
+
+	if backoff > maxWait {
+		backoff = maxWait
+	}
+
+	if backoff < c.MinTimeout {
+		backoff = c.MinTimeout
+	}
+
+	return backoff
+}
+
+// sleepContext waits for the given duration, returning false if the context
+// is done first.
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// waitContextDiag returns the error diagnostic for a done context while
+// waiting for the target states.
+func waitContextDiag(ctx context.Context, target []string, lastState string) diag.Diagnostic {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return diag.NewErrorDiagnostic(
+			"Wait Timed Out",
+			fmt.Sprintf("The timeout elapsed while waiting for the state to become %s. The last state was %q.", quotedStates(target), lastState),
+		)
+	}
+
+	return diag.NewErrorDiagnostic(
+		"Wait Canceled",
+		fmt.Sprintf("Waiting for the state to become %s was canceled, such as by Terraform stopping the provider. The last state was %q.", quotedStates(target), lastState),
+	)
+}
+
+func quotedStates(states []string) string {
+	quoted := make([]string, 0, len(states))
+
+	for _, state := range states {
+		quoted = append(quoted, fmt.Sprintf("%q", state))
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+func stateIn(state string, states []string) bool {
+	for _, s := range states {
+		if state == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,242 @@
+package retry_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/retry"
+)
+
+// testRefreshFunc returns a StateRefreshFunc which returns the given states in
+// order, repeating the last state. An empty state returns a nil result.
+func testRefreshFunc(states []string) retry.StateRefreshFunc {
+	var i int
+
+	return func(_ context.Context) (interface{}, string, diag.Diagnostics) {
+		state := states[i]
+
+		if i < len(states)-1 {
+			i++
+		}
+
+		if state == "" {
+			return nil, state, nil
+		}
+
+		return state, state, nil
+	}
+}
+
+func TestStateChangeConfWaitForState(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		conf           retry.StateChangeConf
+		states         []string
+		expectedResult interface{}
+		expectedDiags  diag.Diagnostics
+	}{
+		"missing-refresh": {
+			conf: retry.StateChangeConf{
+				Target: []string{"available"},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Wait Configuration",
+					"An unexpected error was encountered while waiting for the target state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"StateChangeConf is missing the Refresh function.",
+				),
+			},
+		},
+		"target": {
+			conf: retry.StateChangeConf{
+				Pending:      []string{"creating"},
+				Target:       []string{"available"},
+				PollInterval: time.Millisecond,
+			},
+			states:         []string{"creating", "creating", "available"},
+			expectedResult: "available",
+		},
+		"target-no-pending": {
+			conf: retry.StateChangeConf{
+				Target:       []string{"available"},
+				PollInterval: time.Millisecond,
+			},
+			states:         []string{"creating", "modifying", "available"},
+			expectedResult: "available",
+		},
+		"target-backoff": {
+			conf: retry.StateChangeConf{
+				Pending: []string{"creating"},
+				Target:  []string{"available"},
+			},
+			states:         []string{"creating", "available"},
+			expectedResult: "available",
+		},
+		"target-continuous-occurence": {
+			conf: retry.StateChangeConf{
+				Pending:                   []string{"creating"},
+				Target:                    []string{"available"},
+				PollInterval:              time.Millisecond,
+				ContinuousTargetOccurence: 2,
+			},
+			states:         []string{"available", "creating", "available", "available"},
+			expectedResult: "available",
+		},
+		"target-not-found": {
+			conf: retry.StateChangeConf{
+				Pending:      []string{"deleting"},
+				PollInterval: time.Millisecond,
+			},
+			states:         []string{"deleting", ""},
+			expectedResult: nil,
+		},
+		"not-found": {
+			conf: retry.StateChangeConf{
+				Pending:        []string{"creating"},
+				Target:         []string{"available"},
+				PollInterval:   time.Millisecond,
+				NotFoundChecks: 2,
+			},
+			states:         []string{""},
+			expectedResult: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Remote Object Not Found",
+					`The remote object was not found after 3 checks while waiting for the state to become "available".`,
+				),
+			},
+		},
+		"not-found-eventually-consistent": {
+			conf: retry.StateChangeConf{
+				Pending:        []string{"creating"},
+				Target:         []string{"available"},
+				PollInterval:   time.Millisecond,
+				NotFoundChecks: 2,
+			},
+			states:         []string{"", "", "creating", "", "", "available"},
+			expectedResult: "available",
+		},
+		"unexpected-state": {
+			conf: retry.StateChangeConf{
+				Pending:      []string{"creating"},
+				Target:       []string{"available", "running"},
+				PollInterval: time.Millisecond,
+			},
+			states:         []string{"creating", "failed"},
+			expectedResult: "failed",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unexpected State",
+					`The remote object returned the unexpected state "failed" while waiting for the state to become "available", "running".`,
+				),
+			},
+		},
+		"timeout": {
+			conf: retry.StateChangeConf{
+				Pending:      []string{"creating"},
+				Target:       []string{"available"},
+				PollInterval: time.Millisecond,
+				Timeout:      10 * time.Millisecond,
+			},
+			states:         []string{"creating"},
+			expectedResult: "creating",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Wait Timed Out",
+					`The timeout elapsed while waiting for the state to become "available". The last state was "creating".`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conf := testCase.conf
+
+			if testCase.states != nil {
+				conf.Refresh = testRefreshFunc(testCase.states)
+			}
+
+			got, diags := conf.WaitForState(context.Background())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedResult); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateChangeConfWaitForState_Canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	conf := retry.StateChangeConf{
+		Pending: []string{"creating"},
+		Target:  []string{"available"},
+		Refresh: func(_ context.Context) (interface{}, string, diag.Diagnostics) {
+			// Simulate Terraform stopping the provider during the wait.
+			cancel()
+
+			return "creating", "creating", nil
+		},
+		PollInterval: time.Minute,
+	}
+
+	got, diags := conf.WaitForState(ctx)
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Wait Canceled",
+			`Waiting for the state to become "available" was canceled, such as by Terraform stopping the provider. The last state was "creating".`,
+		),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got, "creating"); diff != "" {
+		t.Errorf("unexpected result difference: %s", diff)
+	}
+}
+
+func TestStateChangeConfWaitForState_RefreshDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	conf := retry.StateChangeConf{
+		Pending: []string{"creating"},
+		Target:  []string{"available"},
+		Refresh: func(_ context.Context) (interface{}, string, diag.Diagnostics) {
+			return nil, "", diag.Diagnostics{
+				diag.NewErrorDiagnostic("API Error", "test error"),
+			}
+		},
+	}
+
+	got, diags := conf.WaitForState(context.Background())
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic("API Error", "test error"),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	if got != nil {
+		t.Errorf("expected nil result, got: %v", got)
+	}
+}
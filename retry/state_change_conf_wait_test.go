@@ -0,0 +1,92 @@
+package retry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStateChangeConfNextWait(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		conf     StateChangeConf
+		expected []time.Duration
+	}{
+		"backoff": {
+			conf: StateChangeConf{},
+			expected: []time.Duration{
+				100 * time.Millisecond,
+				200 * time.Millisecond,
+				400 * time.Millisecond,
+				800 * time.Millisecond,
+				1600 * time.Millisecond,
+				3200 * time.Millisecond,
+				6400 * time.Millisecond,
+				10 * time.Second,
+				10 * time.Second,
+			},
+		},
+		"backoff-min-timeout": {
+			conf: StateChangeConf{
+				MinTimeout: 2 * time.Second,
+			},
+			expected: []time.Duration{
+				2 * time.Second,
+				4 * time.Second,
+				8 * time.Second,
+				10 * time.Second,
+				10 * time.Second,
+			},
+		},
+		"backoff-min-timeout-above-max": {
+			conf: StateChangeConf{
+				MinTimeout: 15 * time.Second,
+			},
+			expected: []time.Duration{
+				15 * time.Second,
+				15 * time.Second,
+				15 * time.Second,
+				15 * time.Second,
+			},
+		},
+		"poll-interval": {
+			conf: StateChangeConf{
+				MinTimeout:   15 * time.Second,
+				PollInterval: time.Second,
+			},
+			expected: []time.Duration{
+				time.Second,
+				time.Second,
+				time.Second,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := make([]time.Duration, 0, len(testCase.expected))
+			wait := initialBackoffWait
+
+			// Mirror the WaitForState loop, which doubles the wait after
+			// each refresh when PollInterval is zero.
+			for range testCase.expected {
+				wait = testCase.conf.nextWait(wait)
+				got = append(got, wait)
+
+				if testCase.conf.PollInterval <= 0 {
+					wait *= 2
+				}
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
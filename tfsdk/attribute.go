@@ -49,7 +49,8 @@ type Attribute struct {
 	// When defining an attribute that has Optional set to true,
 	// and uses PlanModifiers to set a "default value" when none is provided,
 	// Computed must also be set to true. This is necessary because default
-	// values are, in effect, set by the provider (i.e. computed).
+	// values are, in effect, set by the provider (i.e. computed). Setting
+	// the Default field instead handles this automatically.
 	Optional bool
 
 	// Computed indicates whether the provider may return its own value for
@@ -81,6 +82,24 @@ type Attribute struct {
 	// Validators defines validation functionality for the attribute.
 	Validators []AttributeValidator
 
+	// ElementValidators defines validation functionality for each element
+	// of a list, set, or map attribute. Each validator is called once per
+	// element, with the AttributePath and AttributeConfig of the element,
+	// so any diagnostics refer to the specific element. Null and unknown
+	// collections are skipped.
+	//
+	// ElementValidators can only be set when Type is a list, set, or map
+	// type.
+	ElementValidators []AttributeValidator
+
+	// KeyValidators defines validation functionality for each key of a map
+	// attribute. Each validator is called once per element, with the
+	// AttributePath of the element and the key as a types.String
+	// AttributeConfig. Null and unknown maps are skipped.
+	//
+	// KeyValidators can only be set when Type is a map type.
+	KeyValidators []AttributeValidator
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Attribute-level plan modifications occur before any
 	// resource-level plan modifications.
@@ -96,6 +115,20 @@ type Attribute struct {
 	//
 	// When providing PlanModifiers, it's necessary to set Computed to true.
 	PlanModifiers AttributePlanModifiers
+
+	// Default defines the value of the attribute in the plan when it is
+	// not configured by the practitioner. Use StaticDefault for a fixed
+	// value or DefaultFunc to determine the value during planning.
+	//
+	// Defaults are applied before any PlanModifiers. Attributes with a
+	// Default are automatically marked as Computed in the provider schema
+	// sent to Terraform, since the value is, in effect, set by the
+	// provider. Default cannot be set on Required attributes.
+	//
+	// Defaults only apply to resources, not data sources or providers.
+	// Setting Default on a data source or provider attribute will have no
+	// effect.
+	Default AttributeDefault
 }
 
 // ApplyTerraform5AttributePathStep transparently calls
@@ -0,0 +1,250 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AttributeDefault represents the default value of an attribute, which is
+// set in the plan by the framework when the attribute is not configured.
+//
+// Defaults are applied during resource planning, before any
+// AttributePlanModifiers and the resource-level ModifyPlan method are run.
+// They only apply to resources, not data sources or providers.
+type AttributeDefault interface {
+	// Description is used in various tooling, like the language server, to
+	// give practitioners more information about what this default is,
+	// what it's for, and how it should be used. It should be written as
+	// plain text, with no special formatting.
+	//
+	// This information is automatically appended to the plain text
+	// Description of the attribute in the provider schema.
+	Description(context.Context) string
+
+	// MarkdownDescription is used in various tooling, like the
+	// documentation generator, to give practitioners more information
+	// about what this default is, what it's for, and how it should be
+	// used. It should be formatted using Markdown.
+	//
+	// This information is automatically appended to the
+	// MarkdownDescription of the attribute in the provider schema.
+	MarkdownDescription(context.Context) string
+
+	// DefaultValue is called when the attribute is null in the
+	// configuration and should set the AttributeDefaultResponse Value field
+	// to a value matching the attribute type.
+	DefaultValue(context.Context, AttributeDefaultRequest, *AttributeDefaultResponse)
+}
+
+// AttributeDefaultRequest represents a request for the default value of an
+// attribute. An instance of this request struct is supplied as an argument to
+// the DefaultValue method of an AttributeDefault.
+type AttributeDefaultRequest struct {
+	// AttributePath is the path of the attribute.
+	AttributePath path.Path
+
+	// Config is the configuration the user supplied for the resource.
+	Config Config
+
+	// ProviderMeta is metadata from the provider_meta block of the module.
+	ProviderMeta Config
+
+	// ProviderData is the ResourceData value the provider set in the
+	// ConfigureProviderResponse, such as a configured API client.
+	ProviderData interface{}
+}
+
+// AttributeDefaultResponse represents a response to an
+// AttributeDefaultRequest. An instance of this response struct is supplied as
+// an argument to the DefaultValue method of an AttributeDefault.
+type AttributeDefaultResponse struct {
+	// Value is the default value for the attribute. It must be of the same
+	// type as the attribute. Leaving this nil will not set a default value
+	// in the plan.
+	Value attr.Value
+
+	// Diagnostics report errors or warnings related to determining the
+	// default value of the attribute. Returning an empty slice indicates
+	// a successful operation with no warnings or errors generated.
+	Diagnostics diag.Diagnostics
+}
+
+// StaticDefault returns an AttributeDefault which always sets `value` as the
+// default value of the attribute. The type of `value` is verified against the
+// attribute type when the provider schema is loaded.
+func StaticDefault(value attr.Value) AttributeDefault {
+	return StaticDefaultValue{
+		Value: value,
+	}
+}
+
+// StaticDefaultValue is an AttributeDefault which always sets the same value.
+type StaticDefaultValue struct {
+	// Value is the default value for the attribute.
+	Value attr.Value
+}
+
+// DefaultValue sets the response Value to the static default value.
+func (d StaticDefaultValue) DefaultValue(_ context.Context, _ AttributeDefaultRequest, resp *AttributeDefaultResponse) {
+	resp.Value = d.Value
+}
+
+// Description returns a human-readable description of the default value.
+func (d StaticDefaultValue) Description(ctx context.Context) string {
+	return fmt.Sprintf("Defaults to %s.", defaultValueString(ctx, d.Value))
+}
+
+// MarkdownDescription returns a markdown description of the default value.
+func (d StaticDefaultValue) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Defaults to `%s`.", defaultValueString(ctx, d.Value))
+}
+
+// DefaultFunc returns an AttributeDefault which calls `f` to determine the
+// default value of the attribute. Since the returned value can only be
+// verified when `f` is called, any type mismatch with the attribute is
+// reported as an error diagnostic during planning.
+func DefaultFunc(f DefaultValueFunc, description, markdownDescription string) AttributeDefault {
+	return DefaultFuncValue{
+		f:                   f,
+		description:         description,
+		markdownDescription: markdownDescription,
+	}
+}
+
+// DefaultValueFunc is a function used in the DefaultFunc attribute default to
+// determine the default value of the attribute.
+type DefaultValueFunc func(context.Context, AttributeDefaultRequest, *AttributeDefaultResponse)
+
+// DefaultFuncValue is an AttributeDefault which calls a function to determine
+// the default value.
+type DefaultFuncValue struct {
+	f                   DefaultValueFunc
+	description         string
+	markdownDescription string
+}
+
+// DefaultValue calls the default value function.
+func (d DefaultFuncValue) DefaultValue(ctx context.Context, req AttributeDefaultRequest, resp *AttributeDefaultResponse) {
+	if d.f == nil {
+		return
+	}
+
+	d.f(ctx, req, resp)
+}
+
+// Description returns a human-readable description of the default value.
+func (d DefaultFuncValue) Description(ctx context.Context) string {
+	return d.description
+}
+
+// MarkdownDescription returns a markdown description of the default value.
+func (d DefaultFuncValue) MarkdownDescription(ctx context.Context) string {
+	return d.markdownDescription
+}
+
+// defaultValueString returns a practitioner-friendly rendering of a default
+// value, similar to how it would be written in a configuration.
+func defaultValueString(ctx context.Context, value attr.Value) string {
+	if value == nil {
+		return "null"
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		return "an invalid value"
+	}
+
+	return terraformValueString(tfValue)
+}
+
+// terraformValueString returns a practitioner-friendly rendering of a
+// tftypes.Value.
+func terraformValueString(value tftypes.Value) string {
+	if value.IsNull() {
+		return "null"
+	}
+
+	if !value.IsKnown() {
+		return "an unknown value"
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+
+		if err := value.As(&s); err != nil {
+			return "an invalid value"
+		}
+
+		return strconv.Quote(s)
+	case value.Type().Is(tftypes.Number):
+		n := big.NewFloat(0)
+
+		if err := value.As(&n); err != nil {
+			return "an invalid value"
+		}
+
+		return n.Text('f', -1)
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+
+		if err := value.As(&b); err != nil {
+			return "an invalid value"
+		}
+
+		return strconv.FormatBool(b)
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+
+		if err := value.As(&elems); err != nil {
+			return "an invalid value"
+		}
+
+		elemStrings := make([]string, 0, len(elems))
+
+		for _, elem := range elems {
+			elemStrings = append(elemStrings, terraformValueString(elem))
+		}
+
+		return "[" + strings.Join(elemStrings, ", ") + "]"
+	case value.Type().Is(tftypes.Map{}), value.Type().Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+
+		if err := value.As(&elems); err != nil {
+			return "an invalid value"
+		}
+
+		keys := make([]string, 0, len(elems))
+
+		for key := range elems {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		elemStrings := make([]string, 0, len(elems))
+
+		for _, key := range keys {
+			if value.Type().Is(tftypes.Map{}) {
+				elemStrings = append(elemStrings, strconv.Quote(key)+" = "+terraformValueString(elems[key]))
+				continue
+			}
+
+			elemStrings = append(elemStrings, key+" = "+terraformValueString(elems[key]))
+		}
+
+		return "{" + strings.Join(elemStrings, ", ") + "}"
+	default:
+		return value.String()
+	}
+}
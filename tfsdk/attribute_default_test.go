@@ -0,0 +1,107 @@
+package tfsdk
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStaticDefaultValueDescription(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		value            attr.Value
+		expected         string
+		expectedMarkdown string
+	}
+
+	tests := map[string]testCase{
+		"nil": {
+			value:            nil,
+			expected:         "Defaults to null.",
+			expectedMarkdown: "Defaults to `null`.",
+		},
+		"bool": {
+			value:            types.Bool{Value: true},
+			expected:         "Defaults to true.",
+			expectedMarkdown: "Defaults to `true`.",
+		},
+		"number": {
+			value:            types.Number{Value: big.NewFloat(1.5)},
+			expected:         "Defaults to 1.5.",
+			expectedMarkdown: "Defaults to `1.5`.",
+		},
+		"int64": {
+			value:            types.Int64{Value: 123},
+			expected:         "Defaults to 123.",
+			expectedMarkdown: "Defaults to `123`.",
+		},
+		"string": {
+			value:            types.String{Value: "test"},
+			expected:         `Defaults to "test".`,
+			expectedMarkdown: "Defaults to `\"test\"`.",
+		},
+		"string-null": {
+			value:            types.String{Null: true},
+			expected:         "Defaults to null.",
+			expectedMarkdown: "Defaults to `null`.",
+		},
+		"list": {
+			value: types.List{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "a"},
+					types.String{Value: "b"},
+				},
+			},
+			expected:         `Defaults to ["a", "b"].`,
+			expectedMarkdown: "Defaults to `[\"a\", \"b\"]`.",
+		},
+		"map": {
+			value: types.Map{
+				ElemType: types.Int64Type,
+				Elems: map[string]attr.Value{
+					"b": types.Int64{Value: 2},
+					"a": types.Int64{Value: 1},
+				},
+			},
+			expected:         `Defaults to {"a" = 1, "b" = 2}.`,
+			expectedMarkdown: "Defaults to `{\"a\" = 1, \"b\" = 2}`.",
+		},
+		"object": {
+			value: types.Object{
+				AttrTypes: map[string]attr.Type{
+					"string": types.StringType,
+					"bool":   types.BoolType,
+				},
+				Attrs: map[string]attr.Value{
+					"string": types.String{Value: "test"},
+					"bool":   types.Bool{Value: false},
+				},
+			},
+			expected:         `Defaults to {bool = false, string = "test"}.`,
+			expectedMarkdown: "Defaults to `{bool = false, string = \"test\"}`.",
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := StaticDefault(tc.value)
+
+			if got := d.Description(context.Background()); got != tc.expected {
+				t.Errorf("expected description %q, got %q", tc.expected, got)
+			}
+
+			if got := d.MarkdownDescription(context.Background()); got != tc.expectedMarkdown {
+				t.Errorf("expected markdown description %q, got %q", tc.expectedMarkdown, got)
+			}
+		})
+	}
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // AttributePlanModifier represents a modifier for an attribute at plan time.
@@ -128,7 +128,7 @@ func (r RequiresReplaceModifier) Modify(ctx context.Context, req ModifyAttribute
 		return
 	}
 
-	attrSchema, err := req.State.Schema.AttributeAtPath(req.AttributePath)
+	attrSchema, err := req.State.Schema.AttributeAtPath(ctx, req.AttributePath)
 
 	// Path may lead to block instead of attribute. Blocks cannot be Computed.
 	// If ErrPathIsBlock, attrSchema.Computed will still be false later.
@@ -206,7 +206,7 @@ func RequiresReplaceIf(f RequiresReplaceIfFunc, description, markdownDescription
 
 // RequiresReplaceIfFunc is a conditional function used in the RequiresReplaceIf
 // plan modifier to determine whether the attribute requires replacement.
-type RequiresReplaceIfFunc func(ctx context.Context, state, config attr.Value, path *tftypes.AttributePath) (bool, diag.Diagnostics)
+type RequiresReplaceIfFunc func(ctx context.Context, state, config attr.Value, path path.Path) (bool, diag.Diagnostics)
 
 // RequiresReplaceIfModifier is an AttributePlanModifier that sets RequiresReplace
 // on the attribute if the conditional function returns true.
@@ -260,7 +260,7 @@ func (r RequiresReplaceIfModifier) Modify(ctx context.Context, req ModifyAttribu
 		return
 	}
 
-	attrSchema, err := req.State.Schema.AttributeAtPath(req.AttributePath)
+	attrSchema, err := req.State.Schema.AttributeAtPath(ctx, req.AttributePath)
 
 	// Path may lead to block instead of attribute. Blocks cannot be Computed.
 	// If ErrPathIsBlock, attrSchema.Computed will still be false later.
@@ -363,7 +363,7 @@ func (r UseStateForUnknownModifier) MarkdownDescription(ctx context.Context) str
 // function of an attribute's plan modifier(s).
 type ModifyAttributePlanRequest struct {
 	// AttributePath is the path of the attribute.
-	AttributePath *tftypes.AttributePath
+	AttributePath path.Path
 
 	// Config is the configuration the user supplied for the resource.
 	Config Config
@@ -385,6 +385,10 @@ type ModifyAttributePlanRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// ProviderData is the ResourceData value the provider set in the
+	// ConfigureProviderResponse, such as a configured API client.
+	ProviderData interface{}
 }
 
 // ModifyAttributePlanResponse represents a response to a
@@ -7,6 +7,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -121,7 +122,7 @@ func TestUseStateForUnknownModifier(t *testing.T) {
 			}
 
 			req := ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath(),
+				AttributePath: path.Empty(),
 				Config: Config{
 					Schema: schema,
 					Raw: tftypes.NewValue(schema.TerraformType(context.Background()), map[string]tftypes.Value{
@@ -168,7 +169,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 		state        State
 		plan         Plan
 		config       Config
-		path         *tftypes.AttributePath
+		path         path.Path
 		expectedPlan attr.Value
 		expectedRR   bool
 	}
@@ -228,7 +229,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 					"optional":          tftypes.NewValue(tftypes.String, "bar"),
 				}),
 			},
-			path:         tftypes.NewAttributePath().WithAttributeName("optional-computed"),
+			path:         path.Root("optional-computed"),
 			expectedPlan: types.String{Value: "foo"},
 			expectedRR:   false,
 		},
@@ -254,7 +255,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 				Schema: schema,
 				Raw:    tftypes.NewValue(schema.TerraformType(context.Background()), nil),
 			},
-			path:         tftypes.NewAttributePath().WithAttributeName("optional-computed"),
+			path:         path.Root("optional-computed"),
 			expectedPlan: nil,
 			expectedRR:   false,
 		},
@@ -283,7 +284,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 					"optional":          tftypes.NewValue(tftypes.String, "bar"),
 				}),
 			},
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			expectedPlan: types.String{Value: "bar"},
 			expectedRR:   true,
 		},
@@ -312,7 +313,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 					"optional":          tftypes.NewValue(tftypes.String, nil),
 				}),
 			},
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			expectedPlan: types.String{Null: true},
 			expectedRR:   true,
 		},
@@ -340,7 +341,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 					"optional":          tftypes.NewValue(tftypes.String, "quux"),
 				}),
 			},
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			expectedPlan: types.String{Value: "quux"},
 			expectedRR:   true,
 		},
@@ -368,7 +369,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 					"optional":          tftypes.NewValue(tftypes.String, "quux"),
 				}),
 			},
-			path:         tftypes.NewAttributePath().WithAttributeName("optional-computed"),
+			path:         path.Root("optional-computed"),
 			expectedPlan: types.String{Value: "foo"},
 			expectedRR:   false,
 		},
@@ -403,7 +404,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 					"optional":          tftypes.NewValue(tftypes.String, "quux"),
 				}),
 			},
-			path:         tftypes.NewAttributePath().WithAttributeName("optional-computed"),
+			path:         path.Root("optional-computed"),
 			expectedPlan: types.String{Unknown: true},
 			expectedRR:   false,
 		},
@@ -439,7 +440,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 					"optional":          tftypes.NewValue(tftypes.String, nil),
 				}),
 			},
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			expectedPlan: types.String{Null: true},
 			expectedRR:   true,
 		},
@@ -516,7 +517,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 						}),
 				}),
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("block"),
+			path: path.Root("block"),
 			expectedPlan: types.List{
 				ElemType: types.ObjectType{
 					AttrTypes: map[string]attr.Type{
@@ -630,7 +631,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 						}),
 				}),
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("block"),
+			path: path.Root("block"),
 			expectedPlan: types.List{
 				ElemType: types.ObjectType{
 					AttrTypes: map[string]attr.Type{
@@ -736,7 +737,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 						}),
 				}),
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("block"),
+			path: path.Root("block"),
 			expectedPlan: types.List{
 				ElemType: types.ObjectType{
 					AttrTypes: map[string]attr.Type{
@@ -818,7 +819,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 		plan         Plan
 		config       Config
 		priorRR      bool
-		path         *tftypes.AttributePath
+		path         path.Path
 		ifReturn     bool
 		expectedPlan attr.Value
 		expectedRR   bool
@@ -880,7 +881,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			priorRR:      false,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional-computed"),
+			path:         path.Root("optional-computed"),
 			ifReturn:     true,
 			expectedPlan: types.String{Value: "foo"},
 			expectedRR:   false,
@@ -908,7 +909,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				Raw:    tftypes.NewValue(schema.TerraformType(context.Background()), nil),
 			},
 			priorRR:      false,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional-computed"),
+			path:         path.Root("optional-computed"),
 			ifReturn:     true,
 			expectedPlan: nil,
 			expectedRR:   false,
@@ -939,7 +940,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			priorRR:      false,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			ifReturn:     true,
 			expectedPlan: types.String{Value: "bar"},
 			expectedRR:   true,
@@ -971,7 +972,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 			},
 			priorRR:      false,
 			ifReturn:     true,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			expectedPlan: types.String{Null: true},
 			expectedRR:   true,
 		},
@@ -1001,7 +1002,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			priorRR:      false,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			ifReturn:     true,
 			expectedPlan: types.String{Value: "quux"},
 			expectedRR:   true,
@@ -1032,7 +1033,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			priorRR:      false,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			ifReturn:     false,
 			expectedPlan: types.String{Value: "quux"},
 			expectedRR:   false,
@@ -1064,7 +1065,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			priorRR:      true,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			ifReturn:     false,
 			expectedPlan: types.String{Value: "quux"},
 			expectedRR:   true,
@@ -1094,7 +1095,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			priorRR:      false,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional-computed"),
+			path:         path.Root("optional-computed"),
 			ifReturn:     true,
 			expectedPlan: types.String{Value: "foo"},
 			expectedRR:   false,
@@ -1131,7 +1132,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			priorRR:      false,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional-computed"),
+			path:         path.Root("optional-computed"),
 			ifReturn:     true,
 			expectedPlan: types.String{Unknown: true},
 			expectedRR:   false,
@@ -1169,7 +1170,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			priorRR:      false,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			ifReturn:     true,
 			expectedPlan: types.String{Null: true},
 			expectedRR:   true,
@@ -1247,7 +1248,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 						}),
 				}),
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("block"),
+			path: path.Root("block"),
 			expectedPlan: types.List{
 				ElemType: types.ObjectType{
 					AttrTypes: map[string]attr.Type{
@@ -1362,7 +1363,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 						}),
 				}),
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("block"),
+			path: path.Root("block"),
 			expectedPlan: types.List{
 				ElemType: types.ObjectType{
 					AttrTypes: map[string]attr.Type{
@@ -1469,7 +1470,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 						}),
 				}),
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("block"),
+			path: path.Root("block"),
 			expectedPlan: types.List{
 				ElemType: types.ObjectType{
 					AttrTypes: map[string]attr.Type{
@@ -1529,7 +1530,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				AttributePlan:   req.AttributePlan,
 				RequiresReplace: tc.priorRR,
 			}
-			modifier := RequiresReplaceIf(func(ctx context.Context, state, config attr.Value, path *tftypes.AttributePath) (bool, diag.Diagnostics) {
+			modifier := RequiresReplaceIf(func(ctx context.Context, state, config attr.Value, path path.Path) (bool, diag.Diagnostics) {
 				return tc.ifReturn, nil
 			}, "", "")
 
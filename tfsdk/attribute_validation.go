@@ -5,7 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // AttributeValidator describes reusable Attribute validation functionality.
@@ -29,13 +29,19 @@ type AttributeValidator interface {
 // ValidateAttributeRequest repesents a request for
 type ValidateAttributeRequest struct {
 	// AttributePath contains the path of the attribute.
-	AttributePath *tftypes.AttributePath
+	AttributePath path.Path
 
 	// AttributeConfig contains the value of the attribute in the configuration.
 	AttributeConfig attr.Value
 
 	// Config contains the entire configuration of the data source, provider, or resource.
 	Config Config
+
+	// ProviderData is the ResourceData or DataSourceData value the provider
+	// set in the ConfigureProviderResponse. Terraform validates
+	// configuration before configuring the provider, so this is usually nil,
+	// and is always nil when validating the provider configuration.
+	ProviderData interface{}
 }
 
 // ValidateAttributeResponse represents a response to a
@@ -42,11 +42,18 @@ type Block struct {
 
 	// MaxItems is the maximum number of blocks that can be present in a
 	// practitioner configuration.
+	//
+	// For BlockNestingModeSingle, MinItems and MaxItems must both be 0 or
+	// both be 1, as at most one block can be present.
 	MaxItems int64
 
 	// MinItems is the minimum number of blocks that must be present in a
 	// practitioner configuration. Setting to 1 or above effectively marks
 	// this configuration as required.
+	//
+	// For BlockNestingModeSingle, MinItems and MaxItems must both be 0 or
+	// both be 1. Setting both to 1 requires the block to be present in the
+	// configuration.
 	MinItems int64
 
 	// NestingMode indicates the block kind.
@@ -90,6 +97,14 @@ func (b Block) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep)
 		}
 
 		return nestedBlock{Block: b}, nil
+	case BlockNestingModeSingle:
+		_, ok := step.(tftypes.AttributeName)
+
+		if !ok {
+			return nil, fmt.Errorf("can't apply %T to block NestingModeSingle", step)
+		}
+
+		return nestedBlock{Block: b}.ApplyTerraform5AttributePathStep(step)
 	default:
 		return nil, fmt.Errorf("unsupported block nesting mode: %v", b.NestingMode)
 	}
@@ -134,7 +149,7 @@ func (b Block) attributeType() attr.Type {
 		attrType.AttrTypes[attrName] = attr.attributeType()
 	}
 
-	for blockName, block := range b.Attributes {
+	for blockName, block := range b.Blocks {
 		attrType.AttrTypes[blockName] = block.attributeType()
 	}
 
@@ -147,6 +162,8 @@ func (b Block) attributeType() attr.Type {
 		return types.SetType{
 			ElemType: attrType,
 		}
+	case BlockNestingModeSingle:
+		return attrType
 	default:
 		panic(fmt.Sprintf("unsupported block nesting mode: %v", b.NestingMode))
 	}
@@ -1,11 +1,11 @@
 package tfsdk
 
 // BlockNestingMode is an enum type of the ways attributes and blocks can be
-// nested in a block. They can be a list or a set.
+// nested in a block. They can be a list, a set, or a single object.
 //
-// While the protocol and theoretically Terraform itself support map, single,
-// and group nesting modes, this framework intentionally only supports list
-// and set blocks as those other modes were not typically implemented or
+// While the protocol and theoretically Terraform itself support map and
+// group nesting modes, this framework intentionally only supports list, set,
+// and single blocks as those other modes were not typically implemented or
 // tested since the older Terraform Plugin SDK did not support them.
 type BlockNestingMode uint8
 
@@ -23,4 +23,12 @@ const (
 	// with multiple, unique instances of those attributes nested inside a
 	// set under another attribute.
 	BlockNestingModeSet BlockNestingMode = 2
+
+	// BlockNestingModeSingle is for attributes that represent a single
+	// object, with at most one instance of those attributes nested
+	// directly under another attribute. This is commonly used when
+	// migrating blocks which were previously implemented as a list with a
+	// maximum of one element, so that the object is accessed directly
+	// rather than by indexing into a list.
+	BlockNestingModeSingle BlockNestingMode = 3
 )
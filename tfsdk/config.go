@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -25,7 +27,7 @@ func (c Config) Get(ctx context.Context, target interface{}) diag.Diagnostics {
 
 // GetAttribute retrieves the attribute found at `path` and populates the
 // `target` with the value.
-func (c Config) GetAttribute(ctx context.Context, path *tftypes.AttributePath, target interface{}) diag.Diagnostics {
+func (c Config) GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics {
 	ctx = logging.FrameworkWithAttributePath(ctx, path.String())
 
 	attrValue, diags := c.getAttributeValue(ctx, path)
@@ -56,13 +58,22 @@ func (c Config) GetAttribute(ctx context.Context, path *tftypes.AttributePath, t
 	return diags
 }
 
+// PathMatches returns all matching path.Paths from the given path.Expression.
+//
+// If a parent path is null or unknown, which would prevent a full expression
+// from matching, the parent path is returned rather than no match to prevent
+// false positives.
+func (c Config) PathMatches(ctx context.Context, pathExpr path.Expression) (path.Paths, diag.Diagnostics) {
+	return schemaDataPathMatches(ctx, c.Schema, c.Raw, pathExpr)
+}
+
 // getAttributeValue retrieves the attribute found at `path` and returns it as an
 // attr.Value. Consumers should assert the type of the returned value with the
 // desired attr.Type.
-func (c Config) getAttributeValue(ctx context.Context, path *tftypes.AttributePath) (attr.Value, diag.Diagnostics) {
+func (c Config) getAttributeValue(ctx context.Context, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	attrType, err := c.Schema.AttributeTypeAtPath(path)
+	attrType, err := c.Schema.AttributeTypeAtPath(ctx, path)
 	if err != nil {
 		diags.AddAttributeError(
 			path,
@@ -78,7 +89,7 @@ func (c Config) getAttributeValue(ctx context.Context, path *tftypes.AttributePa
 		return nil, nil
 	}
 
-	tfValue, err := c.terraformValueAtPath(path)
+	tfValue, err := c.terraformValueAtPath(ctx, path)
 
 	// Ignoring ErrInvalidStep will allow this method to return a null value of the type.
 	if err != nil && !errors.Is(err, tftypes.ErrInvalidStep) {
@@ -119,8 +130,14 @@ func (c Config) getAttributeValue(ctx context.Context, path *tftypes.AttributePa
 	return attrValue, diags
 }
 
-func (c Config) terraformValueAtPath(path *tftypes.AttributePath) (tftypes.Value, error) {
-	rawValue, remaining, err := tftypes.WalkAttributePath(c.Raw, path)
+func (c Config) terraformValueAtPath(ctx context.Context, path path.Path) (tftypes.Value, error) {
+	tftypesPath, err := totftypes.AttributePath(ctx, path)
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	rawValue, remaining, err := tftypes.WalkAttributePath(c.Raw, tftypesPath)
 	if err != nil {
 		return tftypes.Value{}, fmt.Errorf("%v still remains in the path: %w", remaining, err)
 	}
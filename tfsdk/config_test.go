@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -108,7 +109,7 @@ func TestConfigGet_testTypes(t *testing.T) {
 			expected: testConfigGetData{
 				Name: testtypes.String{String: types.String{Value: ""}, CreatedBy: testtypes.StringTypeWithValidateError{}},
 			},
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			config: Config{
@@ -131,7 +132,7 @@ func TestConfigGet_testTypes(t *testing.T) {
 			expected: testConfigGetData{
 				Name: testtypes.String{String: types.String{Value: "namevalue"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
 			},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
 
@@ -251,7 +252,7 @@ func TestConfigGetAttribute(t *testing.T) {
 			expected: new(testtypes.String),
 			expectedDiags: diag.Diagnostics{
 				diag.WithPath(
-					tftypes.NewAttributePath().WithAttributeName("name"),
+					path.Root("name"),
 					intreflect.DiagNewAttributeValueIntoWrongType{
 						ValType:    reflect.TypeOf(types.String{Value: "namevalue"}),
 						TargetType: reflect.TypeOf(testtypes.String{}),
@@ -282,7 +283,7 @@ func TestConfigGetAttribute(t *testing.T) {
 			expected: new(bool),
 			expectedDiags: diag.Diagnostics{
 				diag.WithPath(
-					tftypes.NewAttributePath().WithAttributeName("name"),
+					path.Root("name"),
 					intreflect.DiagIntoIncompatibleType{
 						Val:        tftypes.NewValue(tftypes.String, "namevalue"),
 						TargetType: reflect.TypeOf(false),
@@ -311,7 +312,7 @@ func TestConfigGetAttribute(t *testing.T) {
 			},
 			target:        new(testtypes.String),
 			expected:      new(testtypes.String),
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			config: Config{
@@ -333,7 +334,7 @@ func TestConfigGetAttribute(t *testing.T) {
 			},
 			target:        new(testtypes.String),
 			expected:      &testtypes.String{String: types.String{Value: "namevalue"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
 
@@ -342,7 +343,7 @@ func TestConfigGetAttribute(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			diags := tc.config.GetAttribute(context.Background(), tftypes.NewAttributePath().WithAttributeName("name"), tc.target)
+			diags := tc.config.GetAttribute(context.Background(), path.Root("name"), tc.target)
 
 			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
 				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
@@ -360,7 +361,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 
 	type testCase struct {
 		config        Config
-		path          *tftypes.AttributePath
+		path          path.Path
 		expected      attr.Value
 		expectedDiags diag.Diagnostics
 	}
@@ -387,7 +388,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: nil,
 		},
 		"WithAttributeName-nonexistent": {
@@ -408,11 +409,11 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("other"),
+			path:     path.Root("other"),
 			expected: nil,
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("other"),
+					path.Root("other"),
 					"Configuration Read Error",
 					"An unexpected error was encountered trying to read an attribute from the configuration. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"AttributeName(\"other\") still remains in the path: could not find attribute or block \"other\" in schema",
@@ -449,7 +450,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-List-WithElementKeyInt": {
@@ -485,7 +486,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
@@ -529,7 +530,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName-Object": {
@@ -586,7 +587,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path: path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.Object{
 				Null:      true,
 				AttrTypes: map[string]attr.Type{"value": types.StringType},
@@ -641,7 +642,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-ListNestedBlocks-null-WithElementKeyInt-WithAttributeName": {
@@ -710,7 +711,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-ListNestedBlocks-WithElementKeyInt-WithAttributeName": {
@@ -787,7 +788,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Map-null-WithElementKeyString": {
@@ -820,7 +821,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:     path.Root("test").AtMapKey("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-Map-WithElementKeyString": {
@@ -856,7 +857,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:     path.Root("test").AtMapKey("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Map-WithElementKeyString-nonexistent": {
@@ -891,7 +892,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("other"),
+			path:     path.Root("test").AtMapKey("other"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-MapNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
@@ -935,7 +936,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtMapKey("element").AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-MapNestedAttributes-WithElementKeyString-WithAttributeName": {
@@ -987,7 +988,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtMapKey("element").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Object-WithAttributeName": {
@@ -1028,7 +1029,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Set-null-WithElementKeyValue": {
@@ -1061,7 +1062,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-Set-WithElementKeyValue": {
@@ -1097,7 +1098,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SetNestedAttributes-null-WithElementKeyValue-WithAttributeName": {
@@ -1141,13 +1142,13 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SetNestedAttributes-WithElementKeyValue-WithAttributeName": {
@@ -1199,13 +1200,13 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SetNestedBlocks-null-WithElementKeyValue-WithAttributeName": {
@@ -1274,13 +1275,13 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SetNestedBlocks-WithElementKeyValue-WithAttributeName": {
@@ -1357,13 +1358,13 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-Float64": {
@@ -1403,7 +1404,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.Float64{Null: true},
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-Int64": {
@@ -1443,7 +1444,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.Int64{Null: true},
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-Set": {
@@ -1489,7 +1490,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.Set{ElemType: types.StringType, Null: true},
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-String": {
@@ -1529,7 +1530,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SingleNestedAttributes-WithAttributeName": {
@@ -1571,7 +1572,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-String-null": {
@@ -1598,7 +1599,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-String-unknown": {
@@ -1625,7 +1626,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Unknown: true},
 		},
 		"WithAttributeName-String-value": {
@@ -1652,7 +1653,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Value: "value"},
 		},
 		"AttrTypeWithValidateError": {
@@ -1679,9 +1680,9 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:          tftypes.NewAttributePath().WithAttributeName("test"),
+			path:          path.Root("test"),
 			expected:      nil,
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("test"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			config: Config{
@@ -1707,9 +1708,9 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:          tftypes.NewAttributePath().WithAttributeName("test"),
+			path:          path.Root("test"),
 			expected:      testtypes.String{String: types.String{Value: "value"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("test"))},
 		},
 	}
 
@@ -66,6 +66,8 @@ type NestedAttributes interface {
 	AttributeType() attr.Type
 	GetNestingMode() NestingMode
 	GetAttributes() map[string]Attribute
+	GetMinItems() int64
+	GetMaxItems() int64
 	Equal(NestedAttributes) bool
 	unimplementable()
 }
@@ -123,6 +125,18 @@ func (s singleNestedAttributes) GetNestingMode() NestingMode {
 	return NestingModeSingle
 }
 
+// GetMinItems always returns 0, as SingleNestedAttributes do not support
+// element count constraints.
+func (s singleNestedAttributes) GetMinItems() int64 {
+	return 0
+}
+
+// GetMaxItems always returns 0, as SingleNestedAttributes do not support
+// element count constraints.
+func (s singleNestedAttributes) GetMaxItems() int64 {
+	return 0
+}
+
 func (s singleNestedAttributes) Equal(o NestedAttributes) bool {
 	other, ok := o.(singleNestedAttributes)
 	if !ok {
@@ -145,21 +159,56 @@ func (s singleNestedAttributes) Equal(o NestedAttributes) bool {
 
 // ListNestedAttributes nests `attributes` under another attribute, allowing
 // multiple instances of that group of attributes to appear in the
-// configuration.
-func ListNestedAttributes(attributes map[string]Attribute) NestedAttributes {
-	return listNestedAttributes{
+// configuration. The optional ListNestedAttributesOptions can constrain the
+// number of elements; only the first is used.
+func ListNestedAttributes(attributes map[string]Attribute, opts ...ListNestedAttributesOptions) NestedAttributes {
+	n := listNestedAttributes{
 		nestedAttributes: nestedAttributes(attributes),
 	}
+
+	if len(opts) > 0 {
+		n.minItems = opts[0].MinItems
+		n.maxItems = opts[0].MaxItems
+	}
+
+	return n
+}
+
+// ListNestedAttributesOptions defines the optional settings of
+// ListNestedAttributes.
+type ListNestedAttributesOptions struct {
+	// MinItems is the minimum number of elements that must be present in
+	// the list when it is configured. If 0, there is no minimum.
+	MinItems int64
+
+	// MaxItems is the maximum number of elements that can be present in
+	// the list when it is configured. If 0, there is no maximum.
+	MaxItems int64
 }
 
 type listNestedAttributes struct {
 	nestedAttributes
+
+	minItems int64
+	maxItems int64
 }
 
 func (l listNestedAttributes) GetNestingMode() NestingMode {
 	return NestingModeList
 }
 
+// GetMinItems returns the minimum number of elements configured with
+// ListNestedAttributesOptions.
+func (l listNestedAttributes) GetMinItems() int64 {
+	return l.minItems
+}
+
+// GetMaxItems returns the maximum number of elements configured with
+// ListNestedAttributesOptions.
+func (l listNestedAttributes) GetMaxItems() int64 {
+	return l.maxItems
+}
+
 // AttributeType returns an attr.Type corresponding to the nested attributes.
 func (l listNestedAttributes) AttributeType() attr.Type {
 	return types.ListType{
@@ -180,6 +229,9 @@ func (l listNestedAttributes) Equal(o NestedAttributes) bool {
 	if !ok {
 		return false
 	}
+	if other.minItems != l.minItems || other.maxItems != l.maxItems {
+		return false
+	}
 	if len(other.nestedAttributes) != len(l.nestedAttributes) {
 		return false
 	}
@@ -197,21 +249,57 @@ func (l listNestedAttributes) Equal(o NestedAttributes) bool {
 
 // SetNestedAttributes nests `attributes` under another attribute, allowing
 // multiple instances of that group of attributes to appear in the
-// configuration, while requiring each group of values be unique.
-func SetNestedAttributes(attributes map[string]Attribute) NestedAttributes {
-	return setNestedAttributes{
+// configuration, while requiring each group of values be unique. The
+// optional SetNestedAttributesOptions can constrain the number of elements;
+// only the first is used.
+func SetNestedAttributes(attributes map[string]Attribute, opts ...SetNestedAttributesOptions) NestedAttributes {
+	n := setNestedAttributes{
 		nestedAttributes: nestedAttributes(attributes),
 	}
+
+	if len(opts) > 0 {
+		n.minItems = opts[0].MinItems
+		n.maxItems = opts[0].MaxItems
+	}
+
+	return n
+}
+
+// SetNestedAttributesOptions defines the optional settings of
+// SetNestedAttributes.
+type SetNestedAttributesOptions struct {
+	// MinItems is the minimum number of elements that must be present in
+	// the set when it is configured. If 0, there is no minimum.
+	MinItems int64
+
+	// MaxItems is the maximum number of elements that can be present in
+	// the set when it is configured. If 0, there is no maximum.
+	MaxItems int64
 }
 
 type setNestedAttributes struct {
 	nestedAttributes
+
+	minItems int64
+	maxItems int64
 }
 
 func (s setNestedAttributes) GetNestingMode() NestingMode {
 	return NestingModeSet
 }
 
+// GetMinItems returns the minimum number of elements configured with
+// SetNestedAttributesOptions.
+func (s setNestedAttributes) GetMinItems() int64 {
+	return s.minItems
+}
+
+// GetMaxItems returns the maximum number of elements configured with
+// SetNestedAttributesOptions.
+func (s setNestedAttributes) GetMaxItems() int64 {
+	return s.maxItems
+}
+
 // AttributeType returns an attr.Type corresponding to the nested attributes.
 func (s setNestedAttributes) AttributeType() attr.Type {
 	return types.SetType{
@@ -232,6 +320,9 @@ func (s setNestedAttributes) Equal(o NestedAttributes) bool {
 	if !ok {
 		return false
 	}
+	if other.minItems != s.minItems || other.maxItems != s.maxItems {
+		return false
+	}
 	if len(other.nestedAttributes) != len(s.nestedAttributes) {
 		return false
 	}
@@ -250,21 +341,56 @@ func (s setNestedAttributes) Equal(o NestedAttributes) bool {
 // MapNestedAttributes nests `attributes` under another attribute, allowing
 // multiple instances of that group of attributes to appear in the
 // configuration. Each group will need to be associated with a unique string by
-// the user.
-func MapNestedAttributes(attributes map[string]Attribute) NestedAttributes {
-	return mapNestedAttributes{
+// the user. The optional MapNestedAttributesOptions can constrain the number
+// of elements; only the first is used.
+func MapNestedAttributes(attributes map[string]Attribute, opts ...MapNestedAttributesOptions) NestedAttributes {
+	n := mapNestedAttributes{
 		nestedAttributes: nestedAttributes(attributes),
 	}
+
+	if len(opts) > 0 {
+		n.minItems = opts[0].MinItems
+		n.maxItems = opts[0].MaxItems
+	}
+
+	return n
+}
+
+// MapNestedAttributesOptions defines the optional settings of
+// MapNestedAttributes.
+type MapNestedAttributesOptions struct {
+	// MinItems is the minimum number of elements that must be present in
+	// the map when it is configured. If 0, there is no minimum.
+	MinItems int64
+
+	// MaxItems is the maximum number of elements that can be present in
+	// the map when it is configured. If 0, there is no maximum.
+	MaxItems int64
 }
 
 type mapNestedAttributes struct {
 	nestedAttributes
+
+	minItems int64
+	maxItems int64
 }
 
 func (m mapNestedAttributes) GetNestingMode() NestingMode {
 	return NestingModeMap
 }
 
+// GetMinItems returns the minimum number of elements configured with
+// MapNestedAttributesOptions.
+func (m mapNestedAttributes) GetMinItems() int64 {
+	return m.minItems
+}
+
+// GetMaxItems returns the maximum number of elements configured with
+// MapNestedAttributesOptions.
+func (m mapNestedAttributes) GetMaxItems() int64 {
+	return m.maxItems
+}
+
 // AttributeType returns an attr.Type corresponding to the nested attributes.
 func (m mapNestedAttributes) AttributeType() attr.Type {
 	return types.MapType{
@@ -285,6 +411,9 @@ func (m mapNestedAttributes) Equal(o NestedAttributes) bool {
 	if !ok {
 		return false
 	}
+	if other.minItems != m.minItems || other.maxItems != m.maxItems {
+		return false
+	}
 	if len(other.nestedAttributes) != len(m.nestedAttributes) {
 		return false
 	}
@@ -0,0 +1,153 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// schemaDataPathMatches returns all paths in `data` matching the given
+// path.Expression, which must be valid for `schema`. If a parent path is
+// null or unknown, which would prevent the full expression from matching,
+// the parent path is returned rather than no match to prevent false
+// positives.
+func schemaDataPathMatches(ctx context.Context, schema Schema, data tftypes.Value, pathExpr path.Expression) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var paths path.Paths
+
+	pathExpr = pathExpr.Resolve()
+
+	if !schemaValidPathExpression(ctx, schema, pathExpr) {
+		diags.AddError(
+			"Invalid Path Expression for Schema",
+			"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+				"This can happen if the path expression does not correctly follow the schema in structure or types, "+
+				"such as using a block path expression for an attribute or when using an attribute path expression for a block. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Path Expression: %s", pathExpr),
+		)
+
+		return paths, diags
+	}
+
+	err := tftypes.Walk(data, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (bool, error) {
+		p, err := fromtftypes.AttributePath(ctx, tfTypePath)
+
+		if err != nil {
+			return false, err
+		}
+
+		if pathExpr.Matches(p) {
+			paths.Append(p)
+
+			return false, nil
+		}
+
+		if !pathExpr.MatchesParent(p) {
+			return false, nil
+		}
+
+		if len(p.Steps()) > 0 && (tfTypeValue.IsNull() || !tfTypeValue.IsKnown()) {
+			paths.Append(p)
+
+			return false, nil
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Path Matching Error",
+			"An unexpected error was encountered trying to match a path expression. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return paths, diags
+	}
+
+	if len(paths) == 0 {
+		diags.AddWarning(
+			"Invalid Path Expression for Schema Data",
+			"The Terraform Provider unexpectedly matched no paths with the given path expression and current schema data. "+
+				"This can happen if the path expression does not correctly follow the schema in structure or types, "+
+				"such as using a block path expression for an attribute or when using an attribute path expression for a block. "+
+				"This is always an issue in the provider and should be reported to the provider developer.\n\n"+
+				fmt.Sprintf("Path Expression: %s", pathExpr),
+		)
+	}
+
+	return paths, diags
+}
+
+// schemaValidPathExpression returns true if the given root path.Expression
+// can match attributes or blocks in `schema`. Steps inside attributes whose
+// type is tftypes.DynamicPseudoType are always considered valid, since their
+// structure is only known at runtime.
+func schemaValidPathExpression(ctx context.Context, schema Schema, pathExpr path.Expression) bool {
+	if !pathExpr.IsRoot() {
+		return false
+	}
+
+	return typeValidExpressionSteps(ctx, schema.AttributeType(), pathExpr.Steps())
+}
+
+func typeValidExpressionSteps(ctx context.Context, typ attr.Type, steps path.ExpressionSteps) bool {
+	if len(steps) == 0 {
+		return true
+	}
+
+	if typ.TerraformType(ctx).Is(tftypes.DynamicPseudoType) {
+		return true
+	}
+
+	step, remainingSteps := steps.NextStep()
+
+	var tfTypeStep tftypes.AttributePathStep
+
+	switch step := step.(type) {
+	case path.ExpressionStepAttributeNameExact:
+		tfTypeStep = tftypes.AttributeName(string(step))
+	case path.ExpressionStepElementKeyIntExact:
+		tfTypeStep = tftypes.ElementKeyInt(int64(step))
+	case path.ExpressionStepElementKeyStringExact:
+		tfTypeStep = tftypes.ElementKeyString(string(step))
+	case path.ExpressionStepElementKeyValueExact:
+		tfTypeStep = tftypes.ElementKeyValue(step.Value)
+	case path.ExpressionStepElementAny:
+		switch typ := typ.(type) {
+		case attr.TypeWithElementType:
+			return typeValidExpressionSteps(ctx, typ.ElementType(), remainingSteps)
+		case attr.TypeWithElementTypes:
+			for _, elemType := range typ.ElementTypes() {
+				if typeValidExpressionSteps(ctx, elemType, remainingSteps) {
+					return true
+				}
+			}
+
+			return false
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+
+	next, err := typ.ApplyTerraform5AttributePathStep(tfTypeStep)
+
+	if err != nil {
+		return false
+	}
+
+	nextType, ok := next.(attr.Type)
+
+	if !ok {
+		return false
+	}
+
+	return typeValidExpressionSteps(ctx, nextType, remainingSteps)
+}
@@ -0,0 +1,199 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConfigPathMatches(t *testing.T) {
+	t.Parallel()
+
+	testSchema := Schema{
+		Attributes: map[string]Attribute{
+			"test_string": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"test_list": {
+				Type:     types.ListType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"test_map": {
+				Attributes: MapNestedAttributes(map[string]Attribute{
+					"nested": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				}),
+				Optional: true,
+			},
+			"test_dynamic": {
+				Type:     types.DynamicType{},
+				Optional: true,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_string": tftypes.String,
+			"test_list":   tftypes.List{ElementType: tftypes.String},
+			"test_map": tftypes.Map{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"nested": tftypes.String,
+					},
+				},
+			},
+			"test_dynamic": tftypes.DynamicPseudoType,
+		},
+	}
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested": tftypes.String,
+		},
+	}
+
+	testConfig := Config{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_string": tftypes.NewValue(tftypes.String, "value"),
+			"test_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.String, "two"),
+			}),
+			"test_map": tftypes.NewValue(tftypes.Map{ElementType: testNestedType}, map[string]tftypes.Value{
+				"key1": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"nested": tftypes.NewValue(tftypes.String, "one"),
+				}),
+				"key2": tftypes.NewValue(testNestedType, nil),
+			}),
+			"test_dynamic": tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+		}),
+	}
+
+	testCases := map[string]struct {
+		config        Config
+		expression    path.Expression
+		expected      path.Paths
+		expectedDiags diag.Diagnostics
+	}{
+		"AttributeNameExact": {
+			config:     testConfig,
+			expression: path.MatchRoot("test_string"),
+			expected: path.Paths{
+				path.Root("test_string"),
+			},
+		},
+		"AttributeNameExact-ElementAny": {
+			config:     testConfig,
+			expression: path.MatchRoot("test_list").AtAnyElement(),
+			expected: path.Paths{
+				path.Root("test_list").AtListIndex(0),
+				path.Root("test_list").AtListIndex(1),
+			},
+		},
+		"AttributeNameExact-ElementKeyIntExact": {
+			config:     testConfig,
+			expression: path.MatchRoot("test_list").AtListIndex(1),
+			expected: path.Paths{
+				path.Root("test_list").AtListIndex(1),
+			},
+		},
+		"AttributeNameExact-ElementAny-AttributeNameExact": {
+			config:     testConfig,
+			expression: path.MatchRoot("test_map").AtAnyElement().AtName("nested"),
+			expected: path.Paths{
+				path.Root("test_map").AtMapKey("key1").AtName("nested"),
+				path.Root("test_map").AtMapKey("key2"),
+			},
+		},
+		"AttributeNameExact-Parent-AttributeNameExact": {
+			config:     testConfig,
+			expression: path.MatchRoot("test_list").AtParent().AtName("test_string"),
+			expected: path.Paths{
+				path.Root("test_string"),
+			},
+		},
+		"dynamic": {
+			config:     testConfig,
+			expression: path.MatchRoot("test_dynamic").AtName("anything"),
+			expected: path.Paths{
+				path.Root("test_dynamic"),
+			},
+		},
+		"invalid-for-schema": {
+			config:     testConfig,
+			expression: path.MatchRoot("test_string").AtAnyElement(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path Expression for Schema",
+					"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+						"This can happen if the path expression does not correctly follow the schema in structure or types, "+
+						"such as using a block path expression for an attribute or when using an attribute path expression for a block. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Path Expression: test_string[*]",
+				),
+			},
+		},
+		"no-matches": {
+			config:     testConfig,
+			expression: path.MatchRoot("test_list").AtListIndex(5),
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Invalid Path Expression for Schema Data",
+					"The Terraform Provider unexpectedly matched no paths with the given path expression and current schema data. "+
+						"This can happen if the path expression does not correctly follow the schema in structure or types, "+
+						"such as using a block path expression for an attribute or when using an attribute path expression for a block. "+
+						"This is always an issue in the provider and should be reported to the provider developer.\n\n"+
+						"Path Expression: test_list[5]",
+				),
+			},
+		},
+		"relative": {
+			config:     testConfig,
+			expression: path.MatchRelative().AtName("test_string"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path Expression for Schema",
+					"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+						"This can happen if the path expression does not correctly follow the schema in structure or types, "+
+						"such as using a block path expression for an attribute or when using an attribute path expression for a block. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Path Expression: test_string",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tc.config.PathMatches(context.Background(), tc.expression)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			// tftypes.Walk does not guarantee the order of map elements.
+			sortPaths := cmpopts.SortSlices(func(a, b path.Path) bool {
+				return a.String() < b.String()
+			})
+
+			if diff := cmp.Diff(got, tc.expected, sortPaths); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
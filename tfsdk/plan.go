@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -25,7 +27,7 @@ func (p Plan) Get(ctx context.Context, target interface{}) diag.Diagnostics {
 
 // GetAttribute retrieves the attribute found at `path` and populates the
 // `target` with the value.
-func (p Plan) GetAttribute(ctx context.Context, path *tftypes.AttributePath, target interface{}) diag.Diagnostics {
+func (p Plan) GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics {
 	ctx = logging.FrameworkWithAttributePath(ctx, path.String())
 
 	attrValue, diags := p.getAttributeValue(ctx, path)
@@ -56,13 +58,22 @@ func (p Plan) GetAttribute(ctx context.Context, path *tftypes.AttributePath, tar
 	return diags
 }
 
+// PathMatches returns all matching path.Paths from the given path.Expression.
+//
+// If a parent path is null or unknown, which would prevent a full expression
+// from matching, the parent path is returned rather than no match to prevent
+// false positives.
+func (p Plan) PathMatches(ctx context.Context, pathExpr path.Expression) (path.Paths, diag.Diagnostics) {
+	return schemaDataPathMatches(ctx, p.Schema, p.Raw, pathExpr)
+}
+
 // getAttributeValue retrieves the attribute found at `path` and returns it as an
 // attr.Value. Consumers should assert the type of the returned value with the
 // desired attr.Type.
-func (p Plan) getAttributeValue(ctx context.Context, path *tftypes.AttributePath) (attr.Value, diag.Diagnostics) {
+func (p Plan) getAttributeValue(ctx context.Context, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	attrType, err := p.Schema.AttributeTypeAtPath(path)
+	attrType, err := p.Schema.AttributeTypeAtPath(ctx, path)
 	if err != nil {
 		err = fmt.Errorf("error getting attribute type in schema: %w", err)
 		diags.AddAttributeError(
@@ -78,7 +89,7 @@ func (p Plan) getAttributeValue(ctx context.Context, path *tftypes.AttributePath
 		return nil, nil
 	}
 
-	tfValue, err := p.terraformValueAtPath(path)
+	tfValue, err := p.terraformValueAtPath(ctx, path)
 
 	// Ignoring ErrInvalidStep will allow this method to return a null value of the type.
 	if err != nil && !errors.Is(err, tftypes.ErrInvalidStep) {
@@ -123,7 +134,7 @@ func (p Plan) getAttributeValue(ctx context.Context, path *tftypes.AttributePath
 // should be a struct whose values have one of the attr.Value types. Each field
 // must be tagged with the corresponding schema field.
 func (p *Plan) Set(ctx context.Context, val interface{}) diag.Diagnostics {
-	newPlanAttrValue, diags := reflect.FromValue(ctx, p.Schema.AttributeType(), val, tftypes.NewAttributePath())
+	newPlanAttrValue, diags := reflect.FromValue(ctx, p.Schema.AttributeType(), val, path.Empty())
 	if diags.HasError() {
 		return diags
 	}
@@ -150,12 +161,12 @@ func (p *Plan) Set(ctx context.Context, val interface{}) diag.Diagnostics {
 // paths as necessary.
 //
 // Lists can only have the next element added according to the current length.
-func (p *Plan) SetAttribute(ctx context.Context, path *tftypes.AttributePath, val interface{}) diag.Diagnostics {
+func (p *Plan) SetAttribute(ctx context.Context, path path.Path, val interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	ctx = logging.FrameworkWithAttributePath(ctx, path.String())
 
-	attrType, err := p.Schema.AttributeTypeAtPath(path)
+	attrType, err := p.Schema.AttributeTypeAtPath(ctx, path)
 	if err != nil {
 		err = fmt.Errorf("error getting attribute type in schema: %w", err)
 		diags.AddAttributeError(
@@ -195,6 +206,31 @@ func (p *Plan) SetAttribute(ctx context.Context, path *tftypes.AttributePath, va
 		}
 	}
 
+	// Values inside a dynamic attribute have no type information in the
+	// schema, so the entire dynamic attribute value is rebuilt instead.
+	if dynamicPath, ok := p.Schema.dynamicAncestorPath(ctx, path); ok {
+		dynamicValue, err := p.terraformValueAtPath(ctx, dynamicPath)
+
+		if err != nil && !errors.Is(err, tftypes.ErrInvalidStep) {
+			diags.AddAttributeError(
+				dynamicPath,
+				"Plan Read Error",
+				"An unexpected error was encountered trying to read an attribute from the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return diags
+		}
+
+		var dynamicDiags diag.Diagnostics
+		tfVal, dynamicDiags = upsertDynamicChildValue(ctx, dynamicPath, dynamicValue, path.Steps()[len(dynamicPath.Steps()):], tfVal)
+		diags.Append(dynamicDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		path = dynamicPath
+	}
+
 	transformFunc, transformFuncDiags := p.setAttributeTransformFunc(ctx, path, tfVal, nil)
 	diags.Append(transformFuncDiags...)
 
@@ -218,10 +254,21 @@ func (p *Plan) SetAttribute(ctx context.Context, path *tftypes.AttributePath, va
 
 // pathExists walks the current state and returns true if the path can be reached.
 // The value at the path may be null or unknown.
-func (p Plan) pathExists(_ context.Context, path *tftypes.AttributePath) (bool, diag.Diagnostics) {
+func (p Plan) pathExists(ctx context.Context, path path.Path) (bool, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	_, remaining, err := tftypes.WalkAttributePath(p.Raw, path)
+	tftypesPath, err := totftypes.AttributePath(ctx, path)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Plan Read Error",
+			"An unexpected error was encountered trying to read an attribute from the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return false, diags
+	}
+
+	_, remaining, err := tftypes.WalkAttributePath(p.Raw, tftypesPath)
 
 	if err != nil {
 		if errors.Is(err, tftypes.ErrInvalidStep) {
@@ -244,7 +291,7 @@ func (p Plan) pathExists(_ context.Context, path *tftypes.AttributePath) (bool,
 // Plan values along the path. If the value at the path does not yet exist,
 // this will perform recursion to add the child value to a parent value,
 // creating the parent value if necessary.
-func (p Plan) setAttributeTransformFunc(ctx context.Context, path *tftypes.AttributePath, tfVal tftypes.Value, diags diag.Diagnostics) (transformFunc, diag.Diagnostics) {
+func (p Plan) setAttributeTransformFunc(ctx context.Context, path path.Path, tfVal tftypes.Value, diags diag.Diagnostics) (transformFunc, diag.Diagnostics) {
 	exists, pathExistsDiags := p.pathExists(ctx, path)
 	diags.Append(pathExistsDiags...)
 
@@ -253,17 +300,28 @@ func (p Plan) setAttributeTransformFunc(ctx context.Context, path *tftypes.Attri
 	}
 
 	if exists {
+		tftypesPath, err := totftypes.AttributePath(ctx, path)
+
+		if err != nil {
+			diags.AddAttributeError(
+				path,
+				"Plan Write Error",
+				"An unexpected error was encountered trying to write an attribute to the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return nil, diags
+		}
+
 		// Overwrite existing value
 		return func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
-			if p.Equal(path) {
+			if p.Equal(tftypesPath) {
 				return tfVal, nil
 			}
 			return v, nil
 		}, diags
 	}
 
-	parentPath := path.WithoutLastStep()
-	parentAttrType, err := p.Schema.AttributeTypeAtPath(parentPath)
+	parentPath := path.ParentPath()
+	parentAttrType, err := p.Schema.AttributeTypeAtPath(ctx, parentPath)
 
 	if err != nil {
 		err = fmt.Errorf("error getting parent attribute type in schema: %w", err)
@@ -275,7 +333,7 @@ func (p Plan) setAttributeTransformFunc(ctx context.Context, path *tftypes.Attri
 		return nil, diags
 	}
 
-	parentValue, err := p.terraformValueAtPath(parentPath)
+	parentValue, err := p.terraformValueAtPath(ctx, parentPath)
 
 	if err != nil && !errors.Is(err, tftypes.ErrInvalidStep) {
 		diags.AddAttributeError(
@@ -287,8 +345,9 @@ func (p Plan) setAttributeTransformFunc(ctx context.Context, path *tftypes.Attri
 	}
 
 	if parentValue.IsNull() || !parentValue.IsKnown() {
-		// TODO: This will break when DynamicPsuedoType is introduced.
-		// tftypes.Type should implement AttributePathStepper, but it currently does not.
+		// Paths inside DynamicPseudoType values are handled separately by
+		// SetAttribute, so the parent type always comes from the schema.
+		// TODO: tftypes.Type should implement AttributePathStepper, but it currently does not.
 		// When it does, we should use: tftypes.WalkAttributePath(p.Raw.Type(), parentPath)
 		// Reference: https://github.com/hashicorp/terraform-plugin-go/issues/110
 		parentType := parentAttrType.TerraformType(ctx)
@@ -308,7 +367,7 @@ func (p Plan) setAttributeTransformFunc(ctx context.Context, path *tftypes.Attri
 	}
 
 	var childValueDiags diag.Diagnostics
-	childStep := path.LastStep()
+	childStep, _ := path.Steps().LastStep()
 	parentValue, childValueDiags = upsertChildValue(ctx, parentPath, parentValue, childStep, tfVal)
 	diags.Append(childValueDiags...)
 
@@ -330,8 +389,14 @@ func (p Plan) setAttributeTransformFunc(ctx context.Context, path *tftypes.Attri
 	return p.setAttributeTransformFunc(ctx, parentPath, parentValue, diags)
 }
 
-func (p Plan) terraformValueAtPath(path *tftypes.AttributePath) (tftypes.Value, error) {
-	rawValue, remaining, err := tftypes.WalkAttributePath(p.Raw, path)
+func (p Plan) terraformValueAtPath(ctx context.Context, path path.Path) (tftypes.Value, error) {
+	tftypesPath, err := totftypes.AttributePath(ctx, path)
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	rawValue, remaining, err := tftypes.WalkAttributePath(p.Raw, tftypesPath)
 	if err != nil {
 		return tftypes.Value{}, fmt.Errorf("%v still remains in the path: %w", remaining, err)
 	}
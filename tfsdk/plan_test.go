@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -108,7 +109,7 @@ func TestPlanGet_testTypes(t *testing.T) {
 			expected: testPlanGetDataTestTypes{
 				Name: testtypes.String{String: types.String{Value: ""}, CreatedBy: testtypes.StringTypeWithValidateError{}},
 			},
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			plan: Plan{
@@ -131,7 +132,7 @@ func TestPlanGet_testTypes(t *testing.T) {
 			expected: testPlanGetDataTestTypes{
 				Name: testtypes.String{String: types.String{Value: "namevalue"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
 			},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
 
@@ -251,7 +252,7 @@ func TestPlanGetAttribute(t *testing.T) {
 			expected: new(testtypes.String),
 			expectedDiags: diag.Diagnostics{
 				diag.WithPath(
-					tftypes.NewAttributePath().WithAttributeName("name"),
+					path.Root("name"),
 					intreflect.DiagNewAttributeValueIntoWrongType{
 						ValType:    reflect.TypeOf(types.String{Value: "namevalue"}),
 						TargetType: reflect.TypeOf(testtypes.String{}),
@@ -282,7 +283,7 @@ func TestPlanGetAttribute(t *testing.T) {
 			expected: new(bool),
 			expectedDiags: diag.Diagnostics{
 				diag.WithPath(
-					tftypes.NewAttributePath().WithAttributeName("name"),
+					path.Root("name"),
 					intreflect.DiagIntoIncompatibleType{
 						Val:        tftypes.NewValue(tftypes.String, "namevalue"),
 						TargetType: reflect.TypeOf(false),
@@ -311,7 +312,7 @@ func TestPlanGetAttribute(t *testing.T) {
 			},
 			target:        new(testtypes.String),
 			expected:      new(testtypes.String),
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			plan: Plan{
@@ -333,7 +334,7 @@ func TestPlanGetAttribute(t *testing.T) {
 			},
 			target:        new(testtypes.String),
 			expected:      &testtypes.String{String: types.String{Value: "namevalue"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
 
@@ -342,7 +343,7 @@ func TestPlanGetAttribute(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			diags := tc.plan.GetAttribute(context.Background(), tftypes.NewAttributePath().WithAttributeName("name"), tc.target)
+			diags := tc.plan.GetAttribute(context.Background(), path.Root("name"), tc.target)
 
 			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
 				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
@@ -360,7 +361,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 
 	type testCase struct {
 		plan          Plan
-		path          *tftypes.AttributePath
+		path          path.Path
 		expected      attr.Value
 		expectedDiags diag.Diagnostics
 	}
@@ -387,7 +388,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: nil,
 		},
 		"WithAttributeName-nonexistent": {
@@ -408,11 +409,11 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("other"),
+			path:     path.Root("other"),
 			expected: nil,
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("other"),
+					path.Root("other"),
 					"Plan Read Error",
 					"An unexpected error was encountered trying to read an attribute from the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"error getting attribute type in schema: AttributeName(\"other\") still remains in the path: could not find attribute or block \"other\" in schema",
@@ -449,7 +450,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-List-WithElementKeyInt": {
@@ -485,7 +486,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
@@ -529,7 +530,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName-Object": {
@@ -586,7 +587,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path: path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.Object{
 				Null:      true,
 				AttrTypes: map[string]attr.Type{"value": types.StringType},
@@ -641,7 +642,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-ListNestedBlocks-null-WithElementKeyInt-WithAttributeName": {
@@ -710,7 +711,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-ListNestedBlocks-WithElementKeyInt-WithAttributeName": {
@@ -787,7 +788,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Map-null-WithElementKeyString": {
@@ -820,7 +821,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:     path.Root("test").AtMapKey("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-Map-WithElementKeyString": {
@@ -856,7 +857,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:     path.Root("test").AtMapKey("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Map-WithElementKeyString-nonexistent": {
@@ -891,7 +892,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("other"),
+			path:     path.Root("test").AtMapKey("other"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-MapNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
@@ -935,7 +936,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtMapKey("element").AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-MapNestedAttributes-WithElementKeyString-WithAttributeName": {
@@ -987,7 +988,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtMapKey("element").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Object-WithAttributeName": {
@@ -1028,7 +1029,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Set-null-WithElementKeyValue": {
@@ -1061,7 +1062,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-Set-WithElementKeyValue": {
@@ -1097,7 +1098,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SetNestedAttributes-null-WithElementKeyValue-WithAttributeName": {
@@ -1141,13 +1142,13 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SetNestedAttributes-WithElementKeyValue-WithAttributeName": {
@@ -1199,13 +1200,13 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SetNestedBlocks-null-WithElementKeyValue-WithAttributeName": {
@@ -1274,13 +1275,13 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SetNestedBlocks-WithElementKeyValue-WithAttributeName": {
@@ -1357,13 +1358,13 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-Float64": {
@@ -1403,7 +1404,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.Float64{Null: true},
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-Int64": {
@@ -1443,7 +1444,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.Int64{Null: true},
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-Set": {
@@ -1489,7 +1490,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.Set{ElemType: types.StringType, Null: true},
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-String": {
@@ -1529,7 +1530,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SingleNestedAttributes-WithAttributeName": {
@@ -1571,7 +1572,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-String-null": {
@@ -1598,7 +1599,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-String-unknown": {
@@ -1625,7 +1626,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Unknown: true},
 		},
 		"WithAttributeName-String-value": {
@@ -1652,7 +1653,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Value: "value"},
 		},
 		"AttrTypeWithValidateError": {
@@ -1679,9 +1680,9 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:          tftypes.NewAttributePath().WithAttributeName("test"),
+			path:          path.Root("test"),
 			expected:      nil,
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("test"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			plan: Plan{
@@ -1707,9 +1708,9 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:          tftypes.NewAttributePath().WithAttributeName("test"),
+			path:          path.Root("test"),
 			expected:      testtypes.String{String: types.String{Value: "value"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("test"))},
 		},
 	}
 
@@ -1735,7 +1736,7 @@ func TestPlanPathExists(t *testing.T) {
 
 	type testCase struct {
 		plan          Plan
-		path          *tftypes.AttributePath
+		path          path.Path
 		expected      bool
 		expectedDiags diag.Diagnostics
 	}
@@ -1743,12 +1744,12 @@ func TestPlanPathExists(t *testing.T) {
 	testCases := map[string]testCase{
 		"empty-path": {
 			plan:     Plan{},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: false,
 		},
 		"empty-root": {
 			plan:     Plan{},
-			path:     tftypes.NewAttributePath(),
+			path:     path.Empty(),
 			expected: true,
 		},
 		"root": {
@@ -1769,7 +1770,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath(),
+			path:     path.Empty(),
 			expected: true,
 		},
 		"WithAttributeName": {
@@ -1790,7 +1791,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: true,
 		},
 		"WithAttributeName-mismatch": {
@@ -1811,7 +1812,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("other"),
+			path:     path.Root("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithAttributeName": {
@@ -1846,7 +1847,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("nested"),
+			path:     path.Root("test").AtName("nested"),
 			expected: true,
 		},
 		"WithAttributeName.WithAttributeName-mismatch-child": {
@@ -1881,7 +1882,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("other"),
+			path:     path.Root("test").AtName("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithAttributeName-mismatch-parent": {
@@ -1902,7 +1903,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("other"),
+			path:     path.Root("test").AtName("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyInt": {
@@ -1931,7 +1932,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: true,
 		},
 		"WithAttributeName.WithElementKeyInt-mismatch-child": {
@@ -1960,7 +1961,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(1),
+			path:     path.Root("test").AtListIndex(1),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyInt-mismatch-parent": {
@@ -1981,7 +1982,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyString": {
@@ -2010,7 +2011,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path:     path.Root("test").AtMapKey("key"),
 			expected: true,
 		},
 		"WithAttributeName.WithElementKeyString-mismatch-child": {
@@ -2039,7 +2040,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("other"),
+			path:     path.Root("test").AtMapKey("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyString-mismatch-parent": {
@@ -2060,7 +2061,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("other"),
+			path:     path.Root("test").AtMapKey("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyValue": {
@@ -2089,7 +2090,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "testvalue")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "testvalue")),
 			expected: true,
 		},
 		"WithAttributeName.WithElementKeyValue-mismatch-child": {
@@ -2118,7 +2119,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "othervalue")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "othervalue")),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyValue-mismatch-parent": {
@@ -2139,7 +2140,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "othervalue")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "othervalue")),
 			expected: false,
 		},
 	}
@@ -2247,7 +2248,7 @@ func TestPlanSet(t *testing.T) {
 				Name: "newvalue",
 			},
 			expected:      tftypes.Value{},
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			plan: Plan{
@@ -2273,7 +2274,7 @@ func TestPlanSet(t *testing.T) {
 			}, map[string]tftypes.Value{
 				"name": tftypes.NewValue(tftypes.String, "newvalue"),
 			}),
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
 
@@ -2300,13 +2301,86 @@ func TestPlanSetAttribute(t *testing.T) {
 
 	type testCase struct {
 		plan          Plan
-		path          *tftypes.AttributePath
+		path          path.Path
 		val           interface{}
 		expected      tftypes.Value
 		expectedDiags diag.Diagnostics
 	}
 
 	testCases := map[string]testCase{
+		"dynamic-set-nested-list-element": {
+			plan: Plan{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"payload": tftypes.DynamicPseudoType,
+					},
+				}, map[string]tftypes.Value{
+					"payload": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "a"),
+					}),
+				}),
+				Schema: Schema{
+					Attributes: map[string]Attribute{
+						"payload": {
+							Type:     types.DynamicType{},
+							Optional: true,
+						},
+					},
+				},
+			},
+			path: path.Root("payload").AtListIndex(1),
+			val:  "b",
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"payload": tftypes.DynamicPseudoType,
+				},
+			}, map[string]tftypes.Value{
+				"payload": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+					tftypes.NewValue(tftypes.String, "b"),
+				}),
+			}),
+		},
+		"dynamic-set-nested-list-element-wrong-type": {
+			plan: Plan{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"payload": tftypes.DynamicPseudoType,
+					},
+				}, map[string]tftypes.Value{
+					"payload": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "a"),
+					}),
+				}),
+				Schema: Schema{
+					Attributes: map[string]Attribute{
+						"payload": {
+							Type:     types.DynamicType{},
+							Optional: true,
+						},
+					},
+				},
+			},
+			path: path.Root("payload").AtListIndex(1),
+			val:  true,
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"payload": tftypes.DynamicPseudoType,
+				},
+			}, map[string]tftypes.Value{
+				"payload": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+				}),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("payload"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot set value inside dynamic value: all elements must have the same type, got tftypes.String and tftypes.Bool",
+				),
+			},
+		},
 		"add-List-Element-append": {
 			plan: Plan{
 				Raw: tftypes.NewValue(tftypes.Object{
@@ -2365,7 +2439,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1),
+			path: path.Root("disks").AtListIndex(1),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -2474,7 +2548,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(2),
+			path: path.Root("disks").AtListIndex(2),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -2517,7 +2591,7 @@ func TestPlanSetAttribute(t *testing.T) {
 			}),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("disks"),
+					path.Root("disks"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"Cannot add list element 3 as list currently has 1 length. To prevent ambiguity, only the next element can be added to a list. Add empty elements into the list prior to this call, if appropriate.",
@@ -2572,7 +2646,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+			path: path.Root("disks").AtListIndex(0),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -2662,7 +2736,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1),
+			path: path.Root("disks").AtListIndex(1),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -2695,7 +2769,7 @@ func TestPlanSetAttribute(t *testing.T) {
 			}),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("disks"),
+					path.Root("disks"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"Cannot add list element 2 as list currently has 0 length. To prevent ambiguity, only the next element can be added to a list. Add empty elements into the list prior to this call, if appropriate.",
@@ -2734,7 +2808,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key2"),
+			path: path.Root("test").AtMapKey("key2"),
 			val:  "key2value",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -2783,7 +2857,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "keyvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -2859,7 +2933,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -2966,7 +3040,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -3040,7 +3114,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  false,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3084,7 +3158,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags"),
+			path: path.Root("tags"),
 			val:  []string{"one", "two"},
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3168,7 +3242,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1),
+			path: path.Root("disks").AtListIndex(1),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -3252,7 +3326,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val: map[string]string{
 				"newkey": "newvalue",
 			},
@@ -3305,7 +3379,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "newvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3348,7 +3422,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  2,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3402,7 +3476,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("scratch_disk"),
+			path: path.Root("scratch_disk"),
 			val: struct {
 				Interface string `tfsdk:"interface"`
 				Other     string `tfsdk:"other"`
@@ -3471,7 +3545,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("scratch_disk").WithAttributeName("interface"),
+			path: path.Root("scratch_disk").AtName("interface"),
 			val:  "NVME",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3525,7 +3599,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags"),
+			path: path.Root("tags"),
 			val:  []string{"one", "two"},
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3609,7 +3683,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -3702,7 +3776,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyValue(tftypes.NewValue(tftypes.String, "three")),
+			path: path.Root("tags").AtSetValue(tftypes.NewValue(tftypes.String, "three")),
 			val:  "three",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3746,7 +3820,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  "newvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3774,7 +3848,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath(),
+			path: path.Empty(),
 			val:  false,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3783,7 +3857,7 @@ func TestPlanSetAttribute(t *testing.T) {
 			}, nil),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath(),
+					path.Empty(),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\nexpected tftypes.Object[\"test\":tftypes.Bool], got tftypes.Bool",
 				),
@@ -3810,7 +3884,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  false,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3847,7 +3921,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags"),
+			path: path.Root("tags"),
 			val:  []string{"one", "two"},
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3892,7 +3966,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path: path.Root("test").AtListIndex(0),
 			val:  "testvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3910,7 +3984,7 @@ func TestPlanSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test")),
+				testtypes.TestWarningDiagnostic(path.Root("test")),
 			},
 		},
 		"write-List-Element": {
@@ -3951,7 +4025,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+			path: path.Root("disks").AtListIndex(0),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -4031,7 +4105,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1),
+			path: path.Root("disks").AtListIndex(1),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -4054,7 +4128,7 @@ func TestPlanSetAttribute(t *testing.T) {
 			}, nil),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("disks"),
+					path.Root("disks"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"Cannot add list element 2 as list currently has 0 length. To prevent ambiguity, only the next element can be added to a list. Add empty elements into the list prior to this call, if appropriate.",
@@ -4089,7 +4163,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+			path: path.Root("disks").AtListIndex(0),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -4131,7 +4205,7 @@ func TestPlanSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0).WithAttributeName("id")),
+				testtypes.TestWarningDiagnostic(path.Root("disks").AtListIndex(0).AtName("id")),
 			},
 		},
 		"write-Map": {
@@ -4159,7 +4233,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val: map[string]string{
 				"newkey": "newvalue",
 			},
@@ -4206,7 +4280,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "keyvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4224,7 +4298,7 @@ func TestPlanSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test")),
+				testtypes.TestWarningDiagnostic(path.Root("test")),
 			},
 		},
 		"write-Map-Element": {
@@ -4252,7 +4326,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "keyvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4295,7 +4369,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "keyvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4313,7 +4387,7 @@ func TestPlanSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key")),
+				testtypes.TestWarningDiagnostic(path.Root("test").AtMapKey("key")),
 			},
 		},
 		"write-Number": {
@@ -4337,7 +4411,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  1,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4378,7 +4452,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("scratch_disk"),
+			path: path.Root("scratch_disk"),
 			val: struct {
 				Interface string `tfsdk:"interface"`
 			}{
@@ -4427,7 +4501,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags"),
+			path: path.Root("tags"),
 			val:  []string{"one", "two"},
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4482,7 +4556,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -4560,7 +4634,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "testvalue")),
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "testvalue")),
 			val:  "testvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4578,7 +4652,7 @@ func TestPlanSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test")),
+				testtypes.TestWarningDiagnostic(path.Root("test")),
 			},
 		},
 		"write-Set-Element-AttrTypeWithValidateWarning": {
@@ -4619,7 +4693,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -4669,7 +4743,7 @@ func TestPlanSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+				testtypes.TestWarningDiagnostic(path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
 						"id":                   tftypes.String,
 						"delete_with_instance": tftypes.Bool,
@@ -4677,7 +4751,7 @@ func TestPlanSetAttribute(t *testing.T) {
 				}, map[string]tftypes.Value{
 					"id":                   tftypes.NewValue(tftypes.String, "mynewdisk"),
 					"delete_with_instance": tftypes.NewValue(tftypes.Bool, true),
-				})).WithAttributeName("id")),
+				})).AtName("id")),
 			},
 		},
 		"write-String": {
@@ -4701,7 +4775,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  "newvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4731,7 +4805,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("name"),
+			path: path.Root("name"),
 			val:  "newname",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4740,7 +4814,7 @@ func TestPlanSetAttribute(t *testing.T) {
 			}, map[string]tftypes.Value{
 				"name": tftypes.NewValue(tftypes.String, "originalname"),
 			}),
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			plan: Plan{
@@ -4760,7 +4834,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("name"),
+			path: path.Root("name"),
 			val:  "newname",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4770,7 +4844,7 @@ func TestPlanSetAttribute(t *testing.T) {
 				"name": tftypes.NewValue(tftypes.String, "newname"),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name")),
+				testtypes.TestWarningDiagnostic(path.Root("name")),
 			},
 		},
 	}
@@ -1,5 +1,9 @@
 package tfsdk
 
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+)
+
 // ConfigureProviderRequest represents a request containing the values the user
 // specified for the provider configuration block, along with other runtime
 // information from Terraform or the Plugin SDK. An instance of this request
@@ -16,6 +20,17 @@ type ConfigureProviderRequest struct {
 	// that's implementing the Provider interface, for use in later
 	// resource CRUD operations.
 	Config Config
+
+	// ConfigHasUnknownValues is true if any value in Config is unknown. This
+	// occurs during planning when the provider configuration depends on
+	// values which are not yet known, such as attributes of resources which
+	// have not been created. Terraform configures the provider again with
+	// the known values during apply.
+	//
+	// Set ConfigureProviderResponse.DeferWhenConfigUnknown to prevent the
+	// framework from calling resource planning and data source reading
+	// logic while the configuration is unknown.
+	ConfigHasUnknownValues bool
 }
 
 // CreateResourceRequest represents a request for the provider to create a
@@ -34,6 +49,10 @@ type CreateResourceRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// ProviderData is the ResourceData value the provider set in the
+	// ConfigureProviderResponse, such as a configured API client.
+	ProviderData interface{}
 }
 
 // ReadResourceRequest represents a request for the provider to read a
@@ -47,6 +66,20 @@ type ReadResourceRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// ProviderData is the ResourceData value the provider set in the
+	// ConfigureProviderResponse, such as a configured API client.
+	ProviderData interface{}
+
+	// Private is provider-defined resource private state data which was
+	// previously stored with the resource state. This data is opaque to
+	// Terraform and does not affect plan output. Any existing data is
+	// copied to ReadResourceResponse.Private for passthrough as part of a
+	// successful operation.
+	//
+	// Use the GetKey method to read data. Use the SetKey method on
+	// ReadResourceResponse.Private to update or remove a value.
+	Private *privatestate.ProviderData
 }
 
 // UpdateResourceRequest represents a request for the provider to update a
@@ -69,6 +102,21 @@ type UpdateResourceRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// ProviderData is the ResourceData value the provider set in the
+	// ConfigureProviderResponse, such as a configured API client.
+	ProviderData interface{}
+
+	// Private is provider-defined resource private state data which was
+	// previously stored with the resource state, including any changes
+	// made during plan modification. This data is opaque to Terraform and
+	// does not affect plan output. Any existing data is copied to
+	// UpdateResourceResponse.Private for passthrough as part of a
+	// successful operation.
+	//
+	// Use the GetKey method to read data. Use the SetKey method on
+	// UpdateResourceResponse.Private to update or remove a value.
+	Private *privatestate.ProviderData
 }
 
 // DeleteResourceRequest represents a request for the provider to delete a
@@ -81,6 +129,18 @@ type DeleteResourceRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// ProviderData is the ResourceData value the provider set in the
+	// ConfigureProviderResponse, such as a configured API client.
+	ProviderData interface{}
+
+	// Private is provider-defined resource private state data which was
+	// previously stored with the resource state. This data is opaque to
+	// Terraform and does not affect plan output. It is discarded after a
+	// successful Delete operation.
+	//
+	// Use the GetKey method to read data.
+	Private *privatestate.ProviderData
 }
 
 // ModifyResourcePlanRequest represents a request for the provider to modify the
@@ -101,6 +161,20 @@ type ModifyResourcePlanRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// ProviderData is the ResourceData value the provider set in the
+	// ConfigureProviderResponse, such as a configured API client.
+	ProviderData interface{}
+
+	// Private is provider-defined resource private state data which was
+	// previously stored with the resource state. This data is opaque to
+	// Terraform and does not affect plan output. Any existing data is
+	// copied to ModifyResourcePlanResponse.Private for passthrough as part of a
+	// successful operation.
+	//
+	// Use the GetKey method to read data. Use the SetKey method on
+	// ModifyResourcePlanResponse.Private to update or remove a value.
+	Private *privatestate.ProviderData
 }
 
 // ReadDataSourceRequest represents a request for the provider to read a data
@@ -117,4 +191,8 @@ type ReadDataSourceRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// ProviderData is the DataSourceData value the provider set in the
+	// ConfigureProviderResponse, such as a configured API client.
+	ProviderData interface{}
 }
@@ -11,4 +11,8 @@ type ImportResourceStateRequest struct {
 	// its own type of value and parsed during import. This value
 	// is not stored in the state unless the provider explicitly stores it.
 	ID string
+
+	// ProviderData is the ResourceData value the provider set in the
+	// ConfigureProviderResponse, such as a configured API client.
+	ProviderData interface{}
 }
@@ -11,6 +11,11 @@ type ValidateDataSourceConfigRequest struct {
 	// interpolation or other functionality that would prevent Terraform
 	// from knowing the value at request time.
 	Config Config
+
+	// ProviderData is the DataSourceData value the provider set in the
+	// ConfigureProviderResponse. Terraform validates configuration before
+	// configuring the provider, so this is usually nil.
+	ProviderData interface{}
 }
 
 // ValidateProviderConfigRequest represents a request to validate the
@@ -37,4 +42,9 @@ type ValidateResourceConfigRequest struct {
 	// interpolation or other functionality that would prevent Terraform
 	// from knowing the value at request time.
 	Config Config
+
+	// ProviderData is the ResourceData value the provider set in the
+	// ConfigureProviderResponse. Terraform validates configuration before
+	// configuring the provider, so this is usually nil.
+	ProviderData interface{}
 }
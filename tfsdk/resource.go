@@ -34,6 +34,11 @@ type Resource interface {
 	// to update state. Planned state values should be read from the
 	// ReadResourceRequest and new state values set on the
 	// ReadResourceResponse.
+	//
+	// If the remote object no longer exists, either call the State
+	// RemoveResource method or return an error diagnostic created from an
+	// error wrapping ErrResourceNotFound, which the framework converts into
+	// a warning diagnostic.
 	Read(context.Context, ReadResourceRequest, *ReadResourceResponse)
 
 	// Update is called to update the state of the resource. Config, planned
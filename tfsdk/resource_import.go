@@ -3,7 +3,7 @@ package tfsdk
 import (
 	"context"
 
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // Optional interface on top of Resource that enables provider control over
@@ -23,8 +23,8 @@ type ResourceWithImportState interface {
 // ResourceImportStatePassthroughID is a helper function to set the import
 // identifier to a given state attribute path. The attribute must accept a
 // string value.
-func ResourceImportStatePassthroughID(ctx context.Context, path *tftypes.AttributePath, req ImportResourceStateRequest, resp *ImportResourceStateResponse) {
-	if path == nil || tftypes.NewAttributePath().Equal(path) {
+func ResourceImportStatePassthroughID(ctx context.Context, attrPath path.Path, req ImportResourceStateRequest, resp *ImportResourceStateResponse) {
+	if attrPath.Equal(path.Empty()) {
 		resp.Diagnostics.AddError(
 			"Resource Import Passthrough Missing Attribute Path",
 			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
@@ -32,5 +32,5 @@ func ResourceImportStatePassthroughID(ctx context.Context, path *tftypes.Attribu
 		)
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path, req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, req.ID)...)
 }
@@ -0,0 +1,52 @@
+package tfsdk
+
+import (
+	"errors"
+)
+
+// ErrResourceNotFound indicates the remote object of a resource no longer
+// exists, such as when it was deleted outside Terraform.
+//
+// If the resource Read method returns an error diagnostic created with
+// diag.NewErrorDiagnosticFromError from an error which wraps
+// ErrResourceNotFound, the framework removes the resource from the state and
+// returns a warning diagnostic instead of failing the refresh. For example:
+//
+//	if apiErr.StatusCode == http.StatusNotFound {
+//		resp.Diagnostics.AddErrorFromError(
+//			"Error Reading Widget",
+//			fmt.Errorf("%w: %s", tfsdk.ErrResourceNotFound, apiErr),
+//		)
+//		return
+//	}
+//
+// Errors can also implement ResourceNotFoundError instead of wrapping
+// ErrResourceNotFound.
+var ErrResourceNotFound = errors.New("resource not found")
+
+// ResourceNotFoundError is an error which can indicate the remote object of
+// a resource no longer exists, such as an API client error type. It is an
+// alternative to wrapping ErrResourceNotFound.
+type ResourceNotFoundError interface {
+	error
+
+	// ResourceNotFound returns true if the remote object does not exist.
+	ResourceNotFound() bool
+}
+
+// IsResourceNotFound returns true if the given error, or any error it wraps,
+// is ErrResourceNotFound or a ResourceNotFoundError which indicates the
+// remote object does not exist.
+func IsResourceNotFound(err error) bool {
+	if errors.Is(err, ErrResourceNotFound) {
+		return true
+	}
+
+	var notFoundErr ResourceNotFoundError
+
+	if errors.As(err, &notFoundErr) {
+		return notFoundErr.ResourceNotFound()
+	}
+
+	return false
+}
@@ -0,0 +1,71 @@
+package tfsdk
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type testResourceNotFoundError struct {
+	notFound bool
+}
+
+func (e testResourceNotFoundError) Error() string {
+	return "test error"
+}
+
+func (e testResourceNotFoundError) ResourceNotFound() bool {
+	return e.notFound
+}
+
+func TestIsResourceNotFound(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"nil": {
+			err:      nil,
+			expected: false,
+		},
+		"error": {
+			err:      errors.New("test error"),
+			expected: false,
+		},
+		"ErrResourceNotFound": {
+			err:      ErrResourceNotFound,
+			expected: true,
+		},
+		"ErrResourceNotFound-wrapped": {
+			err:      fmt.Errorf("reading widget: %w", ErrResourceNotFound),
+			expected: true,
+		},
+		"ResourceNotFoundError-true": {
+			err:      testResourceNotFoundError{notFound: true},
+			expected: true,
+		},
+		"ResourceNotFoundError-false": {
+			err:      testResourceNotFoundError{notFound: false},
+			expected: false,
+		},
+		"ResourceNotFoundError-wrapped": {
+			err:      fmt.Errorf("reading widget: %w", testResourceNotFoundError{notFound: true}),
+			expected: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := IsResourceNotFound(tc.err)
+
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
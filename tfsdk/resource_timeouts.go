@@ -0,0 +1,110 @@
+package tfsdk
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TimeoutsName is the name the TimeoutsAttribute or TimeoutsBlock must be
+// given in the resource schema. The framework reads the configured operation
+// timeouts from the attribute or block with this name.
+const TimeoutsName = "timeouts"
+
+// Timeouts are the default durations of resource operations, which are used
+// when the operation timeout is not configured. A zero duration does not set
+// a deadline for the operation.
+type Timeouts struct {
+	// Create is the default timeout of the resource Create method.
+	Create time.Duration
+
+	// Read is the default timeout of the resource Read method.
+	Read time.Duration
+
+	// Update is the default timeout of the resource Update method.
+	Update time.Duration
+
+	// Delete is the default timeout of the resource Delete method.
+	Delete time.Duration
+}
+
+// ResourceWithTimeouts represents a resource instance with default operation
+// timeouts.
+type ResourceWithTimeouts interface {
+	Resource
+
+	// Timeouts returns the default timeouts of the resource operations.
+	//
+	// The framework calls the Create, Read, Update and Delete methods with a
+	// context which has a deadline of the configured timeout in the
+	// TimeoutsAttribute or TimeoutsBlock, if any, otherwise the default
+	// timeout.
+	Timeouts(context.Context) Timeouts
+}
+
+// TimeoutsAttribute returns an optional single nested attribute for
+// configuring the create, read, update and delete timeouts of a resource,
+// which must be added to the resource schema Attributes as TimeoutsName.
+// Each timeout is a duration string, such as "30s" or "2h45m".
+//
+// The framework calls the resource operation methods with a context which
+// has a deadline of the configured timeout. Use the ResourceWithTimeouts
+// interface to set default timeouts.
+//
+// Nested attributes are not supported by protocol version 5. Use
+// TimeoutsBlock instead when serving protocol version 5.
+func TimeoutsAttribute() Attribute {
+	return Attribute{
+		Attributes:  SingleNestedAttributes(timeoutsAttributes()),
+		Description: timeoutsDescription,
+		Optional:    true,
+	}
+}
+
+// TimeoutsBlock returns a single nested block for configuring the create,
+// read, update and delete timeouts of a resource, which must be added to the
+// resource schema Blocks as TimeoutsName. Each timeout is a duration string,
+// such as "30s" or "2h45m".
+//
+// The framework calls the resource operation methods with a context which
+// has a deadline of the configured timeout. Use the ResourceWithTimeouts
+// interface to set default timeouts.
+func TimeoutsBlock() Block {
+	return Block{
+		Attributes:  timeoutsAttributes(),
+		Description: timeoutsDescription,
+		NestingMode: BlockNestingModeSingle,
+	}
+}
+
+const timeoutsDescription = "Timeouts of the resource operations. " +
+	"Each timeout is a duration string, such as \"30s\" or \"2h45m\". " +
+	"Valid time units are \"s\" (seconds), \"m\" (minutes) and \"h\" (hours)."
+
+// timeoutsAttributes returns the attributes of the TimeoutsAttribute and
+// TimeoutsBlock.
+func timeoutsAttributes() map[string]Attribute {
+	return map[string]Attribute{
+		"create": {
+			Description: "Timeout for creating the resource.",
+			Optional:    true,
+			Type:        types.DurationType,
+		},
+		"delete": {
+			Description: "Timeout for deleting the resource.",
+			Optional:    true,
+			Type:        types.DurationType,
+		},
+		"read": {
+			Description: "Timeout for reading the resource.",
+			Optional:    true,
+			Type:        types.DurationType,
+		},
+		"update": {
+			Description: "Timeout for updating the resource.",
+			Optional:    true,
+			Type:        types.DurationType,
+		},
+	}
+}
@@ -31,6 +31,37 @@ type ResourceWithUpgradeState interface {
 	UpgradeState(context.Context) map[int64]ResourceStateUpgrader
 }
 
+// Optional interface on top of Resource that enables provider control over
+// the UpgradeResourceState RPC by defining each state upgrade as a single
+// version step. This is an alternative to ResourceWithUpgradeState, which
+// requires every state upgrader to convert directly to the current schema
+// version. If a Resource implements both interfaces, only this interface is
+// used.
+//
+// The framework calls the state upgrader for each version in sequence,
+// starting from the stored state version, until the current Schema type
+// Version field is reached. The upgraded State of each step becomes the
+// prior State of the next step. For example, a stored state version of 2
+// with a current schema version of 5 calls the state upgraders for versions
+// 2, 3, and 4 in that order.
+type ResourceWithUpgradeStateSteps interface {
+	// A mapping of state version to the state upgrade implementation that
+	// converts state from that version to the next version. Each state
+	// upgrader must set PriorSchema, which is the schema of its version.
+	// The UpgradeResourceStateResponse type State field of each state
+	// upgrader is populated with the PriorSchema of the next version, or the
+	// current schema for the last step.
+	//
+	// Only the first step in a sequence receives the UpgradeResourceStateRequest
+	// type RawState field. Later steps must use the State field.
+	//
+	// Version keys begin at 0, which is the default schema version when
+	// undefined. The framework will return an error diagnostic should any
+	// version between the stored state version and current schema version
+	// not be implemented.
+	UpgradeStateSteps(context.Context) map[int64]ResourceStateUpgrader
+}
+
 // Implementation handler for a UpgradeResourceState operation.
 //
 // This is used to encapsulate all upgrade logic from a prior state to the
@@ -40,7 +71,9 @@ type ResourceStateUpgrader struct {
 	// Schema information for the prior state version. While not required,
 	// setting this will populate the UpgradeResourceStateRequest type State
 	// field similar to other Resource data types. This allows for easier data
-	// handling such as calling Get() or GetAttribute().
+	// handling such as calling Get() or GetAttribute(). Prior state data in
+	// the legacy flatmap format, written by Terraform CLI 0.11 and earlier,
+	// is also decoded using this schema.
 	//
 	// If not set, prior state data is available in the
 	// UpgradeResourceStateRequest type RawState field.
@@ -86,6 +119,11 @@ type UpgradeResourceStateRequest struct {
 	// type PriorSchema field was present. When available, this allows for
 	// easier data handling such as calling Get() or GetAttribute().
 	State *State
+
+	// ProviderData is the ResourceData value the provider set in the
+	// ConfigureProviderResponse. This is nil if Terraform upgrades the
+	// resource state before configuring the provider.
+	ProviderData interface{}
 }
 
 // Response information for the provider logic to update a resource state
@@ -2,7 +2,8 @@ package tfsdk
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // ConfigureProviderResponse represents a response to a
@@ -14,6 +15,36 @@ type ConfigureProviderResponse struct {
 	// provider. An empty slice indicates success, with no warnings or
 	// errors generated.
 	Diagnostics diag.Diagnostics
+
+	// ResourceData is provider-defined data, such as a configured API
+	// client, which is passed to every resource request, along with the
+	// attribute validators, plan modifiers and defaults of resource schemas,
+	// as the ProviderData field. This data is opaque to the framework.
+	ResourceData interface{}
+
+	// DataSourceData is provider-defined data, such as a configured API
+	// client, which is passed to every data source request, along with the
+	// attribute validators of data source schemas, as the ProviderData
+	// field. This data is opaque to the framework.
+	DataSourceData interface{}
+
+	// DeferWhenConfigUnknown, if true and the ConfigureProviderRequest
+	// ConfigHasUnknownValues, prevents the framework from calling provider
+	// code which may require a fully configured provider until Terraform
+	// configures the provider again with known values. Instead:
+	//
+	//    - PlanResourceChange returns the proposed new state with all
+	//      Computed attributes and attributes with a Default which are null
+	//      in the configuration marked as unknown, without calling attribute
+	//      defaults or resource plan modification logic. Only the
+	//      RequiresReplace and RequiresReplaceIf attribute plan modifiers
+	//      are called, to determine whether the resource requires
+	//      replacement.
+	//    - ReadDataSource returns an error diagnostic, without calling the
+	//      data source Read method, since a data source read during
+	//      planning is not read again during apply.
+	//
+	DeferWhenConfigUnknown bool
 }
 
 // CreateResourceResponse represents a response to a CreateResourceRequest. An
@@ -30,6 +61,15 @@ type CreateResourceResponse struct {
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
 	Diagnostics diag.Diagnostics
+
+	// Private is provider-defined resource private state data which will
+	// be stored with the resource state. This data is opaque to Terraform
+	// and does not affect plan output. This field is pre-populated from any
+	// private state data set during plan modification.
+	//
+	// Use the SetKey method to set or remove a value. Keys prefixed with a
+	// period ('.') are reserved for the framework.
+	Private *privatestate.ProviderData
 }
 
 // ReadResourceResponse represents a response to a ReadResourceRequest. An
@@ -46,6 +86,15 @@ type ReadResourceResponse struct {
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
 	Diagnostics diag.Diagnostics
+
+	// Private is provider-defined resource private state data which will
+	// be stored with the resource state. This data is opaque to Terraform
+	// and does not affect plan output. This field is pre-populated from
+	// ReadResourceRequest.Private.
+	//
+	// Use the SetKey method to set or remove a value. Keys prefixed with a
+	// period ('.') are reserved for the framework.
+	Private *privatestate.ProviderData
 }
 
 // UpdateResourceResponse represents a response to an UpdateResourceRequest. An
@@ -62,6 +111,16 @@ type UpdateResourceResponse struct {
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
 	Diagnostics diag.Diagnostics
+
+	// Private is provider-defined resource private state data which will
+	// be stored with the resource state. This data is opaque to Terraform
+	// and does not affect plan output. This field is pre-populated from
+	// UpdateResourceRequest.Private, including any changes made during plan
+	// modification.
+	//
+	// Use the SetKey method to set or remove a value. Keys prefixed with a
+	// period ('.') are reserved for the framework.
+	Private *privatestate.ProviderData
 }
 
 // DeleteResourceResponse represents a response to a DeleteResourceRequest. An
@@ -88,17 +147,26 @@ type ModifyResourcePlanResponse struct {
 	// Plan is the planned new state for the resource.
 	Plan Plan
 
-	// RequiresReplace is a list of tftypes.AttributePaths that require the
+	// RequiresReplace is a list of attribute paths that require the
 	// resource to be replaced. They should point to the specific field
 	// that changed that requires the resource to be destroyed and
 	// recreated.
-	RequiresReplace []*tftypes.AttributePath
+	RequiresReplace path.Paths
 
 	// Diagnostics report errors or warnings related to determining the
 	// planned state of the requested resource. Returning an empty slice
 	// indicates a successful plan modification with no warnings or errors
 	// generated.
 	Diagnostics diag.Diagnostics
+
+	// Private is provider-defined resource private state data which will
+	// be stored with the resource state. This data is opaque to Terraform
+	// and does not affect plan output. This field is pre-populated from
+	// ModifyResourcePlanRequest.Private.
+	//
+	// Use the SetKey method to set or remove a value. Keys prefixed with a
+	// period ('.') are reserved for the framework.
+	Private *privatestate.ProviderData
 }
 
 // ReadDataSourceResponse represents a response to a ReadDataSourceRequest. An
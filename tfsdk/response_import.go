@@ -2,6 +2,7 @@ package tfsdk
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 )
 
 // ImportResourceStateResponse represents a response to a ImportResourceStateRequest.
@@ -18,4 +19,12 @@ type ImportResourceStateResponse struct {
 	// It must contain enough information so Terraform can successfully
 	// refresh the resource, e.g. call the Resource Read method.
 	State State
+
+	// Private is provider-defined resource private state data which will
+	// be stored with the imported resource state. This data is opaque to
+	// Terraform and does not affect plan output.
+	//
+	// Use the SetKey method to set a value. Keys prefixed with a period
+	// ('.') are reserved for the framework.
+	Private *privatestate.ProviderData
 }
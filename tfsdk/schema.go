@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -107,8 +109,14 @@ func (s Schema) AttributeType() attr.Type {
 }
 
 // AttributeTypeAtPath returns the attr.Type of the attribute at the given path.
-func (s Schema) AttributeTypeAtPath(path *tftypes.AttributePath) (attr.Type, error) {
-	rawType, remaining, err := tftypes.WalkAttributePath(s, path)
+func (s Schema) AttributeTypeAtPath(ctx context.Context, path path.Path) (attr.Type, error) {
+	tftypesPath, err := totftypes.AttributePath(ctx, path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rawType, remaining, err := tftypes.WalkAttributePath(s, tftypesPath)
 	if err != nil {
 		return nil, fmt.Errorf("%v still remains in the path: %w", remaining, err)
 	}
@@ -131,6 +139,31 @@ func (s Schema) AttributeTypeAtPath(path *tftypes.AttributePath) (attr.Type, err
 	}
 }
 
+// dynamicAncestorPath returns the path of the outermost attribute containing
+// `p` whose type is tftypes.DynamicPseudoType, and whether one was found.
+// The path itself is not considered, only its parents.
+func (s Schema) dynamicAncestorPath(ctx context.Context, p path.Path) (path.Path, bool) {
+	var ancestorPaths []path.Path
+
+	for ancestorPath := p.ParentPath(); len(ancestorPath.Steps()) > 0; ancestorPath = ancestorPath.ParentPath() {
+		ancestorPaths = append(ancestorPaths, ancestorPath)
+	}
+
+	for i := len(ancestorPaths) - 1; i >= 0; i-- {
+		ancestorType, err := s.AttributeTypeAtPath(ctx, ancestorPaths[i])
+
+		if err != nil {
+			return path.Empty(), false
+		}
+
+		if ancestorType.TerraformType(ctx).Is(tftypes.DynamicPseudoType) {
+			return ancestorPaths[i], true
+		}
+	}
+
+	return path.Empty(), false
+}
+
 // TerraformType returns a tftypes.Type that can represent the schema.
 func (s Schema) TerraformType(ctx context.Context) tftypes.Type {
 	attrTypes := map[string]tftypes.Type{}
@@ -146,8 +179,14 @@ func (s Schema) TerraformType(ctx context.Context) tftypes.Type {
 // AttributeAtPath returns the Attribute at the passed path. If the path points
 // to an element or attribute of a complex type, rather than to an Attribute,
 // it will return an ErrPathInsideAtomicAttribute error.
-func (s Schema) AttributeAtPath(path *tftypes.AttributePath) (Attribute, error) {
-	res, remaining, err := tftypes.WalkAttributePath(s, path)
+func (s Schema) AttributeAtPath(ctx context.Context, path path.Path) (Attribute, error) {
+	tftypesPath, err := totftypes.AttributePath(ctx, path)
+
+	if err != nil {
+		return Attribute{}, err
+	}
+
+	res, remaining, err := tftypes.WalkAttributePath(s, tftypesPath)
 	if err != nil {
 		return Attribute{}, fmt.Errorf("%v still remains in the path: %w", remaining, err)
 	}
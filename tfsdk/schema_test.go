@@ -1,10 +1,12 @@
 package tfsdk
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -14,19 +16,19 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 
 	testCases := map[string]struct {
 		schema      Schema
-		path        *tftypes.AttributePath
+		path        path.Path
 		expected    Attribute
 		expectedErr string
 	}{
 		"empty-root": {
 			schema:      Schema{},
-			path:        tftypes.NewAttributePath(),
+			path:        path.Empty(),
 			expected:    Attribute{},
 			expectedErr: "got unexpected type tfsdk.Schema",
 		},
 		"empty-nil": {
 			schema:      Schema{},
-			path:        nil,
+			path:        path.Path{},
 			expected:    Attribute{},
 			expectedErr: "got unexpected type tfsdk.Schema",
 		},
@@ -39,7 +41,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath(),
+			path:        path.Empty(),
 			expected:    Attribute{},
 			expectedErr: "got unexpected type tfsdk.Schema",
 		},
@@ -52,7 +54,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        nil,
+			path:        path.Path{},
 			expected:    Attribute{},
 			expectedErr: "got unexpected type tfsdk.Schema",
 		},
@@ -69,7 +71,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			expected: Attribute{
 				Type:     types.StringType,
 				Required: true,
@@ -97,7 +99,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:        path.Root("test").AtName("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "AttributeName(\"sub_test\") still remains in the path: can't apply tftypes.AttributeName to ListNestedAttributes",
 		},
@@ -123,7 +125,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:        path.Root("test").AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -149,7 +151,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path: path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: Attribute{
 				Type:     types.StringType,
 				Required: true,
@@ -177,7 +179,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:        path.Root("test").AtMapKey("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyString(\"sub_test\") still remains in the path: can't apply tftypes.ElementKeyString to ListNestedAttributes",
 		},
@@ -203,7 +205,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "sub_test")),
+			path:        path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "sub_test")),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyValue(tftypes.String<\"sub_test\">) still remains in the path: can't apply tftypes.ElementKeyValue to ListNestedAttributes",
 		},
@@ -240,7 +242,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:        path.Root("test").AtName("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "AttributeName(\"sub_test\") still remains in the path: can't apply tftypes.AttributeName to block NestingModeList",
 		},
@@ -277,7 +279,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:        path.Root("test").AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -314,7 +316,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path: path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: Attribute{
 				Type:     types.StringType,
 				Required: true,
@@ -353,7 +355,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:        path.Root("test").AtMapKey("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyString(\"sub_test\") still remains in the path: can't apply tftypes.ElementKeyString to block NestingModeList",
 		},
@@ -390,7 +392,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "sub_test")),
+			path:        path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "sub_test")),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyValue(tftypes.String<\"sub_test\">) still remains in the path: can't apply tftypes.ElementKeyValue to block NestingModeList",
 		},
@@ -416,7 +418,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:        path.Root("test").AtName("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "AttributeName(\"sub_test\") still remains in the path: can't use tftypes.AttributeName on maps",
 		},
@@ -442,7 +444,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:        path.Root("test").AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyInt(0) still remains in the path: can't use tftypes.ElementKeyInt on maps",
 		},
@@ -468,7 +470,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:        path.Root("test").AtMapKey("sub_test"),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -494,7 +496,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element").WithAttributeName("sub_test"),
+			path: path.Root("test").AtMapKey("element").AtName("sub_test"),
 			expected: Attribute{
 				Type:     types.StringType,
 				Required: true,
@@ -522,7 +524,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "sub_test")),
+			path:        path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "sub_test")),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyValue(tftypes.String<\"sub_test\">) still remains in the path: can't use tftypes.ElementKeyValue on maps",
 		},
@@ -548,7 +550,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:        path.Root("test").AtName("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "AttributeName(\"sub_test\") still remains in the path: can't use tftypes.AttributeName on sets",
 		},
@@ -574,7 +576,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:        path.Root("test").AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyInt(0) still remains in the path: can't use tftypes.ElementKeyInt on sets",
 		},
@@ -600,7 +602,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:        path.Root("test").AtMapKey("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyString(\"sub_test\") still remains in the path: can't use tftypes.ElementKeyString on sets",
 		},
@@ -626,7 +628,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "sub_test")),
+			path:        path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "sub_test")),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -652,7 +654,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "element")).WithAttributeName("sub_test"),
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "element")).AtName("sub_test"),
 			expected: Attribute{
 				Type:     types.StringType,
 				Required: true,
@@ -691,7 +693,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:        path.Root("test").AtName("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "AttributeName(\"sub_test\") still remains in the path: can't apply tftypes.AttributeName to block NestingModeSet",
 		},
@@ -728,7 +730,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:        path.Root("test").AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyInt(0) still remains in the path: can't apply tftypes.ElementKeyInt to block NestingModeSet",
 		},
@@ -765,7 +767,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:        path.Root("test").AtMapKey("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyString(\"sub_test\") still remains in the path: can't apply tftypes.ElementKeyString to block NestingModeSet",
 		},
@@ -802,7 +804,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "sub_test")),
+			path:        path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "sub_test")),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -839,12 +841,60 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "element")).WithAttributeName("sub_test"),
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "element")).AtName("sub_test"),
 			expected: Attribute{
 				Type:     types.StringType,
 				Required: true,
 			},
 		},
+		"WithAttributeName-SingleNestedBlocks-WithAttributeName": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"other_attr": {
+						Type:     types.BoolType,
+						Optional: true,
+					},
+				},
+				Blocks: map[string]Block{
+					"test": {
+						Attributes: map[string]Attribute{
+							"other_attr": {
+								Type:     types.BoolType,
+								Optional: true,
+							},
+							"sub_test": {
+								Type:     types.StringType,
+								Required: true,
+							},
+						},
+						NestingMode: BlockNestingModeSingle,
+					},
+				},
+			},
+			path: path.Root("test").AtName("sub_test"),
+			expected: Attribute{
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+		"WithAttributeName-SingleNestedBlocks-WithElementKeyInt": {
+			schema: Schema{
+				Blocks: map[string]Block{
+					"test": {
+						Attributes: map[string]Attribute{
+							"sub_test": {
+								Type:     types.StringType,
+								Required: true,
+							},
+						},
+						NestingMode: BlockNestingModeSingle,
+					},
+				},
+			},
+			path:        path.Root("test").AtListIndex(0),
+			expected:    Attribute{},
+			expectedErr: "ElementKeyInt(0) still remains in the path: can't apply tftypes.ElementKeyInt to block NestingModeSingle",
+		},
 		"WithAttributeName-SingleNestedAttributes-WithAttributeName": {
 			schema: Schema{
 				Attributes: map[string]Attribute{
@@ -867,7 +917,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path: path.Root("test").AtName("sub_test"),
 			expected: Attribute{
 				Type:     types.StringType,
 				Required: true,
@@ -895,7 +945,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:        path.Root("test").AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyInt(0) still remains in the path: can't apply tftypes.ElementKeyInt to Attributes",
 		},
@@ -921,7 +971,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:        path.Root("test").AtMapKey("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyString(\"sub_test\") still remains in the path: can't apply tftypes.ElementKeyString to Attributes",
 		},
@@ -947,7 +997,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "sub_test")),
+			path:        path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "sub_test")),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyValue(tftypes.String<\"sub_test\">) still remains in the path: can't apply tftypes.ElementKeyValue to Attributes",
 		},
@@ -968,7 +1018,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:        path.Root("test").AtName("sub_test"),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -985,7 +1035,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:        path.Root("test").AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyInt(0) still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyInt to types.StringType",
 		},
@@ -1004,7 +1054,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:        path.Root("test").AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -1021,7 +1071,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element"),
+			path:        path.Root("test").AtMapKey("element"),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyString(\"element\") still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyString to types.StringType",
 		},
@@ -1040,7 +1090,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element"),
+			path:        path.Root("test").AtMapKey("element"),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -1057,7 +1107,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "element")),
+			path:        path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "element")),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyValue(tftypes.String<\"element\">) still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyValue to types.StringType",
 		},
@@ -1076,7 +1126,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "element")),
+			path:        path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "element")),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -1089,7 +1139,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithElementKeyInt(0),
+			path:        path.Empty().AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyInt(0) still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyInt to schema",
 		},
@@ -1102,7 +1152,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithElementKeyString("test"),
+			path:        path.Empty().AtMapKey("test"),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyString(\"test\") still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyString to schema",
 		},
@@ -1115,7 +1165,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithElementKeyValue(tftypes.NewValue(tftypes.String, "test")),
+			path:        path.Empty().AtSetValue(tftypes.NewValue(tftypes.String, "test")),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyValue(tftypes.String<\"test\">) still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyValue to schema",
 		},
@@ -1127,7 +1177,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := tc.schema.AttributeAtPath(tc.path)
+			got, err := tc.schema.AttributeAtPath(context.Background(), tc.path)
 
 			if err != nil {
 				if tc.expectedErr == "" {
@@ -1238,6 +1288,26 @@ func TestSchemaAttributeType(t *testing.T) {
 				},
 				NestingMode: BlockNestingModeSet,
 			},
+			"single_nested_blocks": {
+				Attributes: map[string]Attribute{
+					"string": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				},
+				Blocks: map[string]Block{
+					"nested": {
+						Attributes: map[string]Attribute{
+							"bool": {
+								Type:     types.BoolType,
+								Optional: true,
+							},
+						},
+						NestingMode: BlockNestingModeSingle,
+					},
+				},
+				NestingMode: BlockNestingModeSingle,
+			},
 		},
 	}
 
@@ -1281,6 +1351,16 @@ func TestSchemaAttributeType(t *testing.T) {
 					},
 				},
 			},
+			"single_nested_blocks": types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"string": types.StringType,
+					"nested": types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"bool": types.BoolType,
+						},
+					},
+				},
+			},
 		},
 	}
 
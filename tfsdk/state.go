@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -25,7 +27,7 @@ func (s State) Get(ctx context.Context, target interface{}) diag.Diagnostics {
 
 // GetAttribute retrieves the attribute found at `path` and populates the
 // `target` with the value.
-func (s State) GetAttribute(ctx context.Context, path *tftypes.AttributePath, target interface{}) diag.Diagnostics {
+func (s State) GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics {
 	ctx = logging.FrameworkWithAttributePath(ctx, path.String())
 
 	attrValue, diags := s.getAttributeValue(ctx, path)
@@ -56,13 +58,22 @@ func (s State) GetAttribute(ctx context.Context, path *tftypes.AttributePath, ta
 	return diags
 }
 
+// PathMatches returns all matching path.Paths from the given path.Expression.
+//
+// If a parent path is null or unknown, which would prevent a full expression
+// from matching, the parent path is returned rather than no match to prevent
+// false positives.
+func (s State) PathMatches(ctx context.Context, pathExpr path.Expression) (path.Paths, diag.Diagnostics) {
+	return schemaDataPathMatches(ctx, s.Schema, s.Raw, pathExpr)
+}
+
 // getAttributeValue retrieves the attribute found at `path` and returns it as an
 // attr.Value. Consumers should assert the type of the returned value with the
 // desired attr.Type.
-func (s State) getAttributeValue(ctx context.Context, path *tftypes.AttributePath) (attr.Value, diag.Diagnostics) {
+func (s State) getAttributeValue(ctx context.Context, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	attrType, err := s.Schema.AttributeTypeAtPath(path)
+	attrType, err := s.Schema.AttributeTypeAtPath(ctx, path)
 	if err != nil {
 		err = fmt.Errorf("error getting attribute type in schema: %w", err)
 		diags.AddAttributeError(
@@ -78,7 +89,7 @@ func (s State) getAttributeValue(ctx context.Context, path *tftypes.AttributePat
 		return nil, nil
 	}
 
-	tfValue, err := s.terraformValueAtPath(path)
+	tfValue, err := s.terraformValueAtPath(ctx, path)
 
 	// Ignoring ErrInvalidStep will allow this method to return a null value of the type.
 	if err != nil && !errors.Is(err, tftypes.ErrInvalidStep) {
@@ -132,7 +143,7 @@ func (s *State) Set(ctx context.Context, val interface{}) diag.Diagnostics {
 			),
 		}
 	}
-	newStateAttrValue, diags := reflect.FromValue(ctx, s.Schema.AttributeType(), val, tftypes.NewAttributePath())
+	newStateAttrValue, diags := reflect.FromValue(ctx, s.Schema.AttributeType(), val, path.Empty())
 	if diags.HasError() {
 		return diags
 	}
@@ -159,12 +170,12 @@ func (s *State) Set(ctx context.Context, val interface{}) diag.Diagnostics {
 // paths as necessary.
 //
 // Lists can only have the next element added according to the current length.
-func (s *State) SetAttribute(ctx context.Context, path *tftypes.AttributePath, val interface{}) diag.Diagnostics {
+func (s *State) SetAttribute(ctx context.Context, path path.Path, val interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	ctx = logging.FrameworkWithAttributePath(ctx, path.String())
 
-	attrType, err := s.Schema.AttributeTypeAtPath(path)
+	attrType, err := s.Schema.AttributeTypeAtPath(ctx, path)
 	if err != nil {
 		err = fmt.Errorf("error getting attribute type in schema: %w", err)
 		diags.AddAttributeError(
@@ -204,6 +215,31 @@ func (s *State) SetAttribute(ctx context.Context, path *tftypes.AttributePath, v
 		}
 	}
 
+	// Values inside a dynamic attribute have no type information in the
+	// schema, so the entire dynamic attribute value is rebuilt instead.
+	if dynamicPath, ok := s.Schema.dynamicAncestorPath(ctx, path); ok {
+		dynamicValue, err := s.terraformValueAtPath(ctx, dynamicPath)
+
+		if err != nil && !errors.Is(err, tftypes.ErrInvalidStep) {
+			diags.AddAttributeError(
+				dynamicPath,
+				"State Read Error",
+				"An unexpected error was encountered trying to read an attribute from the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return diags
+		}
+
+		var dynamicDiags diag.Diagnostics
+		tfVal, dynamicDiags = upsertDynamicChildValue(ctx, dynamicPath, dynamicValue, path.Steps()[len(dynamicPath.Steps()):], tfVal)
+		diags.Append(dynamicDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		path = dynamicPath
+	}
+
 	transformFunc, transformFuncDiags := s.setAttributeTransformFunc(ctx, path, tfVal, nil)
 	diags.Append(transformFuncDiags...)
 
@@ -227,10 +263,21 @@ func (s *State) SetAttribute(ctx context.Context, path *tftypes.AttributePath, v
 
 // pathExists walks the current state and returns true if the path can be reached.
 // The value at the path may be null or unknown.
-func (s State) pathExists(_ context.Context, path *tftypes.AttributePath) (bool, diag.Diagnostics) {
+func (s State) pathExists(ctx context.Context, path path.Path) (bool, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	_, remaining, err := tftypes.WalkAttributePath(s.Raw, path)
+	tftypesPath, err := totftypes.AttributePath(ctx, path)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"State Read Error",
+			"An unexpected error was encountered trying to read an attribute from the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return false, diags
+	}
+
+	_, remaining, err := tftypes.WalkAttributePath(s.Raw, tftypesPath)
 
 	if err != nil {
 		if errors.Is(err, tftypes.ErrInvalidStep) {
@@ -253,7 +300,7 @@ func (s State) pathExists(_ context.Context, path *tftypes.AttributePath) (bool,
 // Plan values along the path. If the value at the path does not yet exist,
 // this will perform recursion to add the child value to a parent value,
 // creating the parent value if necessary.
-func (s State) setAttributeTransformFunc(ctx context.Context, path *tftypes.AttributePath, tfVal tftypes.Value, diags diag.Diagnostics) (func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error), diag.Diagnostics) {
+func (s State) setAttributeTransformFunc(ctx context.Context, path path.Path, tfVal tftypes.Value, diags diag.Diagnostics) (func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error), diag.Diagnostics) {
 	exists, pathExistsDiags := s.pathExists(ctx, path)
 	diags.Append(pathExistsDiags...)
 
@@ -262,17 +309,28 @@ func (s State) setAttributeTransformFunc(ctx context.Context, path *tftypes.Attr
 	}
 
 	if exists {
+		tftypesPath, err := totftypes.AttributePath(ctx, path)
+
+		if err != nil {
+			diags.AddAttributeError(
+				path,
+				"State Write Error",
+				"An unexpected error was encountered trying to write an attribute to the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return nil, diags
+		}
+
 		// Overwrite existing value
 		return func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
-			if p.Equal(path) {
+			if p.Equal(tftypesPath) {
 				return tfVal, nil
 			}
 			return v, nil
 		}, diags
 	}
 
-	parentPath := path.WithoutLastStep()
-	parentAttrType, err := s.Schema.AttributeTypeAtPath(parentPath)
+	parentPath := path.ParentPath()
+	parentAttrType, err := s.Schema.AttributeTypeAtPath(ctx, parentPath)
 
 	if err != nil {
 		err = fmt.Errorf("error getting parent attribute type in schema: %w", err)
@@ -284,7 +342,7 @@ func (s State) setAttributeTransformFunc(ctx context.Context, path *tftypes.Attr
 		return nil, diags
 	}
 
-	parentValue, err := s.terraformValueAtPath(parentPath)
+	parentValue, err := s.terraformValueAtPath(ctx, parentPath)
 
 	if err != nil && !errors.Is(err, tftypes.ErrInvalidStep) {
 		diags.AddAttributeError(
@@ -296,8 +354,9 @@ func (s State) setAttributeTransformFunc(ctx context.Context, path *tftypes.Attr
 	}
 
 	if parentValue.IsNull() || !parentValue.IsKnown() {
-		// TODO: This will break when DynamicPsuedoType is introduced.
-		// tftypes.Type should implement AttributePathStepper, but it currently does not.
+		// Paths inside DynamicPseudoType values are handled separately by
+		// SetAttribute, so the parent type always comes from the schema.
+		// TODO: tftypes.Type should implement AttributePathStepper, but it currently does not.
 		// When it does, we should use: tftypes.WalkAttributePath(s.Raw.Type(), parentPath)
 		// Reference: https://github.com/hashicorp/terraform-plugin-go/issues/110
 		parentType := parentAttrType.TerraformType(ctx)
@@ -317,7 +376,7 @@ func (s State) setAttributeTransformFunc(ctx context.Context, path *tftypes.Attr
 	}
 
 	var childValueDiags diag.Diagnostics
-	childStep := path.LastStep()
+	childStep, _ := path.Steps().LastStep()
 	parentValue, childValueDiags = upsertChildValue(ctx, parentPath, parentValue, childStep, tfVal)
 	diags.Append(childValueDiags...)
 
@@ -347,8 +406,14 @@ func (s *State) RemoveResource(ctx context.Context) {
 	s.Raw = tftypes.NewValue(s.Schema.TerraformType(ctx), nil)
 }
 
-func (s State) terraformValueAtPath(path *tftypes.AttributePath) (tftypes.Value, error) {
-	rawValue, remaining, err := tftypes.WalkAttributePath(s.Raw, path)
+func (s State) terraformValueAtPath(ctx context.Context, path path.Path) (tftypes.Value, error) {
+	tftypesPath, err := totftypes.AttributePath(ctx, path)
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	rawValue, remaining, err := tftypes.WalkAttributePath(s.Raw, tftypesPath)
 	if err != nil {
 		return tftypes.Value{}, fmt.Errorf("%v still remains in the path: %w", remaining, err)
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -323,6 +324,123 @@ func TestStateGet(t *testing.T) {
 	}
 }
 
+func TestStateGet_singleBlock(t *testing.T) {
+	t.Parallel()
+
+	type testBlockData struct {
+		ID string `tfsdk:"id"`
+	}
+
+	type testStateGetData struct {
+		Struct  testBlockData  `tfsdk:"struct"`
+		Pointer *testBlockData `tfsdk:"pointer"`
+	}
+
+	blockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+
+	schema := Schema{
+		Blocks: map[string]Block{
+			"struct": {
+				Attributes: map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				NestingMode: BlockNestingModeSingle,
+			},
+			"pointer": {
+				Attributes: map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				NestingMode: BlockNestingModeSingle,
+			},
+		},
+	}
+
+	type testCase struct {
+		state         State
+		expected      testStateGetData
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		"known": {
+			state: State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"struct":  blockType,
+						"pointer": blockType,
+					},
+				}, map[string]tftypes.Value{
+					"struct": tftypes.NewValue(blockType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "one"),
+					}),
+					"pointer": tftypes.NewValue(blockType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "two"),
+					}),
+				}),
+				Schema: schema,
+			},
+			expected: testStateGetData{
+				Struct: testBlockData{
+					ID: "one",
+				},
+				Pointer: &testBlockData{
+					ID: "two",
+				},
+			},
+		},
+		"null-pointer": {
+			state: State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"struct":  blockType,
+						"pointer": blockType,
+					},
+				}, map[string]tftypes.Value{
+					"struct": tftypes.NewValue(blockType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "one"),
+					}),
+					"pointer": tftypes.NewValue(blockType, nil),
+				}),
+				Schema: schema,
+			},
+			expected: testStateGetData{
+				Struct: testBlockData{
+					ID: "one",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var val testStateGetData
+
+			diags := tc.state.Get(context.Background(), &val)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(val, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestStateGet_testTypes(t *testing.T) {
 	t.Parallel()
 
@@ -570,7 +688,7 @@ func TestStateGet_testTypes(t *testing.T) {
 					Interface: "",
 				},
 			},
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			state: State{
@@ -825,7 +943,7 @@ func TestStateGet_testTypes(t *testing.T) {
 					Interface: "SCSI",
 				},
 			},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
 
@@ -863,6 +981,110 @@ func TestStateGetAttribute(t *testing.T) {
 	}
 
 	testCases := map[string]testCase{
+		"dynamic-string": {
+			state: State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.DynamicPseudoType,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "namevalue"),
+				}),
+				Schema: Schema{
+					Attributes: map[string]Attribute{
+						"name": {
+							Type:     types.DynamicType{},
+							Required: true,
+						},
+					},
+				},
+			},
+			target:   new(string),
+			expected: newStringPointer("namevalue"),
+		},
+		"dynamic-types.Dynamic": {
+			state: State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.DynamicPseudoType,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "namevalue"),
+				}),
+				Schema: Schema{
+					Attributes: map[string]Attribute{
+						"name": {
+							Type:     types.DynamicType{},
+							Required: true,
+						},
+					},
+				},
+			},
+			target: new(types.Dynamic),
+			expected: &types.Dynamic{
+				Value: types.String{Value: "namevalue"},
+			},
+		},
+		"dynamic-types.Dynamic-null": {
+			state: State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.DynamicPseudoType,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+				}),
+				Schema: Schema{
+					Attributes: map[string]Attribute{
+						"name": {
+							Type:     types.DynamicType{},
+							Required: true,
+						},
+					},
+				},
+			},
+			target: new(types.Dynamic),
+			expected: &types.Dynamic{
+				Null: true,
+			},
+		},
+		"dynamic-interface": {
+			state: State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.DynamicPseudoType,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"enabled": tftypes.Bool,
+							"tags":    tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}},
+						},
+					}, map[string]tftypes.Value{
+						"enabled": tftypes.NewValue(tftypes.Bool, true),
+						"tags": tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}}, []tftypes.Value{
+							tftypes.NewValue(tftypes.String, "a"),
+						}),
+					}),
+				}),
+				Schema: Schema{
+					Attributes: map[string]Attribute{
+						"name": {
+							Type:     types.DynamicType{},
+							Required: true,
+						},
+					},
+				},
+			},
+			target: new(interface{}),
+			expected: func() *interface{} {
+				var v interface{} = map[string]interface{}{
+					"enabled": true,
+					"tags":    []interface{}{"a"},
+				}
+				return &v
+			}(),
+		},
 		"string": {
 			state: State{
 				Raw: tftypes.NewValue(tftypes.Object{
@@ -948,7 +1170,7 @@ func TestStateGetAttribute(t *testing.T) {
 			expected: new(testtypes.String),
 			expectedDiags: diag.Diagnostics{
 				diag.WithPath(
-					tftypes.NewAttributePath().WithAttributeName("name"),
+					path.Root("name"),
 					intreflect.DiagNewAttributeValueIntoWrongType{
 						ValType:    reflect.TypeOf(types.String{Value: "namevalue"}),
 						TargetType: reflect.TypeOf(testtypes.String{}),
@@ -979,7 +1201,7 @@ func TestStateGetAttribute(t *testing.T) {
 			expected: new(bool),
 			expectedDiags: diag.Diagnostics{
 				diag.WithPath(
-					tftypes.NewAttributePath().WithAttributeName("name"),
+					path.Root("name"),
 					intreflect.DiagIntoIncompatibleType{
 						Val:        tftypes.NewValue(tftypes.String, "namevalue"),
 						TargetType: reflect.TypeOf(false),
@@ -1008,7 +1230,7 @@ func TestStateGetAttribute(t *testing.T) {
 			},
 			target:        new(testtypes.String),
 			expected:      new(testtypes.String),
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			state: State{
@@ -1030,7 +1252,7 @@ func TestStateGetAttribute(t *testing.T) {
 			},
 			target:        new(testtypes.String),
 			expected:      &testtypes.String{String: types.String{Value: "namevalue"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
 
@@ -1039,13 +1261,13 @@ func TestStateGetAttribute(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			diags := tc.state.GetAttribute(context.Background(), tftypes.NewAttributePath().WithAttributeName("name"), tc.target)
+			diags := tc.state.GetAttribute(context.Background(), path.Root("name"), tc.target)
 
 			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
 				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
 			}
 
-			if diff := cmp.Diff(tc.target, tc.expected, cmp.Transformer("testtypes", func(in *testtypes.String) testtypes.String { return *in }), cmp.Transformer("types", func(in *types.String) types.String { return *in })); diff != "" {
+			if diff := cmp.Diff(tc.target, tc.expected, cmp.Transformer("testtypes", func(in *testtypes.String) testtypes.String { return *in }), cmp.Transformer("types", func(in *types.String) types.String { return *in }), cmp.Transformer("dynamic", func(in *types.Dynamic) types.Dynamic { return *in })); diff != "" {
 				t.Errorf("unexpected value (+wanted, -got): %s", diff)
 			}
 		})
@@ -1057,7 +1279,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 
 	type testCase struct {
 		state         State
-		path          *tftypes.AttributePath
+		path          path.Path
 		expected      attr.Value
 		expectedDiags diag.Diagnostics
 	}
@@ -1084,7 +1306,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: nil,
 		},
 		"WithAttributeName-nonexistent": {
@@ -1105,11 +1327,11 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("other"),
+			path:     path.Root("other"),
 			expected: nil,
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("other"),
+					path.Root("other"),
 					"State Read Error",
 					"An unexpected error was encountered trying to read an attribute from the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"error getting attribute type in schema: AttributeName(\"other\") still remains in the path: could not find attribute or block \"other\" in schema",
@@ -1146,7 +1368,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-List-WithElementKeyInt": {
@@ -1182,7 +1404,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
@@ -1226,7 +1448,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName-Object": {
@@ -1283,7 +1505,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path: path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.Object{
 				Null:      true,
 				AttrTypes: map[string]attr.Type{"value": types.StringType},
@@ -1338,7 +1560,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-ListNestedBlocks-null-WithElementKeyInt-WithAttributeName": {
@@ -1407,7 +1629,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-ListNestedBlocks-WithElementKeyInt-WithAttributeName": {
@@ -1484,7 +1706,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Map-null-WithElementKeyString": {
@@ -1517,7 +1739,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:     path.Root("test").AtMapKey("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-Map-WithElementKeyString": {
@@ -1553,7 +1775,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:     path.Root("test").AtMapKey("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Map-WithElementKeyString-nonexistent": {
@@ -1588,7 +1810,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("other"),
+			path:     path.Root("test").AtMapKey("other"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-MapNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
@@ -1632,7 +1854,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtMapKey("element").AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-MapNestedAttributes-WithElementKeyString-WithAttributeName": {
@@ -1684,7 +1906,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtMapKey("element").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Object-WithAttributeName": {
@@ -1725,7 +1947,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Set-null-WithElementKeyValue": {
@@ -1758,7 +1980,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-Set-WithElementKeyValue": {
@@ -1794,7 +2016,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SetNestedAttributes-null-WithElementKeyValue-WithAttributeName": {
@@ -1838,13 +2060,13 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SetNestedAttributes-WithElementKeyValue-WithAttributeName": {
@@ -1896,13 +2118,13 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SetNestedBlocks-null-WithElementKeyValue-WithAttributeName": {
@@ -1971,13 +2193,13 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SetNestedBlocks-WithElementKeyValue-WithAttributeName": {
@@ -2054,13 +2276,13 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-Float64": {
@@ -2100,7 +2322,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.Float64{Null: true},
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-Int64": {
@@ -2140,7 +2362,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.Int64{Null: true},
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-Set": {
@@ -2186,7 +2408,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.Set{ElemType: types.StringType, Null: true},
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-String": {
@@ -2226,7 +2448,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SingleNestedAttributes-WithAttributeName": {
@@ -2268,7 +2490,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-String-null": {
@@ -2295,7 +2517,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-String-unknown": {
@@ -2322,7 +2544,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Unknown: true},
 		},
 		"WithAttributeName-String-value": {
@@ -2349,7 +2571,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Value: "value"},
 		},
 		"AttrTypeWithValidateError": {
@@ -2376,9 +2598,9 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:          tftypes.NewAttributePath().WithAttributeName("test"),
+			path:          path.Root("test"),
 			expected:      nil,
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("test"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			state: State{
@@ -2404,9 +2626,9 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:          tftypes.NewAttributePath().WithAttributeName("test"),
+			path:          path.Root("test"),
 			expected:      testtypes.String{String: types.String{Value: "value"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("test"))},
 		},
 	}
 
@@ -2432,7 +2654,7 @@ func TestStatePathExists(t *testing.T) {
 
 	type testCase struct {
 		state         State
-		path          *tftypes.AttributePath
+		path          path.Path
 		expected      bool
 		expectedDiags diag.Diagnostics
 	}
@@ -2440,12 +2662,12 @@ func TestStatePathExists(t *testing.T) {
 	testCases := map[string]testCase{
 		"empty-path": {
 			state:    State{},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: false,
 		},
 		"empty-root": {
 			state:    State{},
-			path:     tftypes.NewAttributePath(),
+			path:     path.Empty(),
 			expected: true,
 		},
 		"root": {
@@ -2466,7 +2688,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath(),
+			path:     path.Empty(),
 			expected: true,
 		},
 		"WithAttributeName": {
@@ -2487,7 +2709,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: true,
 		},
 		"WithAttributeName-mismatch": {
@@ -2508,7 +2730,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("other"),
+			path:     path.Root("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithAttributeName": {
@@ -2543,7 +2765,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("nested"),
+			path:     path.Root("test").AtName("nested"),
 			expected: true,
 		},
 		"WithAttributeName.WithAttributeName-mismatch-child": {
@@ -2578,7 +2800,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("other"),
+			path:     path.Root("test").AtName("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithAttributeName-mismatch-parent": {
@@ -2599,7 +2821,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("other"),
+			path:     path.Root("test").AtName("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyInt": {
@@ -2628,7 +2850,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: true,
 		},
 		"WithAttributeName.WithElementKeyInt-mismatch-child": {
@@ -2657,7 +2879,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(1),
+			path:     path.Root("test").AtListIndex(1),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyInt-mismatch-parent": {
@@ -2678,7 +2900,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyString": {
@@ -2707,7 +2929,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path:     path.Root("test").AtMapKey("key"),
 			expected: true,
 		},
 		"WithAttributeName.WithElementKeyString-mismatch-child": {
@@ -2736,7 +2958,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("other"),
+			path:     path.Root("test").AtMapKey("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyString-mismatch-parent": {
@@ -2757,7 +2979,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("other"),
+			path:     path.Root("test").AtMapKey("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyValue": {
@@ -2786,7 +3008,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "testvalue")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "testvalue")),
 			expected: true,
 		},
 		"WithAttributeName.WithElementKeyValue-mismatch-child": {
@@ -2815,7 +3037,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "othervalue")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "othervalue")),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyValue-mismatch-parent": {
@@ -2836,7 +3058,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "othervalue")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "othervalue")),
 			expected: false,
 		},
 	}
@@ -3457,7 +3679,7 @@ func TestStateSet(t *testing.T) {
 				Name: "newvalue",
 			},
 			expected:      tftypes.Value{},
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			state: State{
@@ -3483,7 +3705,7 @@ func TestStateSet(t *testing.T) {
 			}, map[string]tftypes.Value{
 				"name": tftypes.NewValue(tftypes.String, "newvalue"),
 			}),
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
 
@@ -3510,13 +3732,180 @@ func TestStateSetAttribute(t *testing.T) {
 
 	type testCase struct {
 		state         State
-		path          *tftypes.AttributePath
+		path          path.Path
 		val           interface{}
 		expected      tftypes.Value
 		expectedDiags diag.Diagnostics
 	}
 
 	testCases := map[string]testCase{
+		"dynamic-set": {
+			state: State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.DynamicPseudoType,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "namevalue"),
+				}),
+				Schema: Schema{
+					Attributes: map[string]Attribute{
+						"name": {
+							Type:     types.DynamicType{},
+							Required: true,
+						},
+					},
+				},
+			},
+			path: path.Root("name"),
+			val:  map[string]interface{}{"enabled": false},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.DynamicPseudoType,
+				},
+			}, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"enabled": tftypes.Bool,
+					},
+				}, map[string]tftypes.Value{
+					"enabled": tftypes.NewValue(tftypes.Bool, false),
+				}),
+			}),
+		},
+		"dynamic-set-nested-new-attribute": {
+			state: State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.DynamicPseudoType,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"enabled": tftypes.Bool,
+							"tags":    tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}},
+						},
+					}, map[string]tftypes.Value{
+						"enabled": tftypes.NewValue(tftypes.Bool, true),
+						"tags": tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}}, []tftypes.Value{
+							tftypes.NewValue(tftypes.String, "a"),
+						}),
+					}),
+				}),
+				Schema: Schema{
+					Attributes: map[string]Attribute{
+						"name": {
+							Type:     types.DynamicType{},
+							Required: true,
+						},
+					},
+				},
+			},
+			path: path.Root("name").AtName("count"),
+			val:  int64(2),
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.DynamicPseudoType,
+				},
+			}, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"count":   tftypes.Number,
+						"enabled": tftypes.Bool,
+						"tags":    tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}},
+					},
+				}, map[string]tftypes.Value{
+					"count":   tftypes.NewValue(tftypes.Number, 2),
+					"enabled": tftypes.NewValue(tftypes.Bool, true),
+					"tags": tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "a"),
+					}),
+				}),
+			}),
+		},
+		"dynamic-set-nested-change-type": {
+			state: State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.DynamicPseudoType,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"enabled": tftypes.Bool,
+							"tags":    tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}},
+						},
+					}, map[string]tftypes.Value{
+						"enabled": tftypes.NewValue(tftypes.Bool, true),
+						"tags": tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}}, []tftypes.Value{
+							tftypes.NewValue(tftypes.String, "a"),
+						}),
+					}),
+				}),
+				Schema: Schema{
+					Attributes: map[string]Attribute{
+						"name": {
+							Type:     types.DynamicType{},
+							Required: true,
+						},
+					},
+				},
+			},
+			path: path.Root("name").AtName("tags").AtListIndex(1),
+			val:  true,
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.DynamicPseudoType,
+				},
+			}, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"enabled": tftypes.Bool,
+						"tags":    tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}},
+					},
+				}, map[string]tftypes.Value{
+					"enabled": tftypes.NewValue(tftypes.Bool, true),
+					"tags": tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "a"),
+						tftypes.NewValue(tftypes.Bool, true),
+					}),
+				}),
+			}),
+		},
+		"dynamic-set-nested-null-parent": {
+			state: State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.DynamicPseudoType,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+				}),
+				Schema: Schema{
+					Attributes: map[string]Attribute{
+						"name": {
+							Type:     types.DynamicType{},
+							Required: true,
+						},
+					},
+				},
+			},
+			path: path.Root("name").AtName("id"),
+			val:  "test",
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.DynamicPseudoType,
+				},
+			}, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, "test"),
+				}),
+			}),
+		},
 		"add-List-Element-append": {
 			state: State{
 				Raw: tftypes.NewValue(tftypes.Object{
@@ -3575,7 +3964,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1),
+			path: path.Root("disks").AtListIndex(1),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -3684,7 +4073,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(2),
+			path: path.Root("disks").AtListIndex(2),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -3727,7 +4116,7 @@ func TestStateSetAttribute(t *testing.T) {
 			}),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("disks"),
+					path.Root("disks"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"Cannot add list element 3 as list currently has 1 length. To prevent ambiguity, only the next element can be added to a list. Add empty elements into the list prior to this call, if appropriate.",
@@ -3782,7 +4171,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+			path: path.Root("disks").AtListIndex(0),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -3872,7 +4261,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1),
+			path: path.Root("disks").AtListIndex(1),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -3905,7 +4294,7 @@ func TestStateSetAttribute(t *testing.T) {
 			}),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("disks"),
+					path.Root("disks"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"Cannot add list element 2 as list currently has 0 length. To prevent ambiguity, only the next element can be added to a list. Add empty elements into the list prior to this call, if appropriate.",
@@ -3944,7 +4333,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key2"),
+			path: path.Root("test").AtMapKey("key2"),
 			val:  "key2value",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3993,7 +4382,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "keyvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4069,7 +4458,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -4176,7 +4565,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -4250,7 +4639,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  false,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4294,7 +4683,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags"),
+			path: path.Root("tags"),
 			val:  []string{"one", "two"},
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4378,7 +4767,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1),
+			path: path.Root("disks").AtListIndex(1),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -4462,7 +4851,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val: map[string]string{
 				"newkey": "newvalue",
 			},
@@ -4515,7 +4904,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "newvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4558,7 +4947,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  2,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4612,7 +5001,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("scratch_disk"),
+			path: path.Root("scratch_disk"),
 			val: struct {
 				Interface string `tfsdk:"interface"`
 				Other     string `tfsdk:"other"`
@@ -4681,7 +5070,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("scratch_disk").WithAttributeName("interface"),
+			path: path.Root("scratch_disk").AtName("interface"),
 			val:  "NVME",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4735,7 +5124,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags"),
+			path: path.Root("tags"),
 			val:  []string{"one", "two"},
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4819,7 +5208,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -4912,7 +5301,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyValue(tftypes.NewValue(tftypes.String, "three")),
+			path: path.Root("tags").AtSetValue(tftypes.NewValue(tftypes.String, "three")),
 			val:  "three",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4956,7 +5345,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  "newvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4984,7 +5373,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath(),
+			path: path.Empty(),
 			val:  false,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4993,7 +5382,7 @@ func TestStateSetAttribute(t *testing.T) {
 			}, nil),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath(),
+					path.Empty(),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\nexpected tftypes.Object[\"test\":tftypes.Bool], got tftypes.Bool",
 				),
@@ -5020,7 +5409,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  false,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5057,7 +5446,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags"),
+			path: path.Root("tags"),
 			val:  []string{"one", "two"},
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5102,7 +5491,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path: path.Root("test").AtListIndex(0),
 			val:  "testvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5120,7 +5509,7 @@ func TestStateSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test")),
+				testtypes.TestWarningDiagnostic(path.Root("test")),
 			},
 		},
 		"write-List-Element": {
@@ -5161,7 +5550,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+			path: path.Root("disks").AtListIndex(0),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -5241,7 +5630,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1),
+			path: path.Root("disks").AtListIndex(1),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -5264,7 +5653,7 @@ func TestStateSetAttribute(t *testing.T) {
 			}, nil),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("disks"),
+					path.Root("disks"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"Cannot add list element 2 as list currently has 0 length. To prevent ambiguity, only the next element can be added to a list. Add empty elements into the list prior to this call, if appropriate.",
@@ -5299,7 +5688,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+			path: path.Root("disks").AtListIndex(0),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -5341,7 +5730,7 @@ func TestStateSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0).WithAttributeName("id")),
+				testtypes.TestWarningDiagnostic(path.Root("disks").AtListIndex(0).AtName("id")),
 			},
 		},
 		"write-Map": {
@@ -5369,7 +5758,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val: map[string]string{
 				"newkey": "newvalue",
 			},
@@ -5416,7 +5805,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "keyvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5434,7 +5823,7 @@ func TestStateSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test")),
+				testtypes.TestWarningDiagnostic(path.Root("test")),
 			},
 		},
 		"write-Map-Element": {
@@ -5462,7 +5851,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "keyvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5505,7 +5894,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "keyvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5523,7 +5912,7 @@ func TestStateSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key")),
+				testtypes.TestWarningDiagnostic(path.Root("test").AtMapKey("key")),
 			},
 		},
 		"write-Number": {
@@ -5547,7 +5936,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  1,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5588,7 +5977,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("scratch_disk"),
+			path: path.Root("scratch_disk"),
 			val: struct {
 				Interface string `tfsdk:"interface"`
 			}{
@@ -5637,7 +6026,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags"),
+			path: path.Root("tags"),
 			val:  []string{"one", "two"},
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5692,7 +6081,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -5770,7 +6159,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "testvalue")),
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "testvalue")),
 			val:  "testvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5788,7 +6177,7 @@ func TestStateSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test")),
+				testtypes.TestWarningDiagnostic(path.Root("test")),
 			},
 		},
 		"write-Set-Element-AttrTypeWithValidateWarning": {
@@ -5829,7 +6218,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -5879,7 +6268,7 @@ func TestStateSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+				testtypes.TestWarningDiagnostic(path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
 						"id":                   tftypes.String,
 						"delete_with_instance": tftypes.Bool,
@@ -5887,7 +6276,7 @@ func TestStateSetAttribute(t *testing.T) {
 				}, map[string]tftypes.Value{
 					"id":                   tftypes.NewValue(tftypes.String, "mynewdisk"),
 					"delete_with_instance": tftypes.NewValue(tftypes.Bool, true),
-				})).WithAttributeName("id")),
+				})).AtName("id")),
 			},
 		},
 		"write-String": {
@@ -5911,7 +6300,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  "newvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5941,7 +6330,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("name"),
+			path: path.Root("name"),
 			val:  "newname",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5950,7 +6339,7 @@ func TestStateSetAttribute(t *testing.T) {
 			}, map[string]tftypes.Value{
 				"name": tftypes.NewValue(tftypes.String, "originalname"),
 			}),
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			state: State{
@@ -5970,7 +6359,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("name"),
+			path: path.Root("name"),
 			val:  "newname",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5980,7 +6369,7 @@ func TestStateSetAttribute(t *testing.T) {
 				"name": tftypes.NewValue(tftypes.String, "newname"),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name")),
+				testtypes.TestWarningDiagnostic(path.Root("name")),
 			},
 		},
 	}
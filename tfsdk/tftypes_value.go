@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -13,7 +14,7 @@ import (
 // without modification. A null Object or Tuple is converted to known with null
 // children. An unknown Object or Tuple is converted to known with unknown
 // children. List, Map, and Set are created with empty elements.
-func createParentValue(_ context.Context, parentPath *tftypes.AttributePath, parentType tftypes.Type, childValue interface{}) (tftypes.Value, diag.Diagnostics) {
+func createParentValue(_ context.Context, parentPath path.Path, parentType tftypes.Type, childValue interface{}) (tftypes.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var parentValue tftypes.Value
 
@@ -58,13 +59,13 @@ func createParentValue(_ context.Context, parentPath *tftypes.AttributePath, par
 // value will be added.
 //
 // Lists can only have the next element added according to the current length.
-func upsertChildValue(_ context.Context, parentPath *tftypes.AttributePath, parentValue tftypes.Value, childStep tftypes.AttributePathStep, childValue tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+func upsertChildValue(_ context.Context, parentPath path.Path, parentValue tftypes.Value, childStep path.PathStep, childValue tftypes.Value) (tftypes.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// TODO: Add Tuple support
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/54
 	switch childStep := childStep.(type) {
-	case tftypes.AttributeName:
+	case path.PathStepAttributeName:
 		// Set in Object
 		if !parentValue.Type().Is(tftypes.Object{}) {
 			diags.AddAttributeError(
@@ -91,7 +92,7 @@ func upsertChildValue(_ context.Context, parentPath *tftypes.AttributePath, pare
 
 		parentAttrs[string(childStep)] = childValue
 		parentValue = tftypes.NewValue(parentValue.Type(), parentAttrs)
-	case tftypes.ElementKeyInt:
+	case path.PathStepElementKeyInt:
 		// Upsert List element, except past length + 1
 		if !parentValue.Type().Is(tftypes.List{}) {
 			diags.AddAttributeError(
@@ -133,7 +134,7 @@ func upsertChildValue(_ context.Context, parentPath *tftypes.AttributePath, pare
 		}
 
 		parentValue = tftypes.NewValue(parentValue.Type(), parentElems)
-	case tftypes.ElementKeyString:
+	case path.PathStepElementKeyString:
 		// Upsert Map element
 		if !parentValue.Type().Is(tftypes.Map{}) {
 			diags.AddAttributeError(
@@ -160,7 +161,7 @@ func upsertChildValue(_ context.Context, parentPath *tftypes.AttributePath, pare
 
 		parentElems[string(childStep)] = childValue
 		parentValue = tftypes.NewValue(parentValue.Type(), parentElems)
-	case tftypes.ElementKeyValue:
+	case path.PathStepElementKeyValue:
 		// Upsert Set element
 		if !parentValue.Type().Is(tftypes.Set{}) {
 			diags.AddAttributeError(
@@ -204,3 +205,193 @@ func upsertChildValue(_ context.Context, parentPath *tftypes.AttributePath, pare
 
 	return parentValue, diags
 }
+
+// upsertDynamicChildValue will upsert a child value at the remaining
+// `childSteps` into the value of an attribute whose type is
+// tftypes.DynamicPseudoType. Since there is no schema type information for
+// values inside a dynamic attribute, the types of the parent values are
+// rebuilt from the values they contain. Missing, null, or unknown parent
+// values are created as objects for attribute names, tuples for element
+// indexes, and maps for element keys.
+func upsertDynamicChildValue(_ context.Context, dynamicPath path.Path, dynamicValue tftypes.Value, childSteps path.PathSteps, childValue tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, err := upsertDynamicValue(dynamicValue, childSteps, childValue)
+
+	if err != nil {
+		diags.AddAttributeError(
+			dynamicPath,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot set value inside dynamic value: %s", err),
+		)
+		return dynamicValue, diags
+	}
+
+	return newValue, diags
+}
+
+func upsertDynamicValue(parentValue tftypes.Value, steps path.PathSteps, childValue tftypes.Value) (tftypes.Value, error) {
+	if len(steps) == 0 {
+		return childValue, nil
+	}
+
+	// Treat missing, null, and unknown parents as empty, since there are
+	// no existing children to preserve.
+	parentExists := parentValue.Type() != nil && parentValue.IsKnown() && !parentValue.IsNull()
+
+	switch step := steps[0].(type) {
+	case path.PathStepAttributeName:
+		attrs := map[string]tftypes.Value{}
+
+		if parentExists {
+			if !parentValue.Type().Is(tftypes.Object{}) {
+				return parentValue, fmt.Errorf("cannot add attribute into parent type: %s", parentValue.Type())
+			}
+
+			if err := parentValue.Copy().As(&attrs); err != nil {
+				return parentValue, err
+			}
+		}
+
+		newChild, err := upsertDynamicValue(attrs[string(step)], steps[1:], childValue)
+
+		if err != nil {
+			return parentValue, err
+		}
+
+		attrs[string(step)] = newChild
+		attrTypes := make(map[string]tftypes.Type, len(attrs))
+
+		for name, attr := range attrs {
+			attrTypes[name] = attr.Type()
+		}
+
+		return newDynamicValue(tftypes.Object{AttributeTypes: attrTypes}, attrs)
+	case path.PathStepElementKeyInt:
+		var elems []tftypes.Value
+		isList := false
+
+		if parentExists {
+			switch {
+			case parentValue.Type().Is(tftypes.List{}):
+				isList = true
+			case parentValue.Type().Is(tftypes.Tuple{}):
+			default:
+				return parentValue, fmt.Errorf("cannot add element into parent type: %s", parentValue.Type())
+			}
+
+			if err := parentValue.Copy().As(&elems); err != nil {
+				return parentValue, err
+			}
+		}
+
+		if int(step) < 0 || int(step) > len(elems) {
+			return parentValue, fmt.Errorf("cannot add element %d as there are currently %d elements, only the next element can be added", int(step)+1, len(elems))
+		}
+
+		var existing tftypes.Value
+
+		if int(step) < len(elems) {
+			existing = elems[int(step)]
+		}
+
+		newChild, err := upsertDynamicValue(existing, steps[1:], childValue)
+
+		if err != nil {
+			return parentValue, err
+		}
+
+		if int(step) == len(elems) {
+			elems = append(elems, newChild)
+		} else {
+			elems[int(step)] = newChild
+		}
+
+		if isList {
+			elemType, err := commonElementType(elems)
+
+			if err != nil {
+				return parentValue, err
+			}
+
+			return newDynamicValue(tftypes.List{ElementType: elemType}, elems)
+		}
+
+		elemTypes := make([]tftypes.Type, 0, len(elems))
+
+		for _, elem := range elems {
+			elemTypes = append(elemTypes, elem.Type())
+		}
+
+		return newDynamicValue(tftypes.Tuple{ElementTypes: elemTypes}, elems)
+	case path.PathStepElementKeyString:
+		elems := map[string]tftypes.Value{}
+
+		if parentExists {
+			if !parentValue.Type().Is(tftypes.Map{}) {
+				return parentValue, fmt.Errorf("cannot add map value into parent type: %s", parentValue.Type())
+			}
+
+			if err := parentValue.Copy().As(&elems); err != nil {
+				return parentValue, err
+			}
+		}
+
+		newChild, err := upsertDynamicValue(elems[string(step)], steps[1:], childValue)
+
+		if err != nil {
+			return parentValue, err
+		}
+
+		elems[string(step)] = newChild
+		elemValues := make([]tftypes.Value, 0, len(elems))
+
+		for _, elem := range elems {
+			elemValues = append(elemValues, elem)
+		}
+
+		elemType, err := commonElementType(elemValues)
+
+		if err != nil {
+			return parentValue, err
+		}
+
+		return newDynamicValue(tftypes.Map{ElementType: elemType}, elems)
+	default:
+		return parentValue, fmt.Errorf("unsupported step %T", step)
+	}
+}
+
+// commonElementType returns the type shared by all `elems`, as required for
+// list and map values.
+func commonElementType(elems []tftypes.Value) (tftypes.Type, error) {
+	var elemType tftypes.Type
+
+	for _, elem := range elems {
+		if elemType == nil {
+			elemType = elem.Type()
+			continue
+		}
+
+		if !elem.Type().Equal(elemType) {
+			return nil, fmt.Errorf("all elements must have the same type, got %s and %s", elemType, elem.Type())
+		}
+	}
+
+	if elemType == nil {
+		return tftypes.DynamicPseudoType, nil
+	}
+
+	return elemType, nil
+}
+
+// newDynamicValue returns a new tftypes.Value, returning an error instead of
+// panicking if the value does not conform to the type.
+func newDynamicValue(typ tftypes.Type, val interface{}) (tftypes.Value, error) {
+	if err := tftypes.ValidateValue(typ, val); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	return tftypes.NewValue(typ, val), nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -50,7 +51,7 @@ func TestValueAs(t *testing.T) {
 			target: newInt64Pointer(0),
 			expectedDiags: diag.Diagnostics{
 				diag.WithPath(
-					tftypes.NewAttributePath(),
+					path.Empty(),
 					reflect.DiagIntoIncompatibleType{
 						Val:        tftypes.NewValue(tftypes.String, "hello"),
 						TargetType: goreflect.TypeOf(int64(0)),
@@ -64,7 +65,7 @@ func TestValueAs(t *testing.T) {
 			target: &testtypes.String{},
 			expectedDiags: diag.Diagnostics{
 				diag.WithPath(
-					tftypes.NewAttributePath(),
+					path.Empty(),
 					reflect.DiagNewAttributeValueIntoWrongType{
 						ValType:    goreflect.TypeOf(types.String{Value: "hello"}),
 						TargetType: goreflect.TypeOf(testtypes.String{}),
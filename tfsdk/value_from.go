@@ -0,0 +1,18 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ValueFrom returns the attr.Value of `typ` populated with the contents of
+// the Go value passed as `val`, using the reflection rules defined for `Set`
+// and `SetAttribute`. It is the inverse of ValueAs, and allows building values,
+// such as nested objects from API responses, without a State or Plan.
+func ValueFrom(ctx context.Context, typ attr.Type, val interface{}) (attr.Value, diag.Diagnostics) {
+	return reflect.FromValue(ctx, typ, val, path.Empty())
+}
@@ -0,0 +1,113 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValueFrom(t *testing.T) {
+	t.Parallel()
+
+	type person struct {
+		Name    string   `tfsdk:"name"`
+		Age     int64    `tfsdk:"age"`
+		Aliases []string `tfsdk:"aliases"`
+	}
+
+	personAttrTypes := map[string]attr.Type{
+		"name":    types.StringType,
+		"age":     types.Int64Type,
+		"aliases": types.ListType{ElemType: types.StringType},
+	}
+
+	type testCase struct {
+		typ           attr.Type
+		val           interface{}
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	tests := map[string]testCase{
+		"primitive": {
+			typ:      types.StringType,
+			val:      "hello",
+			expected: types.String{Value: "hello"},
+		},
+		"primitive-pointer-nil": {
+			typ:      types.StringType,
+			val:      (*string)(nil),
+			expected: types.String{Null: true},
+		},
+		"list": {
+			typ: types.ListType{ElemType: types.StringType},
+			val: []string{"a", "b"},
+			expected: types.List{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "a"},
+					types.String{Value: "b"},
+				},
+			},
+		},
+		"object": {
+			typ: types.ObjectType{AttrTypes: personAttrTypes},
+			val: person{
+				Name:    "Ada",
+				Age:     36,
+				Aliases: []string{"Countess of Lovelace"},
+			},
+			expected: types.Object{
+				AttrTypes: personAttrTypes,
+				Attrs: map[string]attr.Value{
+					"name": types.String{Value: "Ada"},
+					"age":  types.Int64{Value: 36},
+					"aliases": types.List{
+						ElemType: types.StringType,
+						Elems: []attr.Value{
+							types.String{Value: "Countess of Lovelace"},
+						},
+					},
+				},
+			},
+		},
+		"incompatible-type": {
+			typ: types.StringType,
+			val: true,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := ValueFrom(context.Background(), tc.typ, tc.val)
+
+			if diff := cmp.Diff(tc.expectedDiags, diags); diff != "" {
+				t.Fatalf("Unexpected diff in diagnostics (-wanted, +got): %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Fatalf("Unexpected diff in results (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
@@ -85,3 +85,18 @@ func (b Bool) IsNull() bool {
 func (b Bool) IsUnknown() bool {
 	return b.Unknown
 }
+
+// BoolNull returns a Bool with a null value.
+func BoolNull() Bool {
+	return Bool{Null: true}
+}
+
+// BoolUnknown returns a Bool with an unknown value.
+func BoolUnknown() Bool {
+	return Bool{Unknown: true}
+}
+
+// BoolValue returns a Bool with a known value.
+func BoolValue(value bool) Bool {
+	return Bool{Value: value}
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"context"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ attr.ValueWithSemanticEquals = CIDR{}
+)
+
+func parseCIDR(s string) (interface{}, error) {
+	ip, ipNet, err := net.ParseCIDR(s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cidr{ip: ip, ipNet: ipNet}, nil
+}
+
+// cidr holds both results of net.ParseCIDR, so the address given is also
+// compared, not only the network.
+type cidr struct {
+	ip    net.IP
+	ipNet *net.IPNet
+}
+
+func cidrEqual(a, b interface{}) bool {
+	aCIDR, bCIDR := a.(cidr), b.(cidr)
+
+	return aCIDR.ip.Equal(bCIDR.ip) && aCIDR.ipNet.String() == bCIDR.ipNet.String()
+}
+
+// CIDR represents a string containing an IPv4 or IPv6 network in CIDR
+// notation, such as "10.0.0.0/16". Networks which differ only in notation are
+// semantically equal. Values can be decoded into a net.IPNet, which contains
+// the network rather than the address given.
+type CIDR struct {
+	// Unknown will be true if the value is not yet known.
+	Unknown bool
+
+	// Null will be true if the value was not set, or was explicitly set to
+	// null.
+	Null bool
+
+	// Value contains the set value, as long as Unknown and Null are both
+	// false.
+	Value string
+}
+
+// Type returns a CIDRType.
+func (c CIDR) Type(_ context.Context) attr.Type {
+	return CIDRType
+}
+
+// ToTerraformValue returns the data contained in the CIDR as a
+// tftypes.Value.
+func (c CIDR) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	return formattedStringToTerraformValue(c.Unknown, c.Null, c.Value)
+}
+
+// Equal returns true if `other` is a CIDR and has the same value as `c`.
+func (c CIDR) Equal(other attr.Value) bool {
+	o, ok := other.(CIDR)
+	if !ok {
+		return false
+	}
+	if c.Unknown != o.Unknown {
+		return false
+	}
+	if c.Null != o.Null {
+		return false
+	}
+	return c.Value == o.Value
+}
+
+// SemanticEquals returns true if `prior` is a CIDR containing the same address
+// and network as `c`.
+func (c CIDR) SemanticEquals(_ context.Context, prior attr.Value) (bool, diag.Diagnostics) {
+	o, ok := prior.(CIDR)
+	if !ok {
+		return false, nil
+	}
+	return formattedStringSemanticEquals(c.Value, o.Value, parseCIDR, cidrEqual), nil
+}
+
+// IsNull returns true if the CIDR represents a null value.
+func (c CIDR) IsNull() bool {
+	return c.Null
+}
+
+// IsUnknown returns true if the CIDR represents an unknown value.
+func (c CIDR) IsUnknown() bool {
+	return c.Unknown
+}
+
+// CIDRNull returns a CIDR with a null value.
+func CIDRNull() CIDR {
+	return CIDR{Null: true}
+}
+
+// CIDRUnknown returns a CIDR with an unknown value.
+func CIDRUnknown() CIDR {
+	return CIDR{Unknown: true}
+}
+
+// CIDRValue returns a CIDR with a known value. An error diagnostic is
+// returned, along with an unknown value, if the value is not a valid
+// CIDR network.
+func CIDRValue(value string) (CIDR, diag.Diagnostics) {
+	diags := formattedStringValidateValue(CIDRType, value)
+
+	if diags.HasError() {
+		return CIDRUnknown(), diags
+	}
+
+	return CIDR{Value: value}, diags
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+func TestCIDRSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    CIDR
+		prior    attr.Value
+		expected bool
+	}{
+		"equal-ipv6-notation": {
+			value:    CIDR{Value: "2001:0db8:0000::/32"},
+			prior:    CIDR{Value: "2001:db8::/32"},
+			expected: true,
+		},
+		"different-prefix-length": {
+			value:    CIDR{Value: "192.0.2.0/24"},
+			prior:    CIDR{Value: "192.0.2.0/25"},
+			expected: false,
+		},
+		"different-address": {
+			value:    CIDR{Value: "192.0.2.1/24"},
+			prior:    CIDR{Value: "192.0.2.0/24"},
+			expected: false,
+		},
+		"invalid": {
+			value:    CIDR{Value: "192.0.2.0"},
+			prior:    CIDR{Value: "192.0.2.0/32"},
+			expected: false,
+		},
+		"different-type": {
+			value:    CIDR{Value: "2001:0db8:0000::/32"},
+			prior:    String{Value: "2001:0db8:0000::/32"},
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tc.value.SemanticEquals(context.Background(), tc.prior)
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			if got != tc.expected {
+				t.Errorf("Expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ attr.ValueWithSemanticEquals = Duration{}
+)
+
+func parseDuration(s string) (interface{}, error) {
+	return time.ParseDuration(s)
+}
+
+func durationEqual(a, b interface{}) bool {
+	return a.(time.Duration) == b.(time.Duration)
+}
+
+// Duration represents a string containing a duration, such as "1h30m", as
+// accepted by time.ParseDuration. Durations of the same length, such as "90m"
+// and "1h30m", are semantically equal. Values can be decoded into a
+// time.Duration.
+type Duration struct {
+	// Unknown will be true if the value is not yet known.
+	Unknown bool
+
+	// Null will be true if the value was not set, or was explicitly set to
+	// null.
+	Null bool
+
+	// Value contains the set value, as long as Unknown and Null are both
+	// false.
+	Value string
+}
+
+// Type returns a DurationType.
+func (d Duration) Type(_ context.Context) attr.Type {
+	return DurationType
+}
+
+// ToTerraformValue returns the data contained in the Duration as a
+// tftypes.Value.
+func (d Duration) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	return formattedStringToTerraformValue(d.Unknown, d.Null, d.Value)
+}
+
+// Equal returns true if `other` is a Duration and has the same value as `d`.
+func (d Duration) Equal(other attr.Value) bool {
+	o, ok := other.(Duration)
+	if !ok {
+		return false
+	}
+	if d.Unknown != o.Unknown {
+		return false
+	}
+	if d.Null != o.Null {
+		return false
+	}
+	return d.Value == o.Value
+}
+
+// SemanticEquals returns true if `prior` is a Duration of the same length as
+// `d`.
+func (d Duration) SemanticEquals(_ context.Context, prior attr.Value) (bool, diag.Diagnostics) {
+	o, ok := prior.(Duration)
+	if !ok {
+		return false, nil
+	}
+	return formattedStringSemanticEquals(d.Value, o.Value, parseDuration, durationEqual), nil
+}
+
+// IsNull returns true if the Duration represents a null value.
+func (d Duration) IsNull() bool {
+	return d.Null
+}
+
+// IsUnknown returns true if the Duration represents an unknown value.
+func (d Duration) IsUnknown() bool {
+	return d.Unknown
+}
+
+// DurationNull returns a Duration with a null value.
+func DurationNull() Duration {
+	return Duration{Null: true}
+}
+
+// DurationUnknown returns a Duration with an unknown value.
+func DurationUnknown() Duration {
+	return Duration{Unknown: true}
+}
+
+// DurationValue returns a Duration with a known value. An error diagnostic is
+// returned, along with an unknown value, if the value is not a valid
+// duration.
+func DurationValue(value string) (Duration, diag.Diagnostics) {
+	diags := formattedStringValidateValue(DurationType, value)
+
+	if diags.HasError() {
+		return DurationUnknown(), diags
+	}
+
+	return Duration{Value: value}, diags
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+func TestDurationSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    Duration
+		prior    attr.Value
+		expected bool
+	}{
+		"equal-units": {
+			value:    Duration{Value: "90m"},
+			prior:    Duration{Value: "1h30m"},
+			expected: true,
+		},
+		"equal-zero": {
+			value:    Duration{Value: "0s"},
+			prior:    Duration{Value: "0"},
+			expected: true,
+		},
+		"different-length": {
+			value:    Duration{Value: "1h"},
+			prior:    Duration{Value: "1h30m"},
+			expected: false,
+		},
+		"invalid": {
+			value:    Duration{Value: "1 hour"},
+			prior:    Duration{Value: "1h"},
+			expected: false,
+		},
+		"different-type": {
+			value:    Duration{Value: "90m"},
+			prior:    String{Value: "90m"},
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tc.value.SemanticEquals(context.Background(), tc.prior)
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			if got != tc.expected {
+				t.Errorf("Expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ attr.Type  = DynamicType{}
+	_ attr.Value = &Dynamic{}
+)
+
+// DynamicType is an AttributeType representing a value whose type is only
+// known at runtime. Terraform accepts any value for attributes of this type,
+// and the concrete type of the value is determined by the configuration,
+// plan, or state it is read from.
+type DynamicType struct{}
+
+// TerraformType returns the tftypes.Type that should be used to
+// represent this type. This constrains what user input will be
+// accepted and what kind of data can be set in state. The framework
+// will use this to translate the AttributeType to something Terraform
+// can understand.
+func (d DynamicType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.DynamicPseudoType
+}
+
+// ValueFromTerraform returns an AttributeValue given a tftypes.Value.
+// This is meant to convert the tftypes.Value into a more convenient Go
+// type for the provider to consume the data with.
+//
+// The underlying value of the returned Dynamic is created from the concrete
+// type of `in`, using the attr.Type from this package that matches it.
+func (d DynamicType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return Dynamic{Null: true}, nil
+	}
+	if in.Type().Is(tftypes.DynamicPseudoType) {
+		if !in.IsKnown() {
+			return Dynamic{Unknown: true}, nil
+		}
+		if in.IsNull() {
+			return Dynamic{Null: true}, nil
+		}
+		return nil, fmt.Errorf("cannot use known %s value without a concrete type as value of Dynamic", in.Type())
+	}
+
+	typ, err := attrTypeFromTerraformType(in.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := typ.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return Dynamic{Value: val}, nil
+}
+
+// Equal returns true if `o` is also a DynamicType.
+func (d DynamicType) Equal(o attr.Type) bool {
+	_, ok := o.(DynamicType)
+	return ok
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type. Since the concrete type of a Dynamic value is not known ahead of
+// time, every step returns a DynamicType.
+func (d DynamicType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	switch step.(type) {
+	case tftypes.AttributeName, tftypes.ElementKeyInt, tftypes.ElementKeyString, tftypes.ElementKeyValue:
+		return DynamicType{}, nil
+	default:
+		return nil, fmt.Errorf("cannot apply step %T to DynamicType", step)
+	}
+}
+
+// String returns a human-friendly description of the DynamicType.
+func (d DynamicType) String() string {
+	return "types.DynamicType"
+}
+
+// Dynamic represents a value whose type is only known at runtime.
+type Dynamic struct {
+	// Unknown will be set to true if the entire value, including its
+	// type, is unknown.
+	Unknown bool
+
+	// Null will be set to true if the value is null, either because it
+	// was omitted from the configuration, state, or plan, or because it
+	// was explicitly set to null.
+	Null bool
+
+	// Value is the underlying value, which determines the concrete type
+	// sent to Terraform. A nil Value is treated as null.
+	Value attr.Value
+}
+
+// Type returns a DynamicType.
+func (d Dynamic) Type(_ context.Context) attr.Type {
+	return DynamicType{}
+}
+
+// ToTerraformValue returns the data contained in the AttributeValue as
+// a tftypes.Value. Known values are returned with the concrete type of the
+// underlying Value.
+func (d Dynamic) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	if d.Unknown {
+		return tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue), nil
+	}
+	if d.Null || d.Value == nil {
+		return tftypes.NewValue(tftypes.DynamicPseudoType, nil), nil
+	}
+	return d.Value.ToTerraformValue(ctx)
+}
+
+// Equal returns true if `o` is a Dynamic with the same known, null, and
+// underlying value.
+func (d Dynamic) Equal(o attr.Value) bool {
+	other, ok := o.(Dynamic)
+	if !ok {
+		return false
+	}
+	if d.Unknown != other.Unknown {
+		return false
+	}
+	if d.Null != other.Null {
+		return false
+	}
+	if d.Value == nil || other.Value == nil {
+		return d.Value == nil && other.Value == nil
+	}
+	return d.Value.Equal(other.Value)
+}
+
+// IsNull returns true if the Dynamic represents a null value, including a
+// null underlying Value.
+func (d Dynamic) IsNull() bool {
+	if d.Null {
+		return true
+	}
+	if d.Unknown {
+		return false
+	}
+	if d.Value == nil {
+		return true
+	}
+	return d.Value.IsNull()
+}
+
+// IsUnknown returns true if the Dynamic represents an unknown value,
+// including an unknown underlying Value.
+func (d Dynamic) IsUnknown() bool {
+	if d.Unknown {
+		return true
+	}
+	if d.Null || d.Value == nil {
+		return false
+	}
+	return d.Value.IsUnknown()
+}
+
+// attrTypeFromTerraformType returns the attr.Type from this package that
+// matches the concrete tftypes.Type `typ`.
+func attrTypeFromTerraformType(typ tftypes.Type) (attr.Type, error) {
+	switch {
+	case typ.Is(tftypes.DynamicPseudoType):
+		return DynamicType{}, nil
+	case typ.Is(tftypes.String):
+		return StringType, nil
+	case typ.Is(tftypes.Number):
+		return NumberType, nil
+	case typ.Is(tftypes.Bool):
+		return BoolType, nil
+	case typ.Is(tftypes.List{}):
+		elemType, err := attrTypeFromTerraformType(typ.(tftypes.List).ElementType)
+		if err != nil {
+			return nil, err
+		}
+		return ListType{ElemType: elemType}, nil
+	case typ.Is(tftypes.Set{}):
+		elemType, err := attrTypeFromTerraformType(typ.(tftypes.Set).ElementType)
+		if err != nil {
+			return nil, err
+		}
+		return SetType{ElemType: elemType}, nil
+	case typ.Is(tftypes.Map{}):
+		elemType, err := attrTypeFromTerraformType(typ.(tftypes.Map).ElementType)
+		if err != nil {
+			return nil, err
+		}
+		return MapType{ElemType: elemType}, nil
+	case typ.Is(tftypes.Object{}):
+		attrTypes := map[string]attr.Type{}
+		for name, attrTfType := range typ.(tftypes.Object).AttributeTypes {
+			attrType, err := attrTypeFromTerraformType(attrTfType)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[name] = attrType
+		}
+		return ObjectType{AttrTypes: attrTypes}, nil
+	case typ.Is(tftypes.Tuple{}):
+		elemTypes := make([]attr.Type, 0, len(typ.(tftypes.Tuple).ElementTypes))
+		for _, elemTfType := range typ.(tftypes.Tuple).ElementTypes {
+			elemType, err := attrTypeFromTerraformType(elemTfType)
+			if err != nil {
+				return nil, err
+			}
+			elemTypes = append(elemTypes, elemType)
+		}
+		return TupleType{ElemTypes: elemTypes}, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s for Dynamic value", typ)
+	}
+}
+
+// DynamicNull returns a Dynamic with a null value.
+func DynamicNull() Dynamic {
+	return Dynamic{Null: true}
+}
+
+// DynamicUnknown returns a Dynamic with an unknown value, including its type.
+func DynamicUnknown() Dynamic {
+	return Dynamic{Unknown: true}
+}
+
+// DynamicValue returns a Dynamic with a known underlying value, which
+// determines the concrete type sent to Terraform. A nil value returns a
+// Dynamic with a null value.
+func DynamicValue(value attr.Value) Dynamic {
+	if value == nil {
+		return DynamicNull()
+	}
+
+	return Dynamic{Value: value}
+}
@@ -0,0 +1,375 @@
+package types
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDynamicTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"string": {
+			input: tftypes.NewValue(tftypes.String, "hello"),
+			expected: Dynamic{
+				Value: String{Value: "hello"},
+			},
+		},
+		"number": {
+			input: tftypes.NewValue(tftypes.Number, 123),
+			expected: Dynamic{
+				Value: Number{Value: big.NewFloat(123)},
+			},
+		},
+		"object": {
+			input: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"bool": tftypes.Bool,
+					"list": tftypes.List{ElementType: tftypes.String},
+				},
+			}, map[string]tftypes.Value{
+				"bool": tftypes.NewValue(tftypes.Bool, true),
+				"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+				}),
+			}),
+			expected: Dynamic{
+				Value: Object{
+					AttrTypes: map[string]attr.Type{
+						"bool": BoolType,
+						"list": ListType{ElemType: StringType},
+					},
+					Attrs: map[string]attr.Value{
+						"bool": Bool{Value: true},
+						"list": List{
+							ElemType: StringType,
+							Elems: []attr.Value{
+								String{Value: "hello"},
+							},
+						},
+					},
+				},
+			},
+		},
+		"tuple": {
+			input: tftypes.NewValue(tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.Bool, false),
+			}),
+			expected: Dynamic{
+				Value: Tuple{
+					ElemTypes: []attr.Type{StringType, BoolType},
+					Elems: []attr.Value{
+						String{Value: "hello"},
+						Bool{Value: false},
+					},
+				},
+			},
+		},
+		"string-null": {
+			input: tftypes.NewValue(tftypes.String, nil),
+			expected: Dynamic{
+				Value: String{Null: true},
+			},
+		},
+		"dynamic-null": {
+			input: tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+			expected: Dynamic{
+				Null: true,
+			},
+		},
+		"dynamic-unknown": {
+			input: tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+			expected: Dynamic{
+				Unknown: true,
+			},
+		},
+		"dynamic-known": {
+			input:       tftypes.NewValue(tftypes.DynamicPseudoType, "hello"),
+			expectedErr: "cannot use known tftypes.DynamicPseudoType value without a concrete type as value of Dynamic",
+		},
+		"nil-type": {
+			input: tftypes.NewValue(nil, nil),
+			expected: Dynamic{
+				Null: true,
+			},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotErr := DynamicType{}.ValueFromTerraform(context.Background(), test.input)
+			if gotErr != nil {
+				if test.expectedErr == "" {
+					t.Errorf("Unexpected error: %s", gotErr.Error())
+					return
+				}
+				if gotErr.Error() != test.expectedErr {
+					t.Errorf("Expected error to be %q, got %q", test.expectedErr, gotErr.Error())
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Errorf("Expected error to be %q, got nil", test.expectedErr)
+				return
+			}
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDynamicTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	steps := map[string]tftypes.AttributePathStep{
+		"attribute-name":     tftypes.AttributeName("test"),
+		"element-key-int":    tftypes.ElementKeyInt(1),
+		"element-key-string": tftypes.ElementKeyString("test"),
+		"element-key-value":  tftypes.ElementKeyValue(tftypes.NewValue(tftypes.String, "test")),
+	}
+	for name, step := range steps {
+		name, step := name, step
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DynamicType{}.ApplyTerraform5AttributePathStep(step)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(DynamicType{}, got); diff != "" {
+				t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDynamicToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Dynamic
+		expectation tftypes.Value
+	}
+	tests := map[string]testCase{
+		"value": {
+			input: Dynamic{
+				Value: String{Value: "hello"},
+			},
+			expectation: tftypes.NewValue(tftypes.String, "hello"),
+		},
+		"value-null": {
+			input: Dynamic{
+				Value: Bool{Null: true},
+			},
+			expectation: tftypes.NewValue(tftypes.Bool, nil),
+		},
+		"unknown": {
+			input: Dynamic{
+				Unknown: true,
+			},
+			expectation: tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+		},
+		"null": {
+			input: Dynamic{
+				Null: true,
+			},
+			expectation: tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+		},
+		"nil-value": {
+			input:       Dynamic{},
+			expectation: tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.input.ToTerraformValue(context.Background())
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+
+			if diff := cmp.Diff(test.expectation, got); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDynamicEqual(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		receiver Dynamic
+		input    attr.Value
+		expected bool
+	}
+	tests := map[string]testCase{
+		"equal": {
+			receiver: Dynamic{Value: String{Value: "hello"}},
+			input:    Dynamic{Value: String{Value: "hello"}},
+			expected: true,
+		},
+		"diff-value": {
+			receiver: Dynamic{Value: String{Value: "hello"}},
+			input:    Dynamic{Value: String{Value: "world"}},
+			expected: false,
+		},
+		"diff-value-type": {
+			receiver: Dynamic{Value: String{Value: "hello"}},
+			input:    Dynamic{Value: Bool{Value: true}},
+			expected: false,
+		},
+		"null-nil-value": {
+			receiver: Dynamic{Null: true},
+			input:    Dynamic{Null: true},
+			expected: true,
+		},
+		"null-value": {
+			receiver: Dynamic{Null: true},
+			input:    Dynamic{Value: String{Value: "hello"}},
+			expected: false,
+		},
+		"unknown": {
+			receiver: Dynamic{Unknown: true},
+			input:    Dynamic{Null: true},
+			expected: false,
+		},
+		"wrong-type": {
+			receiver: Dynamic{Value: String{Value: "hello"}},
+			input:    String{Value: "hello"},
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.receiver.Equal(test.input)
+			if test.expected != got {
+				t.Errorf("Expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestDynamicIsNull(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    Dynamic
+		expected bool
+	}{
+		"null": {
+			value:    Dynamic{Null: true},
+			expected: true,
+		},
+		"nil-value": {
+			value:    Dynamic{},
+			expected: true,
+		},
+		"unknown": {
+			value:    Dynamic{Unknown: true},
+			expected: false,
+		},
+		"value": {
+			value:    Dynamic{Value: String{Value: "hello"}},
+			expected: false,
+		},
+		"value-null": {
+			value:    Dynamic{Value: String{Null: true}},
+			expected: true,
+		},
+		"value-unknown": {
+			value:    Dynamic{Value: String{Unknown: true}},
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.value.IsNull()
+			if test.expected != got {
+				t.Errorf("Expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestDynamicIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    Dynamic
+		expected bool
+	}{
+		"null": {
+			value:    Dynamic{Null: true},
+			expected: false,
+		},
+		"nil-value": {
+			value:    Dynamic{},
+			expected: false,
+		},
+		"unknown": {
+			value:    Dynamic{Unknown: true},
+			expected: true,
+		},
+		"value": {
+			value:    Dynamic{Value: String{Value: "hello"}},
+			expected: false,
+		},
+		"value-null": {
+			value:    Dynamic{Value: String{Null: true}},
+			expected: false,
+		},
+		"value-unknown": {
+			value:    Dynamic{Value: String{Unknown: true}},
+			expected: true,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.value.IsUnknown()
+			if test.expected != got {
+				t.Errorf("Expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestDynamicValue(t *testing.T) {
+	t.Parallel()
+
+	if got, expected := DynamicValue(StringValue("a")), (Dynamic{Value: String{Value: "a"}}); !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	if got, expected := DynamicValue(nil), DynamicNull(); !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
@@ -0,0 +1,260 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ attr.TypeWithValidate             = EnumType{}
+	_ attr.TypeWithPlaintextDescription = EnumType{}
+	_ attr.TypeWithMarkdownDescription  = EnumType{}
+	_ attr.Value                        = Enum{}
+)
+
+// EnumType is an AttributeType representing a string which must be one of a
+// fixed set of values, which the provider must specify as the AllowedValues
+// property. The allowed values are validated and appended to the attribute
+// description.
+//
+// Values can be decoded into a string or a named string type, such as:
+//
+//	type Protocol string
+type EnumType struct {
+	AllowedValues []string
+}
+
+// TerraformType returns the tftypes.Type that should be used to represent this
+// type. Enums are represented as strings.
+func (e EnumType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// ValueFromTerraform returns an AttributeValue given a tftypes.Value. The
+// string is kept exactly as given; use Validate to verify it is allowed.
+func (e EnumType) ValueFromTerraform(_ context.Context, in tftypes.Value) (attr.Value, error) {
+	enum := Enum{
+		AllowedValues: e.AllowedValues,
+	}
+	if !in.IsKnown() {
+		enum.Unknown = true
+		return enum, nil
+	}
+	if in.IsNull() {
+		enum.Null = true
+		return enum, nil
+	}
+	err := in.As(&enum.Value)
+	if err != nil {
+		return nil, err
+	}
+	return enum, nil
+}
+
+// Equal returns true if `o` is also an EnumType and has the same
+// AllowedValues, in the same order.
+func (e EnumType) Equal(o attr.Type) bool {
+	other, ok := o.(EnumType)
+	if !ok {
+		return false
+	}
+	return enumAllowedValuesEqual(e.AllowedValues, other.AllowedValues)
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (e EnumType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, e.String())
+}
+
+// String returns a human-friendly description of the EnumType.
+func (e EnumType) String() string {
+	return "types.EnumType[" + strings.Join(e.AllowedValues, ",") + "]"
+}
+
+// Description returns the allowed values in plain text formatting.
+func (e EnumType) Description(_ context.Context) string {
+	return "Must be one of: " + enumQuotedList(e.AllowedValues, false) + "."
+}
+
+// MarkdownDescription returns the allowed values in Markdown formatting.
+func (e EnumType) MarkdownDescription(_ context.Context) string {
+	return "Must be one of: " + enumQuotedList(e.AllowedValues, true) + "."
+}
+
+// Validate implements type validation, returning an error diagnostic if a
+// known value is not one of the AllowedValues.
+func (e EnumType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Equal(tftypes.String) {
+		diags.AddAttributeError(
+			path,
+			"Enum Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected String value, received %T with value: %v", in, in),
+		)
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var s string
+	err := in.As(&s)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Enum Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to string: %s", err),
+		)
+		return diags
+	}
+
+	for _, allowedValue := range e.AllowedValues {
+		if s == allowedValue {
+			return diags
+		}
+	}
+
+	diags.AddAttributeError(
+		path,
+		"Enum Type Validation Error",
+		fmt.Sprintf("Value %q must be one of: %s.", s, enumQuotedList(e.AllowedValues, false)),
+	)
+
+	return diags
+}
+
+// Enum represents a string which must be one of a fixed set of values,
+// indicated by AllowedValues.
+type Enum struct {
+	// Unknown will be true if the value is not yet known.
+	Unknown bool
+
+	// Null will be true if the value was not set, or was explicitly set to
+	// null.
+	Null bool
+
+	// Value contains the set value, as long as Unknown and Null are both
+	// false.
+	Value string
+
+	// AllowedValues are the values the enum can hold.
+	AllowedValues []string
+}
+
+// Type returns an EnumType with the same allowed values as `e`.
+func (e Enum) Type(_ context.Context) attr.Type {
+	return EnumType{AllowedValues: e.AllowedValues}
+}
+
+// ToTerraformValue returns the data contained in the Enum as a tftypes.Value.
+func (e Enum) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	if e.Null {
+		return tftypes.NewValue(tftypes.String, nil), nil
+	}
+	if e.Unknown {
+		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	}
+	if err := tftypes.ValidateValue(tftypes.String, e.Value); err != nil {
+		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), err
+	}
+	return tftypes.NewValue(tftypes.String, e.Value), nil
+}
+
+// Equal returns true if `other` is an Enum with the same allowed values and
+// has the same value as `e`.
+func (e Enum) Equal(other attr.Value) bool {
+	o, ok := other.(Enum)
+	if !ok {
+		return false
+	}
+	if e.Unknown != o.Unknown {
+		return false
+	}
+	if e.Null != o.Null {
+		return false
+	}
+	if !enumAllowedValuesEqual(e.AllowedValues, o.AllowedValues) {
+		return false
+	}
+	return e.Value == o.Value
+}
+
+// IsNull returns true if the Enum represents a null value.
+func (e Enum) IsNull() bool {
+	return e.Null
+}
+
+// IsUnknown returns true if the Enum represents an unknown value.
+func (e Enum) IsUnknown() bool {
+	return e.Unknown
+}
+
+func enumAllowedValuesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// enumQuotedList returns the given values quoted and separated by commas. If
+// markdown is true, each value is additionally wrapped in backticks.
+func enumQuotedList(values []string, markdown bool) string {
+	quoted := make([]string, 0, len(values))
+
+	for _, value := range values {
+		if markdown {
+			quoted = append(quoted, fmt.Sprintf("`%q`", value))
+
+			continue
+		}
+
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+// EnumNull returns an Enum with a null value.
+func EnumNull(allowedValues []string) Enum {
+	return Enum{Null: true, AllowedValues: allowedValues}
+}
+
+// EnumUnknown returns an Enum with an unknown value.
+func EnumUnknown(allowedValues []string) Enum {
+	return Enum{Unknown: true, AllowedValues: allowedValues}
+}
+
+// EnumValue returns an Enum with a known value. An error diagnostic is
+// returned, along with an unknown value, if the value is not one of the
+// allowed values.
+func EnumValue(allowedValues []string, value string) (Enum, diag.Diagnostics) {
+	enumType := EnumType{AllowedValues: allowedValues}
+	diags := enumType.Validate(context.Background(), tftypes.NewValue(tftypes.String, value), path.Empty())
+
+	if diags.HasError() {
+		return EnumUnknown(allowedValues), diags
+	}
+
+	return Enum{Value: value, AllowedValues: allowedValues}, diags
+}
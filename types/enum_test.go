@@ -0,0 +1,272 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEnumTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	allowedValues := []string{"tcp", "udp"}
+
+	testCases := map[string]struct {
+		input       tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}{
+		"value": {
+			input:    tftypes.NewValue(tftypes.String, "tcp"),
+			expected: Enum{Value: "tcp", AllowedValues: allowedValues},
+		},
+		"value-not-allowed": {
+			input:    tftypes.NewValue(tftypes.String, "icmp"),
+			expected: Enum{Value: "icmp", AllowedValues: allowedValues},
+		},
+		"null": {
+			input:    tftypes.NewValue(tftypes.String, nil),
+			expected: Enum{Null: true, AllowedValues: allowedValues},
+		},
+		"unknown": {
+			input:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: Enum{Unknown: true, AllowedValues: allowedValues},
+		},
+		"wrongType": {
+			input:       tftypes.NewValue(tftypes.Number, 123),
+			expectedErr: "can't unmarshal tftypes.Number into *string, expected string",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := EnumType{AllowedValues: allowedValues}.ValueFromTerraform(context.Background(), tc.input)
+
+			if err != nil {
+				if tc.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if tc.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", tc.expectedErr, err.Error())
+				}
+				return
+			}
+
+			if tc.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", tc.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestEnumTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"allowed": {
+			input: tftypes.NewValue(tftypes.String, "udp"),
+		},
+		"not-allowed": {
+			input: tftypes.NewValue(tftypes.String, "UDP"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Enum Type Validation Error",
+					`Value "UDP" must be one of: "tcp", "udp".`,
+				),
+			},
+		},
+		"null": {
+			input: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			input: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"wrong-type": {
+			input: tftypes.NewValue(tftypes.Number, 1),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Enum Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected String value, received tftypes.Value with value: tftypes.Number<\"1\">",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := EnumType{AllowedValues: []string{"tcp", "udp"}}.Validate(context.Background(), tc.input, path.Root("test"))
+
+			if diff := cmp.Diff(got, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestEnumTypeDescription(t *testing.T) {
+	t.Parallel()
+
+	enumType := EnumType{AllowedValues: []string{"tcp", "udp"}}
+
+	if got, expected := enumType.Description(context.Background()), `Must be one of: "tcp", "udp".`; got != expected {
+		t.Errorf("Expected description %q, got %q", expected, got)
+	}
+
+	if got, expected := enumType.MarkdownDescription(context.Background()), "Must be one of: `\"tcp\"`, `\"udp\"`."; got != expected {
+		t.Errorf("Expected markdown description %q, got %q", expected, got)
+	}
+}
+
+func TestEnumTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		other    attr.Type
+		expected bool
+	}{
+		"equal": {
+			other:    EnumType{AllowedValues: []string{"tcp", "udp"}},
+			expected: true,
+		},
+		"different-order": {
+			other:    EnumType{AllowedValues: []string{"udp", "tcp"}},
+			expected: false,
+		},
+		"different-values": {
+			other:    EnumType{AllowedValues: []string{"tcp"}},
+			expected: false,
+		},
+		"string": {
+			other:    StringType,
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := EnumType{AllowedValues: []string{"tcp", "udp"}}.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("Expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestEnumEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    Enum
+		other    attr.Value
+		expected bool
+	}{
+		"equal": {
+			value:    Enum{Value: "tcp", AllowedValues: []string{"tcp", "udp"}},
+			other:    Enum{Value: "tcp", AllowedValues: []string{"tcp", "udp"}},
+			expected: true,
+		},
+		"different-value": {
+			value:    Enum{Value: "tcp", AllowedValues: []string{"tcp", "udp"}},
+			other:    Enum{Value: "udp", AllowedValues: []string{"tcp", "udp"}},
+			expected: false,
+		},
+		"different-allowed-values": {
+			value:    Enum{Value: "tcp", AllowedValues: []string{"tcp", "udp"}},
+			other:    Enum{Value: "tcp", AllowedValues: []string{"tcp"}},
+			expected: false,
+		},
+		"null-unknown": {
+			value:    Enum{Null: true, AllowedValues: []string{"tcp", "udp"}},
+			other:    Enum{Unknown: true, AllowedValues: []string{"tcp", "udp"}},
+			expected: false,
+		},
+		"string": {
+			value:    Enum{Value: "tcp", AllowedValues: []string{"tcp", "udp"}},
+			other:    String{Value: "tcp"},
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.value.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("Expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestEnumValue(t *testing.T) {
+	t.Parallel()
+
+	allowedValues := []string{"tcp", "udp"}
+
+	testCases := map[string]struct {
+		value         string
+		expected      Enum
+		expectedDiags diag.Diagnostics
+	}{
+		"allowed": {
+			value:    "tcp",
+			expected: Enum{Value: "tcp", AllowedValues: allowedValues},
+		},
+		"not-allowed": {
+			value:    "icmp",
+			expected: EnumUnknown(allowedValues),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Enum Type Validation Error",
+					`Value "icmp" must be one of: "tcp", "udp".`,
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := EnumValue(allowedValues, tc.value)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-expected, +got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected result (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -14,7 +15,7 @@ var (
 	_ attr.Value = Float64{}
 )
 
-func float64Validate(_ context.Context, in tftypes.Value, path *tftypes.AttributePath) diag.Diagnostics {
+func float64Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if in.Type() == nil {
@@ -150,3 +151,18 @@ func (f Float64) IsNull() bool {
 func (f Float64) IsUnknown() bool {
 	return f.Unknown
 }
+
+// Float64Null returns a Float64 with a null value.
+func Float64Null() Float64 {
+	return Float64{Null: true}
+}
+
+// Float64Unknown returns a Float64 with an unknown value.
+func Float64Unknown() Float64 {
+	return Float64{Unknown: true}
+}
+
+// Float64Value returns a Float64 with a known value.
+func Float64Value(value float64) Float64 {
+	return Float64{Value: value}
+}
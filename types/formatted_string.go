@@ -0,0 +1,225 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type formattedString uint8
+
+const (
+	// JSONType represents a string containing a JSON document.
+	JSONType formattedString = iota
+
+	// RFC3339Type represents a string containing an RFC 3339 timestamp.
+	RFC3339Type
+
+	// IPAddressType represents a string containing an IPv4 or IPv6 address.
+	IPAddressType
+
+	// CIDRType represents a string containing an IPv4 or IPv6 network in
+	// CIDR notation.
+	CIDRType
+
+	// UUIDType represents a string containing a UUID.
+	UUIDType
+
+	// DurationType represents a string containing a duration, such as "1h30m",
+	// as accepted by time.ParseDuration.
+	DurationType
+)
+
+var (
+	_ attr.TypeWithValidate = JSONType
+	_ attr.TypeWithValidate = RFC3339Type
+	_ attr.TypeWithValidate = IPAddressType
+	_ attr.TypeWithValidate = CIDRType
+	_ attr.TypeWithValidate = UUIDType
+	_ attr.TypeWithValidate = DurationType
+)
+
+func (f formattedString) String() string {
+	switch f {
+	case JSONType:
+		return "types.JSONType"
+	case RFC3339Type:
+		return "types.RFC3339Type"
+	case IPAddressType:
+		return "types.IPAddressType"
+	case CIDRType:
+		return "types.CIDRType"
+	case UUIDType:
+		return "types.UUIDType"
+	case DurationType:
+		return "types.DurationType"
+	default:
+		return fmt.Sprintf("unknown formatted string %d", f)
+	}
+}
+
+// TerraformType returns the tftypes.Type that should be used to represent this
+// type. All formatted strings are represented as strings.
+func (f formattedString) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. The string is kept
+// exactly as given; use Validate to verify its format.
+func (f formattedString) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	var s string
+
+	if in.IsKnown() && !in.IsNull() {
+		err := in.As(&s)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch f {
+	case JSONType:
+		return JSON{Unknown: !in.IsKnown(), Null: in.IsNull(), Value: s}, nil
+	case RFC3339Type:
+		return RFC3339{Unknown: !in.IsKnown(), Null: in.IsNull(), Value: s}, nil
+	case IPAddressType:
+		return IPAddress{Unknown: !in.IsKnown(), Null: in.IsNull(), Value: s}, nil
+	case CIDRType:
+		return CIDR{Unknown: !in.IsKnown(), Null: in.IsNull(), Value: s}, nil
+	case UUIDType:
+		return UUID{Unknown: !in.IsKnown(), Null: in.IsNull(), Value: s}, nil
+	case DurationType:
+		return Duration{Unknown: !in.IsKnown(), Null: in.IsNull(), Value: s}, nil
+	default:
+		panic(fmt.Sprintf("unknown formatted string %d", f))
+	}
+}
+
+// Equal returns true if `o` is also a formatted string, and is the same type
+// of formatted string as `f`.
+func (f formattedString) Equal(o attr.Type) bool {
+	other, ok := o.(formattedString)
+
+	if !ok {
+		return false
+	}
+
+	return f == other
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (f formattedString) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, f.String())
+}
+
+// Validate implements type validation, returning an error diagnostic if a
+// known value does not match the format of the type.
+func (f formattedString) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	switch f {
+	case JSONType:
+		return formattedStringValidate(in, path, "JSON", "JSON document", parseJSON)
+	case RFC3339Type:
+		return formattedStringValidate(in, path, "RFC3339", "RFC 3339 timestamp", parseRFC3339)
+	case IPAddressType:
+		return formattedStringValidate(in, path, "IPAddress", "IP address", parseIPAddress)
+	case CIDRType:
+		return formattedStringValidate(in, path, "CIDR", "CIDR network", parseCIDR)
+	case UUIDType:
+		return formattedStringValidate(in, path, "UUID", "UUID", parseUUID)
+	case DurationType:
+		return formattedStringValidate(in, path, "Duration", "duration", parseDuration)
+	default:
+		return nil
+	}
+}
+
+func formattedStringValidate(in tftypes.Value, path path.Path, name string, description string, parse func(string) (interface{}, error)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Equal(tftypes.String) {
+		diags.AddAttributeError(
+			path,
+			name+" Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected String value, received %T with value: %v", in, in),
+		)
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var s string
+	err := in.As(&s)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			name+" Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to string: %s", err),
+		)
+		return diags
+	}
+
+	if _, err := parse(s); err != nil {
+		diags.AddAttributeError(
+			path,
+			name+" Type Validation Error",
+			fmt.Sprintf("Value %q is not a valid %s: %s", s, description, err),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+// formattedStringToTerraformValue returns the tftypes.Value of a formatted
+// string value.
+func formattedStringToTerraformValue(unknown, null bool, value string) (tftypes.Value, error) {
+	if null {
+		return tftypes.NewValue(tftypes.String, nil), nil
+	}
+	if unknown {
+		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	}
+	if err := tftypes.ValidateValue(tftypes.String, value); err != nil {
+		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), err
+	}
+	return tftypes.NewValue(tftypes.String, value), nil
+}
+
+// formattedStringSemanticEquals parses both strings and compares the results
+// with equal. Strings which cannot be parsed are never semantically equal, as
+// only exactly equal strings would be safe to consider the same.
+func formattedStringSemanticEquals(value, prior string, parse func(string) (interface{}, error), equal func(interface{}, interface{}) bool) bool {
+	parsedValue, err := parse(value)
+
+	if err != nil {
+		return false
+	}
+
+	parsedPrior, err := parse(prior)
+
+	if err != nil {
+		return false
+	}
+
+	return equal(parsedValue, parsedPrior)
+}
+
+// formattedStringValidateValue validates a known value of the formatted string
+// type, for use by value constructors.
+func formattedStringValidateValue(f formattedString, value string) diag.Diagnostics {
+	return f.Validate(context.Background(), tftypes.NewValue(tftypes.String, value), path.Empty())
+}
@@ -0,0 +1,287 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFormattedStringValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ         attr.Type
+		input       tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}{
+		"json": {
+			typ:      JSONType,
+			input:    tftypes.NewValue(tftypes.String, `{"a": 1}`),
+			expected: JSON{Value: `{"a": 1}`},
+		},
+		"json-null": {
+			typ:      JSONType,
+			input:    tftypes.NewValue(tftypes.String, nil),
+			expected: JSON{Null: true},
+		},
+		"json-unknown": {
+			typ:      JSONType,
+			input:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: JSON{Unknown: true},
+		},
+		"json-invalid": {
+			typ:      JSONType,
+			input:    tftypes.NewValue(tftypes.String, `{`),
+			expected: JSON{Value: `{`},
+		},
+		"rfc3339": {
+			typ:      RFC3339Type,
+			input:    tftypes.NewValue(tftypes.String, "2022-07-01T12:30:00Z"),
+			expected: RFC3339{Value: "2022-07-01T12:30:00Z"},
+		},
+		"ip-address": {
+			typ:      IPAddressType,
+			input:    tftypes.NewValue(tftypes.String, "192.0.2.1"),
+			expected: IPAddress{Value: "192.0.2.1"},
+		},
+		"cidr": {
+			typ:      CIDRType,
+			input:    tftypes.NewValue(tftypes.String, "192.0.2.0/24"),
+			expected: CIDR{Value: "192.0.2.0/24"},
+		},
+		"uuid": {
+			typ:      UUIDType,
+			input:    tftypes.NewValue(tftypes.String, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"),
+			expected: UUID{Value: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+		},
+		"duration": {
+			typ:      DurationType,
+			input:    tftypes.NewValue(tftypes.String, "1h30m"),
+			expected: Duration{Value: "1h30m"},
+		},
+		"duration-unknown": {
+			typ:      DurationType,
+			input:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: Duration{Unknown: true},
+		},
+		"wrongType": {
+			typ:         UUIDType,
+			input:       tftypes.NewValue(tftypes.Number, 123),
+			expectedErr: "can't unmarshal tftypes.Number into *string, expected string",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tc.typ.ValueFromTerraform(context.Background(), tc.input)
+
+			if err != nil {
+				if tc.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if tc.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", tc.expectedErr, err.Error())
+				}
+				return
+			}
+
+			if tc.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", tc.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+			}
+
+			if !got.Type(context.Background()).Equal(tc.typ) {
+				t.Errorf("Expected value type %s, got %s", tc.typ, got.Type(context.Background()))
+			}
+		})
+	}
+}
+
+func TestFormattedStringValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           attr.TypeWithValidate
+		input         tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:   JSONType,
+			input: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			typ:   JSONType,
+			input: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"wrong-type": {
+			typ:   JSONType,
+			input: tftypes.NewValue(tftypes.Number, 1),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"JSON Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected String value, received tftypes.Value with value: tftypes.Number<\"1\">",
+				),
+			},
+		},
+		"json": {
+			typ:   JSONType,
+			input: tftypes.NewValue(tftypes.String, `{"a": [1, true, null]}`),
+		},
+		"json-invalid": {
+			typ:   JSONType,
+			input: tftypes.NewValue(tftypes.String, `{"a": }`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"JSON Type Validation Error",
+					`Value "{\"a\": }" is not a valid JSON document: invalid character '}' looking for beginning of value`,
+				),
+			},
+		},
+		"rfc3339": {
+			typ:   RFC3339Type,
+			input: tftypes.NewValue(tftypes.String, "2022-07-01T12:30:00.5+02:00"),
+		},
+		"rfc3339-invalid": {
+			typ:   RFC3339Type,
+			input: tftypes.NewValue(tftypes.String, "2022-07-01 12:30:00"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"RFC3339 Type Validation Error",
+					`Value "2022-07-01 12:30:00" is not a valid RFC 3339 timestamp: parsing time "2022-07-01 12:30:00" as "2006-01-02T15:04:05Z07:00": cannot parse " 12:30:00" as "T"`,
+				),
+			},
+		},
+		"ip-address-ipv4": {
+			typ:   IPAddressType,
+			input: tftypes.NewValue(tftypes.String, "192.0.2.1"),
+		},
+		"ip-address-ipv6": {
+			typ:   IPAddressType,
+			input: tftypes.NewValue(tftypes.String, "2001:db8::1"),
+		},
+		"ip-address-invalid": {
+			typ:   IPAddressType,
+			input: tftypes.NewValue(tftypes.String, "192.0.2.256"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"IPAddress Type Validation Error",
+					`Value "192.0.2.256" is not a valid IP address: invalid IP address: 192.0.2.256`,
+				),
+			},
+		},
+		"cidr": {
+			typ:   CIDRType,
+			input: tftypes.NewValue(tftypes.String, "2001:db8::/32"),
+		},
+		"cidr-invalid": {
+			typ:   CIDRType,
+			input: tftypes.NewValue(tftypes.String, "192.0.2.0"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"CIDR Type Validation Error",
+					`Value "192.0.2.0" is not a valid CIDR network: invalid CIDR address: 192.0.2.0`,
+				),
+			},
+		},
+		"uuid": {
+			typ:   UUIDType,
+			input: tftypes.NewValue(tftypes.String, "F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6"),
+		},
+		"uuid-invalid": {
+			typ:   UUIDType,
+			input: tftypes.NewValue(tftypes.String, "f81d4fae7dec11d0a76500a0c91e6bf6"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"UUID Type Validation Error",
+					`Value "f81d4fae7dec11d0a76500a0c91e6bf6" is not a valid UUID: expected 32 hexadecimal digits in 8-4-4-4-12 format`,
+				),
+			},
+		},
+		"duration": {
+			typ:   DurationType,
+			input: tftypes.NewValue(tftypes.String, "1h30m"),
+		},
+		"duration-invalid": {
+			typ:   DurationType,
+			input: tftypes.NewValue(tftypes.String, "1 hour"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Duration Type Validation Error",
+					`Value "1 hour" is not a valid duration: time: unknown unit " hour" in duration "1 hour"`,
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.typ.Validate(context.Background(), tc.input, path.Root("test"))
+
+			if diff := cmp.Diff(got, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFormattedStringEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      attr.Type
+		other    attr.Type
+		expected bool
+	}{
+		"equal": {
+			typ:      JSONType,
+			other:    JSONType,
+			expected: true,
+		},
+		"different-formatted-string": {
+			typ:      JSONType,
+			other:    UUIDType,
+			expected: false,
+		},
+		"string": {
+			typ:      UUIDType,
+			other:    StringType,
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.typ.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("Expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -14,7 +15,7 @@ var (
 	_ attr.Value = Int64{}
 )
 
-func int64Validate(_ context.Context, in tftypes.Value, path *tftypes.AttributePath) diag.Diagnostics {
+func int64Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if in.Type() == nil {
@@ -162,3 +163,18 @@ func (i Int64) IsNull() bool {
 func (i Int64) IsUnknown() bool {
 	return i.Unknown
 }
+
+// Int64Null returns an Int64 with a null value.
+func Int64Null() Int64 {
+	return Int64{Null: true}
+}
+
+// Int64Unknown returns an Int64 with an unknown value.
+func Int64Unknown() Int64 {
+	return Int64{Unknown: true}
+}
+
+// Int64Value returns an Int64 with a known value.
+func Int64Value(value int64) Int64 {
+	return Int64{Value: value}
+}
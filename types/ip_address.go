@@ -0,0 +1,114 @@
+package types
+
+import (
+	"context"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ attr.ValueWithSemanticEquals = IPAddress{}
+)
+
+func parseIPAddress(s string) (interface{}, error) {
+	ip := net.ParseIP(s)
+
+	if ip == nil {
+		return nil, &net.ParseError{Type: "IP address", Text: s}
+	}
+
+	return ip, nil
+}
+
+func ipAddressEqual(a, b interface{}) bool {
+	return a.(net.IP).Equal(b.(net.IP))
+}
+
+// IPAddress represents a string containing an IPv4 or IPv6 address. Addresses
+// which differ only in notation, such as "2001:db8::1" and
+// "2001:0db8:0:0:0:0:0:1", are semantically equal. Values can be decoded into
+// a net.IP.
+type IPAddress struct {
+	// Unknown will be true if the value is not yet known.
+	Unknown bool
+
+	// Null will be true if the value was not set, or was explicitly set to
+	// null.
+	Null bool
+
+	// Value contains the set value, as long as Unknown and Null are both
+	// false.
+	Value string
+}
+
+// Type returns an IPAddressType.
+func (i IPAddress) Type(_ context.Context) attr.Type {
+	return IPAddressType
+}
+
+// ToTerraformValue returns the data contained in the IPAddress as a
+// tftypes.Value.
+func (i IPAddress) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	return formattedStringToTerraformValue(i.Unknown, i.Null, i.Value)
+}
+
+// Equal returns true if `other` is an IPAddress and has the same value as `i`.
+func (i IPAddress) Equal(other attr.Value) bool {
+	o, ok := other.(IPAddress)
+	if !ok {
+		return false
+	}
+	if i.Unknown != o.Unknown {
+		return false
+	}
+	if i.Null != o.Null {
+		return false
+	}
+	return i.Value == o.Value
+}
+
+// SemanticEquals returns true if `prior` is an IPAddress containing the same
+// address as `i`.
+func (i IPAddress) SemanticEquals(_ context.Context, prior attr.Value) (bool, diag.Diagnostics) {
+	o, ok := prior.(IPAddress)
+	if !ok {
+		return false, nil
+	}
+	return formattedStringSemanticEquals(i.Value, o.Value, parseIPAddress, ipAddressEqual), nil
+}
+
+// IsNull returns true if the IPAddress represents a null value.
+func (i IPAddress) IsNull() bool {
+	return i.Null
+}
+
+// IsUnknown returns true if the IPAddress represents an unknown value.
+func (i IPAddress) IsUnknown() bool {
+	return i.Unknown
+}
+
+// IPAddressNull returns an IPAddress with a null value.
+func IPAddressNull() IPAddress {
+	return IPAddress{Null: true}
+}
+
+// IPAddressUnknown returns an IPAddress with an unknown value.
+func IPAddressUnknown() IPAddress {
+	return IPAddress{Unknown: true}
+}
+
+// IPAddressValue returns an IPAddress with a known value. An error diagnostic is
+// returned, along with an unknown value, if the value is not a valid
+// IP address.
+func IPAddressValue(value string) (IPAddress, diag.Diagnostics) {
+	diags := formattedStringValidateValue(IPAddressType, value)
+
+	if diags.HasError() {
+		return IPAddressUnknown(), diags
+	}
+
+	return IPAddress{Value: value}, diags
+}
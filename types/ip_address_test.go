@@ -0,0 +1,61 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+func TestIPAddressSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    IPAddress
+		prior    attr.Value
+		expected bool
+	}{
+		"equal-ipv6-notation": {
+			value:    IPAddress{Value: "2001:0db8:0:0:0:0:0:1"},
+			prior:    IPAddress{Value: "2001:db8::1"},
+			expected: true,
+		},
+		"equal-ipv6-case": {
+			value:    IPAddress{Value: "2001:DB8::A"},
+			prior:    IPAddress{Value: "2001:db8::a"},
+			expected: true,
+		},
+		"different-address": {
+			value:    IPAddress{Value: "192.0.2.1"},
+			prior:    IPAddress{Value: "192.0.2.2"},
+			expected: false,
+		},
+		"invalid": {
+			value:    IPAddress{Value: "192.0.2"},
+			prior:    IPAddress{Value: "192.0.2.0"},
+			expected: false,
+		},
+		"different-type": {
+			value:    IPAddress{Value: "2001:0db8:0:0:0:0:0:1"},
+			prior:    String{Value: "2001:0db8:0:0:0:0:0:1"},
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tc.value.SemanticEquals(context.Background(), tc.prior)
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			if got != tc.expected {
+				t.Errorf("Expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
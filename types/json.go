@@ -0,0 +1,107 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ attr.ValueWithSemanticEquals = JSON{}
+)
+
+func parseJSON(s string) (interface{}, error) {
+	var v interface{}
+
+	err := json.Unmarshal([]byte(s), &v)
+
+	return v, err
+}
+
+// JSON represents a string containing a JSON document. Documents which differ
+// only in whitespace or object key order are semantically equal.
+type JSON struct {
+	// Unknown will be true if the value is not yet known.
+	Unknown bool
+
+	// Null will be true if the value was not set, or was explicitly set to
+	// null.
+	Null bool
+
+	// Value contains the set value, as long as Unknown and Null are both
+	// false.
+	Value string
+}
+
+// Type returns a JSONType.
+func (j JSON) Type(_ context.Context) attr.Type {
+	return JSONType
+}
+
+// ToTerraformValue returns the data contained in the JSON as a
+// tftypes.Value.
+func (j JSON) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	return formattedStringToTerraformValue(j.Unknown, j.Null, j.Value)
+}
+
+// Equal returns true if `other` is a JSON and has the same value as `j`.
+func (j JSON) Equal(other attr.Value) bool {
+	o, ok := other.(JSON)
+	if !ok {
+		return false
+	}
+	if j.Unknown != o.Unknown {
+		return false
+	}
+	if j.Null != o.Null {
+		return false
+	}
+	return j.Value == o.Value
+}
+
+// SemanticEquals returns true if `prior` is a JSON containing the same
+// document as `j`, ignoring whitespace and object key order.
+func (j JSON) SemanticEquals(_ context.Context, prior attr.Value) (bool, diag.Diagnostics) {
+	o, ok := prior.(JSON)
+	if !ok {
+		return false, nil
+	}
+	return formattedStringSemanticEquals(j.Value, o.Value, parseJSON, reflect.DeepEqual), nil
+}
+
+// IsNull returns true if the JSON represents a null value.
+func (j JSON) IsNull() bool {
+	return j.Null
+}
+
+// IsUnknown returns true if the JSON represents an unknown value.
+func (j JSON) IsUnknown() bool {
+	return j.Unknown
+}
+
+// JSONNull returns a JSON with a null value.
+func JSONNull() JSON {
+	return JSON{Null: true}
+}
+
+// JSONUnknown returns a JSON with an unknown value.
+func JSONUnknown() JSON {
+	return JSON{Unknown: true}
+}
+
+// JSONValue returns a JSON with a known value. An error diagnostic is
+// returned, along with an unknown value, if the value is not a valid
+// JSON document.
+func JSONValue(value string) (JSON, diag.Diagnostics) {
+	diags := formattedStringValidateValue(JSONType, value)
+
+	if diags.HasError() {
+		return JSONUnknown(), diags
+	}
+
+	return JSON{Value: value}, diags
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestJSONSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    JSON
+		prior    attr.Value
+		expected bool
+	}{
+		"equal-whitespace": {
+			value:    JSON{Value: `{"a": [1, 2]}`},
+			prior:    JSON{Value: `{"a":[1,2]}`},
+			expected: true,
+		},
+		"equal-key-order": {
+			value:    JSON{Value: `{"a": 1, "b": 2}`},
+			prior:    JSON{Value: `{"b": 2, "a": 1}`},
+			expected: true,
+		},
+		"different-array-order": {
+			value:    JSON{Value: `[1, 2]`},
+			prior:    JSON{Value: `[2, 1]`},
+			expected: false,
+		},
+		"different-value": {
+			value:    JSON{Value: `{"a": 1}`},
+			prior:    JSON{Value: `{"a": "1"}`},
+			expected: false,
+		},
+		"invalid": {
+			value:    JSON{Value: `{"a": 1`},
+			prior:    JSON{Value: `{"a": 1}`},
+			expected: false,
+		},
+		"different-type": {
+			value:    JSON{Value: `{"a": [1, 2]}`},
+			prior:    String{Value: `{"a": [1, 2]}`},
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tc.value.SemanticEquals(context.Background(), tc.prior)
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			if got != tc.expected {
+				t.Errorf("Expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestJSONValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         string
+		expected      JSON
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			value:    `{"a": 1}`,
+			expected: JSON{Value: `{"a": 1}`},
+		},
+		"invalid": {
+			value:    `{"a": 1`,
+			expected: JSONUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"JSON Type Validation Error",
+					`Value "{\"a\": 1" is not a valid JSON document: unexpected end of JSON input`,
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := JSONValue(tc.value)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-expected, +got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected result (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -221,3 +222,88 @@ func (l List) IsNull() bool {
 func (l List) IsUnknown() bool {
 	return l.Unknown
 }
+
+// ListNull returns a List with a null value and the given element type.
+func ListNull(elemType attr.Type) List {
+	return List{ElemType: elemType, Null: true}
+}
+
+// ListUnknown returns a List with an unknown value and the given element
+// type.
+func ListUnknown(elemType attr.Type) List {
+	return List{ElemType: elemType, Unknown: true}
+}
+
+// ListValue returns a List with a known value. An error diagnostic is
+// returned, along with an unknown value, if the element type is missing or
+// any element is missing or not of the element type.
+func ListValue(elemType attr.Type, elems []attr.Value) (List, diag.Diagnostics) {
+	ctx := context.Background()
+
+	if elemType == nil {
+		return ListUnknown(elemType), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Missing List Element Type",
+				"While creating a List value, a missing element type was detected. "+
+					"A List must specify the single type of its elements. "+
+					"This is always an error in the provider. Please report this to the provider developer.",
+			),
+		}
+	}
+
+	var diags diag.Diagnostics
+
+	for idx, elem := range elems {
+		if elem == nil {
+			diags.AddError(
+				"Missing List Element",
+				"While creating a List value, a missing element was detected. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("List Index: %d", idx),
+			)
+			continue
+		}
+
+		if !elemType.Equal(elem.Type(ctx)) {
+			diags.AddError(
+				"Invalid List Element Type",
+				"While creating a List value, an element with an invalid type was detected. "+
+					"A List must use the single, given element type. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("List Element Type: %s\nList Index (%d) Element Type: %s", elemType, idx, elem.Type(ctx)),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return ListUnknown(elemType), diags
+	}
+
+	return List{ElemType: elemType, Elems: elems}, diags
+}
+
+// ListValueFrom returns a List populated with the contents of `elems`,
+// which must be a Go slice or array, using the same reflection rules as
+// setting State or Plan values. An error diagnostic is returned, along with
+// an unknown value, if `elems` cannot be converted.
+func ListValueFrom(ctx context.Context, elemType attr.Type, elems interface{}) (List, diag.Diagnostics) {
+	attrValue, diags := reflect.FromValue(ctx, ListType{ElemType: elemType}, elems, path.Empty())
+
+	if diags.HasError() {
+		return ListUnknown(elemType), diags
+	}
+
+	list, ok := attrValue.(List)
+
+	if !ok {
+		diags.AddError(
+			"List Conversion Error",
+			"An unexpected error was encountered trying to convert to a List value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected List, got: %T", attrValue),
+		)
+
+		return ListUnknown(elemType), diags
+	}
+
+	return list, diags
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -674,3 +676,136 @@ func TestListEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestListValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elemType      attr.Type
+		elems         []attr.Value
+		expected      List
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			elemType: StringType,
+			elems:    []attr.Value{StringValue("a"), StringUnknown(), StringNull()},
+			expected: List{
+				ElemType: StringType,
+				Elems:    []attr.Value{String{Value: "a"}, String{Unknown: true}, String{Null: true}},
+			},
+		},
+		"empty": {
+			elemType: StringType,
+			elems:    []attr.Value{},
+			expected: List{ElemType: StringType, Elems: []attr.Value{}},
+		},
+		"missing-element-type": {
+			elems:    []attr.Value{StringValue("a")},
+			expected: List{Unknown: true},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing List Element Type",
+					"While creating a List value, a missing element type was detected. "+
+						"A List must specify the single type of its elements. "+
+						"This is always an error in the provider. Please report this to the provider developer.",
+				),
+			},
+		},
+		"missing-element": {
+			elemType: StringType,
+			elems:    []attr.Value{StringValue("a"), nil},
+			expected: ListUnknown(StringType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing List Element",
+					"While creating a List value, a missing element was detected. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"List Index: 1",
+				),
+			},
+		},
+		"invalid-element-type": {
+			elemType: StringType,
+			elems:    []attr.Value{StringValue("a"), BoolValue(true)},
+			expected: ListUnknown(StringType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While creating a List value, an element with an invalid type was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"List Element Type: types.StringType\nList Index (1) Element Type: types.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := ListValue(tc.elemType, tc.elems)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-expected, +got): %s", diff)
+			}
+
+			// Values without an element type cannot be compared.
+			if tc.elemType == nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected result (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueFrom(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elems         interface{}
+		expected      List
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			elems: []string{"a", "b"},
+			expected: List{
+				ElemType: StringType,
+				Elems:    []attr.Value{String{Value: "a"}, String{Value: "b"}},
+			},
+		},
+		"invalid": {
+			elems:    []interface{}{"a", true},
+			expected: ListUnknown(StringType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtListIndex(1),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := ListValueFrom(context.Background(), StringType, tc.elems)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-expected, +got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected result (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
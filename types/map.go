@@ -3,10 +3,12 @@ package types
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -225,3 +227,97 @@ func (m Map) IsNull() bool {
 func (m Map) IsUnknown() bool {
 	return m.Unknown
 }
+
+// MapNull returns a Map with a null value and the given element type.
+func MapNull(elemType attr.Type) Map {
+	return Map{ElemType: elemType, Null: true}
+}
+
+// MapUnknown returns a Map with an unknown value and the given element type.
+func MapUnknown(elemType attr.Type) Map {
+	return Map{ElemType: elemType, Unknown: true}
+}
+
+// MapValue returns a Map with a known value. An error diagnostic is returned,
+// along with an unknown value, if the element type is missing or any element
+// is missing or not of the element type.
+func MapValue(elemType attr.Type, elems map[string]attr.Value) (Map, diag.Diagnostics) {
+	ctx := context.Background()
+
+	if elemType == nil {
+		return MapUnknown(elemType), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Missing Map Element Type",
+				"While creating a Map value, a missing element type was detected. "+
+					"A Map must specify the single type of its elements. "+
+					"This is always an error in the provider. Please report this to the provider developer.",
+			),
+		}
+	}
+
+	var diags diag.Diagnostics
+
+	keys := make([]string, 0, len(elems))
+
+	for key := range elems {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		elem := elems[key]
+
+		if elem == nil {
+			diags.AddError(
+				"Missing Map Element",
+				"While creating a Map value, a missing element was detected. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Map Key: %s", key),
+			)
+			continue
+		}
+
+		if !elemType.Equal(elem.Type(ctx)) {
+			diags.AddError(
+				"Invalid Map Element Type",
+				"While creating a Map value, an element with an invalid type was detected. "+
+					"A Map must use the single, given element type. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Map Element Type: %s\nMap Key (%s) Element Type: %s", elemType, key, elem.Type(ctx)),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return MapUnknown(elemType), diags
+	}
+
+	return Map{ElemType: elemType, Elems: elems}, diags
+}
+
+// MapValueFrom returns a Map populated with the contents of `elems`,
+// which must be a Go map with string keys, using the same reflection rules as
+// setting State or Plan values. An error diagnostic is returned, along with
+// an unknown value, if `elems` cannot be converted.
+func MapValueFrom(ctx context.Context, elemType attr.Type, elems interface{}) (Map, diag.Diagnostics) {
+	attrValue, diags := reflect.FromValue(ctx, MapType{ElemType: elemType}, elems, path.Empty())
+
+	if diags.HasError() {
+		return MapUnknown(elemType), diags
+	}
+
+	m, ok := attrValue.(Map)
+
+	if !ok {
+		diags.AddError(
+			"Map Conversion Error",
+			"An unexpected error was encountered trying to convert to a Map value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected Map, got: %T", attrValue),
+		)
+
+		return MapUnknown(elemType), diags
+	}
+
+	return m, diags
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -673,3 +675,124 @@ func TestMapEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestMapValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elemType      attr.Type
+		elems         map[string]attr.Value
+		expected      Map
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			elemType: StringType,
+			elems:    map[string]attr.Value{"a": StringValue("a"), "b": StringNull()},
+			expected: Map{
+				ElemType: StringType,
+				Elems:    map[string]attr.Value{"a": String{Value: "a"}, "b": String{Null: true}},
+			},
+		},
+		"missing-element-type": {
+			elems:    map[string]attr.Value{"a": StringValue("a")},
+			expected: Map{Unknown: true},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Map Element Type",
+					"While creating a Map value, a missing element type was detected. "+
+						"A Map must specify the single type of its elements. "+
+						"This is always an error in the provider. Please report this to the provider developer.",
+				),
+			},
+		},
+		"invalid-elements": {
+			elemType: StringType,
+			elems:    map[string]attr.Value{"a": StringValue("a"), "b": nil, "c": BoolValue(true)},
+			expected: MapUnknown(StringType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Map Element",
+					"While creating a Map value, a missing element was detected. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Map Key: b",
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Map Element Type",
+					"While creating a Map value, an element with an invalid type was detected. "+
+						"A Map must use the single, given element type. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Map Element Type: types.StringType\nMap Key (c) Element Type: types.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := MapValue(tc.elemType, tc.elems)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-expected, +got): %s", diff)
+			}
+
+			// Values without an element type cannot be compared.
+			if tc.elemType == nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected result (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapValueFrom(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elems         interface{}
+		expected      Map
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			elems: map[string]string{"a": "b"},
+			expected: Map{
+				ElemType: StringType,
+				Elems:    map[string]attr.Value{"a": String{Value: "b"}},
+			},
+		},
+		"invalid": {
+			elems:    map[string]bool{"a": true},
+			expected: MapUnknown(StringType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtMapKey("a"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := MapValueFrom(context.Background(), StringType, tc.elems)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-expected, +got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected result (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
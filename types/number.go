@@ -93,3 +93,23 @@ func (n Number) IsNull() bool {
 func (n Number) IsUnknown() bool {
 	return n.Unknown
 }
+
+// NumberNull returns a Number with a null value.
+func NumberNull() Number {
+	return Number{Null: true}
+}
+
+// NumberUnknown returns a Number with an unknown value.
+func NumberUnknown() Number {
+	return Number{Unknown: true}
+}
+
+// NumberValue returns a Number with a known value. A nil value returns a
+// Number with a null value, as a nil *big.Float cannot be represented.
+func NumberValue(value *big.Float) Number {
+	if value == nil {
+		return NumberNull()
+	}
+
+	return Number{Value: value}
+}
@@ -245,3 +245,15 @@ func TestNumberEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestNumberValue(t *testing.T) {
+	t.Parallel()
+
+	if got, expected := NumberValue(big.NewFloat(1.5)), (Number{Value: big.NewFloat(1.5)}); !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	if got, expected := NumberValue(nil), NumberNull(); !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -282,3 +283,120 @@ func (o Object) IsNull() bool {
 func (o Object) IsUnknown() bool {
 	return o.Unknown
 }
+
+// ObjectNull returns an Object with a null value and the given attribute
+// types.
+func ObjectNull(attrTypes map[string]attr.Type) Object {
+	return Object{AttrTypes: attrTypes, Null: true}
+}
+
+// ObjectUnknown returns an Object with an unknown value and the given
+// attribute types.
+func ObjectUnknown(attrTypes map[string]attr.Type) Object {
+	return Object{AttrTypes: attrTypes, Unknown: true}
+}
+
+// ObjectValue returns an Object with a known value. An error diagnostic is
+// returned, along with an unknown value, if any attribute type is missing,
+// any attribute value is missing, extra, or not of its attribute type.
+func ObjectValue(attrTypes map[string]attr.Type, attrs map[string]attr.Value) (Object, diag.Diagnostics) {
+	ctx := context.Background()
+
+	var diags diag.Diagnostics
+
+	names := make([]string, 0, len(attrTypes))
+
+	for name := range attrTypes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		attrType := attrTypes[name]
+
+		if attrType == nil {
+			diags.AddError(
+				"Missing Object Attribute Type",
+				"While creating an Object value, a missing attribute type was detected. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Object Attribute Name: %s", name),
+			)
+			continue
+		}
+
+		attrValue, ok := attrs[name]
+
+		if !ok || attrValue == nil {
+			diags.AddError(
+				"Missing Object Attribute Value",
+				"While creating an Object value, a missing attribute value was detected. "+
+					"An Object must contain values for all attributes, even if null or unknown. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Object Attribute Name (%s) Expected Type: %s", name, attrType),
+			)
+			continue
+		}
+
+		if !attrType.Equal(attrValue.Type(ctx)) {
+			diags.AddError(
+				"Invalid Object Attribute Type",
+				"While creating an Object value, an attribute value with an invalid type was detected. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Object Attribute Name (%s) Expected Type: %s\nObject Attribute Name (%s) Given Type: %s", name, attrType, name, attrValue.Type(ctx)),
+			)
+		}
+	}
+
+	extraNames := make([]string, 0)
+
+	for name := range attrs {
+		if _, ok := attrTypes[name]; !ok {
+			extraNames = append(extraNames, name)
+		}
+	}
+
+	sort.Strings(extraNames)
+
+	for _, name := range extraNames {
+		diags.AddError(
+			"Extra Object Attribute Value",
+			"While creating an Object value, an extra attribute value was detected. "+
+				"An Object must only contain values for its attribute types. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Extra Object Attribute Name: %s", name),
+		)
+	}
+
+	if diags.HasError() {
+		return ObjectUnknown(attrTypes), diags
+	}
+
+	return Object{AttrTypes: attrTypes, Attrs: attrs}, diags
+}
+
+// ObjectValueFrom returns an Object populated with the contents of `attrs`,
+// which must be a Go struct with `tfsdk` field tags, using the same reflection rules as
+// setting State or Plan values. An error diagnostic is returned, along with
+// an unknown value, if `attrs` cannot be converted.
+func ObjectValueFrom(ctx context.Context, attrTypes map[string]attr.Type, attrs interface{}) (Object, diag.Diagnostics) {
+	attrValue, diags := reflect.FromValue(ctx, ObjectType{AttrTypes: attrTypes}, attrs, path.Empty())
+
+	if diags.HasError() {
+		return ObjectUnknown(attrTypes), diags
+	}
+
+	object, ok := attrValue.(Object)
+
+	if !ok {
+		diags.AddError(
+			"Object Conversion Error",
+			"An unexpected error was encountered trying to convert to a Object value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected Object, got: %T", attrValue),
+		)
+
+		return ObjectUnknown(attrTypes), diags
+	}
+
+	return object, diags
+}
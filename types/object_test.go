@@ -7,6 +7,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -1471,3 +1473,146 @@ func TestObjectEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectValue(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"a": StringType,
+		"b": BoolType,
+	}
+
+	testCases := map[string]struct {
+		attrTypes     map[string]attr.Type
+		attrs         map[string]attr.Value
+		expected      Object
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			attrTypes: attrTypes,
+			attrs:     map[string]attr.Value{"a": StringValue("a"), "b": BoolNull()},
+			expected: Object{
+				AttrTypes: attrTypes,
+				Attrs:     map[string]attr.Value{"a": String{Value: "a"}, "b": Bool{Null: true}},
+			},
+		},
+		"missing-attribute-value": {
+			attrTypes: attrTypes,
+			attrs:     map[string]attr.Value{"a": StringValue("a")},
+			expected:  ObjectUnknown(attrTypes),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Object Attribute Value",
+					"While creating an Object value, a missing attribute value was detected. "+
+						"An Object must contain values for all attributes, even if null or unknown. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Object Attribute Name (b) Expected Type: types.BoolType",
+				),
+			},
+		},
+		"invalid-attribute-type": {
+			attrTypes: attrTypes,
+			attrs:     map[string]attr.Value{"a": StringValue("a"), "b": StringValue("b")},
+			expected:  ObjectUnknown(attrTypes),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Object Attribute Type",
+					"While creating an Object value, an attribute value with an invalid type was detected. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Object Attribute Name (b) Expected Type: types.BoolType\nObject Attribute Name (b) Given Type: types.StringType",
+				),
+			},
+		},
+		"extra-attribute-value": {
+			attrTypes: attrTypes,
+			attrs:     map[string]attr.Value{"a": StringValue("a"), "b": BoolValue(true), "c": StringValue("c")},
+			expected:  ObjectUnknown(attrTypes),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Extra Object Attribute Value",
+					"While creating an Object value, an extra attribute value was detected. "+
+						"An Object must only contain values for its attribute types. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Extra Object Attribute Name: c",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := ObjectValue(tc.attrTypes, tc.attrs)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-expected, +got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected result (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectValueFrom(t *testing.T) {
+	t.Parallel()
+
+	type testObject struct {
+		A string `tfsdk:"a"`
+		B bool   `tfsdk:"b"`
+	}
+
+	attrTypes := map[string]attr.Type{
+		"a": StringType,
+		"b": BoolType,
+	}
+
+	testCases := map[string]struct {
+		attrs         interface{}
+		expected      Object
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			attrs: testObject{A: "a", B: true},
+			expected: Object{
+				AttrTypes: attrTypes,
+				Attrs:     map[string]attr.Value{"a": String{Value: "a"}, "b": Bool{Value: true}},
+			},
+		},
+		"invalid": {
+			attrs: struct {
+				A bool `tfsdk:"a"`
+				B bool `tfsdk:"b"`
+			}{A: true, B: true},
+			expected: ObjectUnknown(attrTypes),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("a"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := ObjectValueFrom(context.Background(), attrTypes, tc.attrs)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-expected, +got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected result (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ attr.ValueWithSemanticEquals = RFC3339{}
+)
+
+func parseRFC3339(s string) (interface{}, error) {
+	return time.Parse(time.RFC3339, s)
+}
+
+func rfc3339Equal(a, b interface{}) bool {
+	return a.(time.Time).Equal(b.(time.Time))
+}
+
+// RFC3339 represents a string containing an RFC 3339 timestamp, such as
+// "2006-01-02T15:04:05Z". Timestamps which represent the same instant, such as
+// those in differing time zones, are semantically equal. Values can be
+// decoded into a time.Time.
+type RFC3339 struct {
+	// Unknown will be true if the value is not yet known.
+	Unknown bool
+
+	// Null will be true if the value was not set, or was explicitly set to
+	// null.
+	Null bool
+
+	// Value contains the set value, as long as Unknown and Null are both
+	// false.
+	Value string
+}
+
+// Type returns an RFC3339Type.
+func (t RFC3339) Type(_ context.Context) attr.Type {
+	return RFC3339Type
+}
+
+// ToTerraformValue returns the data contained in the RFC3339 as a
+// tftypes.Value.
+func (t RFC3339) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	return formattedStringToTerraformValue(t.Unknown, t.Null, t.Value)
+}
+
+// Equal returns true if `other` is an RFC3339 and has the same value as `t`.
+func (t RFC3339) Equal(other attr.Value) bool {
+	o, ok := other.(RFC3339)
+	if !ok {
+		return false
+	}
+	if t.Unknown != o.Unknown {
+		return false
+	}
+	if t.Null != o.Null {
+		return false
+	}
+	return t.Value == o.Value
+}
+
+// SemanticEquals returns true if `prior` is an RFC3339 representing the same
+// instant as `t`.
+func (t RFC3339) SemanticEquals(_ context.Context, prior attr.Value) (bool, diag.Diagnostics) {
+	o, ok := prior.(RFC3339)
+	if !ok {
+		return false, nil
+	}
+	return formattedStringSemanticEquals(t.Value, o.Value, parseRFC3339, rfc3339Equal), nil
+}
+
+// IsNull returns true if the RFC3339 represents a null value.
+func (t RFC3339) IsNull() bool {
+	return t.Null
+}
+
+// IsUnknown returns true if the RFC3339 represents an unknown value.
+func (t RFC3339) IsUnknown() bool {
+	return t.Unknown
+}
+
+// RFC3339Null returns an RFC3339 with a null value.
+func RFC3339Null() RFC3339 {
+	return RFC3339{Null: true}
+}
+
+// RFC3339Unknown returns an RFC3339 with an unknown value.
+func RFC3339Unknown() RFC3339 {
+	return RFC3339{Unknown: true}
+}
+
+// RFC3339Value returns an RFC3339 with a known value. An error diagnostic is
+// returned, along with an unknown value, if the value is not a valid
+// RFC 3339 timestamp.
+func RFC3339Value(value string) (RFC3339, diag.Diagnostics) {
+	diags := formattedStringValidateValue(RFC3339Type, value)
+
+	if diags.HasError() {
+		return RFC3339Unknown(), diags
+	}
+
+	return RFC3339{Value: value}, diags
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+func TestRFC3339SemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    RFC3339
+		prior    attr.Value
+		expected bool
+	}{
+		"equal-time-zone": {
+			value:    RFC3339{Value: "2022-07-01T14:30:00+02:00"},
+			prior:    RFC3339{Value: "2022-07-01T12:30:00Z"},
+			expected: true,
+		},
+		"equal-fractional-seconds": {
+			value:    RFC3339{Value: "2022-07-01T12:30:00.000Z"},
+			prior:    RFC3339{Value: "2022-07-01T12:30:00Z"},
+			expected: true,
+		},
+		"different-instant": {
+			value:    RFC3339{Value: "2022-07-01T12:30:00+02:00"},
+			prior:    RFC3339{Value: "2022-07-01T12:30:00Z"},
+			expected: false,
+		},
+		"invalid": {
+			value:    RFC3339{Value: "2022-07-01"},
+			prior:    RFC3339{Value: "2022-07-01T00:00:00Z"},
+			expected: false,
+		},
+		"different-type": {
+			value:    RFC3339{Value: "2022-07-01T14:30:00+02:00"},
+			prior:    String{Value: "2022-07-01T14:30:00+02:00"},
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tc.value.SemanticEquals(context.Background(), tc.prior)
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			if got != tc.expected {
+				t.Errorf("Expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
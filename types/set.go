@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -112,7 +113,7 @@ func (t SetType) String() string {
 
 // Validate implements type validation. This type requires all elements to be
 // unique.
-func (s SetType) Validate(ctx context.Context, in tftypes.Value, path *tftypes.AttributePath) diag.Diagnostics {
+func (s SetType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if in.Type() == nil {
@@ -161,7 +162,7 @@ func (s SetType) Validate(ctx context.Context, in tftypes.Value, path *tftypes.A
 			}
 
 			diags.AddAttributeError(
-				path.WithElementKeyValue(elemInner),
+				path.AtSetValue(elemInner),
 				"Duplicate Set Element",
 				fmt.Sprintf("This attribute contains duplicate values of: %s", elemInner),
 			)
@@ -291,3 +292,108 @@ func (s Set) IsNull() bool {
 func (s Set) IsUnknown() bool {
 	return s.Unknown
 }
+
+// SetNull returns a Set with a null value and the given element type.
+func SetNull(elemType attr.Type) Set {
+	return Set{ElemType: elemType, Null: true}
+}
+
+// SetUnknown returns a Set with an unknown value and the given element
+// type.
+func SetUnknown(elemType attr.Type) Set {
+	return Set{ElemType: elemType, Unknown: true}
+}
+
+// SetValue returns a Set with a known value. An error diagnostic is
+// returned, along with an unknown value, if the element type is missing, any
+// element is missing or not of the element type, or elements are duplicated.
+func SetValue(elemType attr.Type, elems []attr.Value) (Set, diag.Diagnostics) {
+	ctx := context.Background()
+
+	if elemType == nil {
+		return SetUnknown(elemType), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Missing Set Element Type",
+				"While creating a Set value, a missing element type was detected. "+
+					"A Set must specify the single type of its elements. "+
+					"This is always an error in the provider. Please report this to the provider developer.",
+			),
+		}
+	}
+
+	var diags diag.Diagnostics
+
+	for idx, elem := range elems {
+		if elem == nil {
+			diags.AddError(
+				"Missing Set Element",
+				"While creating a Set value, a missing element was detected. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Set Index: %d", idx),
+			)
+			continue
+		}
+
+		if !elemType.Equal(elem.Type(ctx)) {
+			diags.AddError(
+				"Invalid Set Element Type",
+				"While creating a Set value, an element with an invalid type was detected. "+
+					"A Set must use the single, given element type. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Set Element Type: %s\nSet Index (%d) Element Type: %s", elemType, idx, elem.Type(ctx)),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return SetUnknown(elemType), diags
+	}
+
+	set := Set{ElemType: elemType, Elems: elems}
+
+	// Reuse type validation to detect duplicate elements.
+	tfValue, err := set.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"Set Value Conversion Error",
+			"An unexpected error was encountered trying to convert the set value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return SetUnknown(elemType), diags
+	}
+
+	diags.Append(SetType{ElemType: elemType}.Validate(ctx, tfValue, path.Empty())...)
+
+	if diags.HasError() {
+		return SetUnknown(elemType), diags
+	}
+
+	return set, diags
+}
+
+// SetValueFrom returns a Set populated with the contents of `elems`,
+// which must be a Go slice or array, using the same reflection rules as
+// setting State or Plan values. An error diagnostic is returned, along with
+// an unknown value, if `elems` cannot be converted.
+func SetValueFrom(ctx context.Context, elemType attr.Type, elems interface{}) (Set, diag.Diagnostics) {
+	attrValue, diags := reflect.FromValue(ctx, SetType{ElemType: elemType}, elems, path.Empty())
+
+	if diags.HasError() {
+		return SetUnknown(elemType), diags
+	}
+
+	set, ok := attrValue.(Set)
+
+	if !ok {
+		diags.AddError(
+			"Set Conversion Error",
+			"An unexpected error was encountered trying to convert to a Set value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected Set, got: %T", attrValue),
+		)
+
+		return SetUnknown(elemType), diags
+	}
+
+	return set, diags
+}
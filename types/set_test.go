@@ -8,6 +8,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -349,7 +350,7 @@ func benchmarkSetTypeValidate(b *testing.B, elementCount int) {
 		},
 		elements,
 	)
-	path := tftypes.NewAttributePath().WithAttributeName("test")
+	path := path.Root("test")
 	set := SetType{}
 
 	for n := 0; n < b.N; n++ {
@@ -423,7 +424,7 @@ func TestSetTypeValidate(t *testing.T) {
 			),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, nil)),
+					path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, nil)),
 					"Duplicate Set Element",
 					"This attribute contains duplicate values of: tftypes.String<null>",
 				),
@@ -513,7 +514,7 @@ func TestSetTypeValidate(t *testing.T) {
 			),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "hello")),
+					path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "hello")),
 					"Duplicate Set Element",
 					"This attribute contains duplicate values of: tftypes.String<\"hello\">",
 				),
@@ -533,7 +534,7 @@ func TestSetTypeValidate(t *testing.T) {
 			),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "hello")),
+					path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "hello")),
 					"Duplicate Set Element",
 					"This attribute contains duplicate values of: tftypes.String<\"hello\">",
 				),
@@ -545,7 +546,7 @@ func TestSetTypeValidate(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			diags := SetType{}.Validate(context.Background(), testCase.in, tftypes.NewAttributePath().WithAttributeName("test"))
+			diags := SetType{}.Validate(context.Background(), testCase.in, path.Root("test"))
 
 			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
 				t.Errorf("Unexpected diagnostics (+got, -expected): %s", diff)
@@ -937,3 +938,148 @@ func TestSetEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestSetValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elemType      attr.Type
+		elems         []attr.Value
+		expected      Set
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			elemType: StringType,
+			elems:    []attr.Value{StringValue("a"), StringUnknown(), StringNull()},
+			expected: Set{
+				ElemType: StringType,
+				Elems:    []attr.Value{String{Value: "a"}, String{Unknown: true}, String{Null: true}},
+			},
+		},
+		"empty": {
+			elemType: StringType,
+			elems:    []attr.Value{},
+			expected: Set{ElemType: StringType, Elems: []attr.Value{}},
+		},
+		"missing-element-type": {
+			elems:    []attr.Value{StringValue("a")},
+			expected: Set{Unknown: true},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Set Element Type",
+					"While creating a Set value, a missing element type was detected. "+
+						"A Set must specify the single type of its elements. "+
+						"This is always an error in the provider. Please report this to the provider developer.",
+				),
+			},
+		},
+		"missing-element": {
+			elemType: StringType,
+			elems:    []attr.Value{StringValue("a"), nil},
+			expected: SetUnknown(StringType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Set Element",
+					"While creating a Set value, a missing element was detected. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Set Index: 1",
+				),
+			},
+		},
+		"invalid-element-type": {
+			elemType: StringType,
+			elems:    []attr.Value{StringValue("a"), BoolValue(true)},
+			expected: SetUnknown(StringType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Set Element Type",
+					"While creating a Set value, an element with an invalid type was detected. "+
+						"A Set must use the single, given element type. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Set Element Type: types.StringType\nSet Index (1) Element Type: types.BoolType",
+				),
+			},
+		},
+		"duplicate-elements": {
+			elemType: StringType,
+			elems:    []attr.Value{StringValue("a"), StringValue("a")},
+			expected: SetUnknown(StringType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtSetValue(tftypes.NewValue(tftypes.String, "a")),
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.String<\"a\">",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := SetValue(tc.elemType, tc.elems)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-expected, +got): %s", diff)
+			}
+
+			// Values without an element type cannot be compared.
+			if tc.elemType == nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected result (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueFrom(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elems         interface{}
+		expected      Set
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			elems: []string{"a", "b"},
+			expected: Set{
+				ElemType: StringType,
+				Elems:    []attr.Value{String{Value: "a"}, String{Value: "b"}},
+			},
+		},
+		"invalid": {
+			elems:    []interface{}{"a", true},
+			expected: SetUnknown(StringType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtListIndex(1),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := SetValueFrom(context.Background(), StringType, tc.elems)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-expected, +got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected result (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
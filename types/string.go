@@ -82,3 +82,18 @@ func (s String) IsNull() bool {
 func (s String) IsUnknown() bool {
 	return s.Unknown
 }
+
+// StringNull returns a String with a null value.
+func StringNull() String {
+	return String{Null: true}
+}
+
+// StringUnknown returns a String with an unknown value.
+func StringUnknown() String {
+	return String{Unknown: true}
+}
+
+// StringValue returns a String with a known value.
+func StringValue(value string) String {
+	return String{Value: value}
+}
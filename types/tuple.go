@@ -0,0 +1,317 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ attr.Type                 = TupleType{}
+	_ attr.TypeWithElementTypes = TupleType{}
+	_ attr.Value                = &Tuple{}
+)
+
+// TupleType is an AttributeType representing a tuple. Tuples are an ordered
+// collection of a fixed number of elements, where each element has its own
+// type, which the provider must specify in the ElemTypes property.
+type TupleType struct {
+	ElemTypes []attr.Type
+}
+
+// WithElementTypes returns a new copy of the type with its elements' types
+// set.
+func (t TupleType) WithElementTypes(typs []attr.Type) attr.TypeWithElementTypes {
+	return TupleType{
+		ElemTypes: typs,
+	}
+}
+
+// ElementTypes returns the type's elements' types.
+func (t TupleType) ElementTypes() []attr.Type {
+	return t.ElemTypes
+}
+
+// TerraformType returns the tftypes.Type that should be used to
+// represent this type. This constrains what user input will be
+// accepted and what kind of data can be set in state. The framework
+// will use this to translate the AttributeType to something Terraform
+// can understand.
+func (t TupleType) TerraformType(ctx context.Context) tftypes.Type {
+	elemTypes := make([]tftypes.Type, 0, len(t.ElemTypes))
+	for _, elemType := range t.ElemTypes {
+		elemTypes = append(elemTypes, elemType.TerraformType(ctx))
+	}
+	return tftypes.Tuple{
+		ElementTypes: elemTypes,
+	}
+}
+
+// ValueFromTerraform returns an AttributeValue given a tftypes.Value.
+// This is meant to convert the tftypes.Value into a more convenient Go
+// type for the provider to consume the data with.
+func (t TupleType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	tuple := Tuple{
+		ElemTypes: t.ElemTypes,
+	}
+	if in.Type() == nil {
+		tuple.Null = true
+		return tuple, nil
+	}
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+	if !in.IsKnown() {
+		tuple.Unknown = true
+		return tuple, nil
+	}
+	if in.IsNull() {
+		tuple.Null = true
+		return tuple, nil
+	}
+	val := []tftypes.Value{}
+	err := in.As(&val)
+	if err != nil {
+		return nil, err
+	}
+	if len(val) != len(t.ElemTypes) {
+		return nil, fmt.Errorf("expected %d tuple elements, got %d", len(t.ElemTypes), len(val))
+	}
+	elems := make([]attr.Value, 0, len(val))
+	for pos, elem := range val {
+		av, err := t.ElemTypes[pos].ValueFromTerraform(ctx, elem)
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, av)
+	}
+	tuple.Elems = elems
+	return tuple, nil
+}
+
+// Equal returns true if `o` is also a TupleType and has the same ElemTypes,
+// in the same order.
+func (t TupleType) Equal(o attr.Type) bool {
+	other, ok := o.(TupleType)
+	if !ok {
+		return false
+	}
+	if len(t.ElemTypes) != len(other.ElemTypes) {
+		return false
+	}
+	for pos, elemType := range t.ElemTypes {
+		if elemType == nil || !elemType.Equal(other.ElemTypes[pos]) {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// tuple.
+func (t TupleType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	elementKeyInt, ok := step.(tftypes.ElementKeyInt)
+	if !ok {
+		return nil, fmt.Errorf("cannot apply step %T to TupleType", step)
+	}
+
+	if elementKeyInt < 0 || int(elementKeyInt) >= len(t.ElemTypes) {
+		return nil, fmt.Errorf("no element %d in TupleType with %d elements", elementKeyInt, len(t.ElemTypes))
+	}
+
+	return t.ElemTypes[int(elementKeyInt)], nil
+}
+
+// String returns a human-friendly description of the TupleType.
+func (t TupleType) String() string {
+	var res strings.Builder
+	res.WriteString("types.TupleType[")
+	for pos, elemType := range t.ElemTypes {
+		if pos != 0 {
+			res.WriteString(", ")
+		}
+		res.WriteString(elemType.String())
+	}
+	res.WriteString("]")
+	return res.String()
+}
+
+// Tuple represents a tuple of AttributeValues, where each element has the
+// type at the same position in ElemTypes.
+type Tuple struct {
+	// Unknown will be set to true if the entire tuple is an unknown value.
+	// If only some of the elements in the tuple are unknown, their known
+	// or unknown status will be represented however that AttributeValue
+	// surfaces that information. The Tuple's Unknown property only tracks
+	// if the tuple as a whole is known, not whether the elements that are
+	// in the tuple are known.
+	Unknown bool
+
+	// Null will be set to true if the tuple is null, either because it was
+	// omitted from the configuration, state, or plan, or because it was
+	// explicitly set to null.
+	Null bool
+
+	// Elems are the elements in the tuple.
+	Elems []attr.Value
+
+	// ElemTypes are the types of the elements in the tuple, in order. The
+	// element at each position in Elems must be of the type at the same
+	// position in ElemTypes.
+	ElemTypes []attr.Type
+}
+
+// ElementsAs populates `target` with the elements of the Tuple, throwing an
+// error if the elements cannot be stored in `target`. The target may be a
+// pointer to a slice, whose element type must be able to hold every element,
+// or a pointer to a struct, whose exported fields are populated in the order
+// they are declared. Struct fields tagged with `tfsdk:"-"` are skipped.
+func (t Tuple) ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics {
+	// we need a tftypes.Value for this Tuple to be able to use it with our
+	// reflection code
+	values, err := t.ToTerraformValue(ctx)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Tuple Element Conversion Error",
+				"An unexpected error was encountered trying to convert tuple elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			),
+		}
+	}
+	return reflect.Into(ctx, TupleType{ElemTypes: t.ElemTypes}, values, target, reflect.Options{
+		UnhandledNullAsEmpty:    allowUnhandled,
+		UnhandledUnknownAsEmpty: allowUnhandled,
+	})
+}
+
+// Type returns a TupleType with the same element types as `t`.
+func (t Tuple) Type(_ context.Context) attr.Type {
+	return TupleType{ElemTypes: t.ElemTypes}
+}
+
+// ToTerraformValue returns the data contained in the AttributeValue as
+// a tftypes.Value.
+func (t Tuple) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	tupleType := TupleType{ElemTypes: t.ElemTypes}.TerraformType(ctx)
+	if t.Unknown {
+		return tftypes.NewValue(tupleType, tftypes.UnknownValue), nil
+	}
+	if t.Null {
+		return tftypes.NewValue(tupleType, nil), nil
+	}
+	vals := make([]tftypes.Value, 0, len(t.Elems))
+	for _, elem := range t.Elems {
+		val, err := elem.ToTerraformValue(ctx)
+		if err != nil {
+			return tftypes.NewValue(tupleType, tftypes.UnknownValue), err
+		}
+		vals = append(vals, val)
+	}
+	if err := tftypes.ValidateValue(tupleType, vals); err != nil {
+		return tftypes.NewValue(tupleType, tftypes.UnknownValue), err
+	}
+	return tftypes.NewValue(tupleType, vals), nil
+}
+
+// Equal must return true if the AttributeValue is considered
+// semantically equal to the AttributeValue passed as an argument.
+func (t Tuple) Equal(o attr.Value) bool {
+	other, ok := o.(Tuple)
+	if !ok {
+		return false
+	}
+	if t.Unknown != other.Unknown {
+		return false
+	}
+	if t.Null != other.Null {
+		return false
+	}
+	if !(TupleType{ElemTypes: t.ElemTypes}).Equal(TupleType{ElemTypes: other.ElemTypes}) {
+		return false
+	}
+	if len(t.Elems) != len(other.Elems) {
+		return false
+	}
+	for pos, tElem := range t.Elems {
+		oElem := other.Elems[pos]
+		if !tElem.Equal(oElem) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t Tuple) IsNull() bool {
+	return t.Null
+}
+
+func (t Tuple) IsUnknown() bool {
+	return t.Unknown
+}
+
+// TupleNull returns a Tuple with a null value and the given element types.
+func TupleNull(elemTypes []attr.Type) Tuple {
+	return Tuple{ElemTypes: elemTypes, Null: true}
+}
+
+// TupleUnknown returns a Tuple with an unknown value and the given element
+// types.
+func TupleUnknown(elemTypes []attr.Type) Tuple {
+	return Tuple{ElemTypes: elemTypes, Unknown: true}
+}
+
+// TupleValue returns a Tuple with a known value. An error diagnostic is
+// returned, along with an unknown value, if the number of elements does not
+// match the number of element types, or any element type or element is
+// missing or any element is not of its element type.
+func TupleValue(elemTypes []attr.Type, elems []attr.Value) (Tuple, diag.Diagnostics) {
+	ctx := context.Background()
+
+	if len(elemTypes) != len(elems) {
+		return TupleUnknown(elemTypes), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Invalid Tuple Element Count",
+				"While creating a Tuple value, a mismatched number of elements was detected. "+
+					"A Tuple must contain exactly one element for each element type. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Tuple Element Types: %d\nTuple Elements: %d", len(elemTypes), len(elems)),
+			),
+		}
+	}
+
+	var diags diag.Diagnostics
+
+	for idx, elemType := range elemTypes {
+		elem := elems[idx]
+
+		if elemType == nil || elem == nil {
+			diags.AddError(
+				"Missing Tuple Element",
+				"While creating a Tuple value, a missing element or element type was detected. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Tuple Index: %d", idx),
+			)
+			continue
+		}
+
+		if !elemType.Equal(elem.Type(ctx)) {
+			diags.AddError(
+				"Invalid Tuple Element Type",
+				"While creating a Tuple value, an element with an invalid type was detected. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Tuple Index (%d) Expected Type: %s\nTuple Index (%d) Given Type: %s", idx, elemType, idx, elem.Type(ctx)),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return TupleUnknown(elemTypes), diags
+	}
+
+	return Tuple{ElemTypes: elemTypes, Elems: elems}, diags
+}
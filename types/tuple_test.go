@@ -0,0 +1,584 @@
+package types
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTupleTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input    TupleType
+		expected tftypes.Type
+	}
+	tests := map[string]testCase{
+		"empty": {
+			input: TupleType{},
+			expected: tftypes.Tuple{
+				ElementTypes: []tftypes.Type{},
+			},
+		},
+		"string-number": {
+			input: TupleType{
+				ElemTypes: []attr.Type{StringType, NumberType},
+			},
+			expected: tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+			},
+		},
+		"nested": {
+			input: TupleType{
+				ElemTypes: []attr.Type{
+					ListType{ElemType: StringType},
+					TupleType{ElemTypes: []attr.Type{BoolType}},
+				},
+			},
+			expected: tftypes.Tuple{
+				ElementTypes: []tftypes.Type{
+					tftypes.List{ElementType: tftypes.String},
+					tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.Bool}},
+				},
+			},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.TerraformType(context.Background())
+			if !got.Equal(test.expected) {
+				t.Errorf("Expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestTupleTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	tupleType := TupleType{
+		ElemTypes: []attr.Type{StringType, NumberType},
+	}
+	tfTupleType := tftypes.Tuple{
+		ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+	}
+
+	type testCase struct {
+		receiver    TupleType
+		input       tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"known": {
+			receiver: tupleType,
+			input: tftypes.NewValue(tfTupleType, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.Number, 123),
+			}),
+			expected: Tuple{
+				ElemTypes: []attr.Type{StringType, NumberType},
+				Elems: []attr.Value{
+					String{Value: "hello"},
+					Number{Value: big.NewFloat(123)},
+				},
+			},
+		},
+		"unknown": {
+			receiver: tupleType,
+			input:    tftypes.NewValue(tfTupleType, tftypes.UnknownValue),
+			expected: Tuple{
+				ElemTypes: []attr.Type{StringType, NumberType},
+				Unknown:   true,
+			},
+		},
+		"null": {
+			receiver: tupleType,
+			input:    tftypes.NewValue(tfTupleType, nil),
+			expected: Tuple{
+				ElemTypes: []attr.Type{StringType, NumberType},
+				Null:      true,
+			},
+		},
+		"partially-unknown": {
+			receiver: tupleType,
+			input: tftypes.NewValue(tfTupleType, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				tftypes.NewValue(tftypes.Number, 123),
+			}),
+			expected: Tuple{
+				ElemTypes: []attr.Type{StringType, NumberType},
+				Elems: []attr.Value{
+					String{Unknown: true},
+					Number{Value: big.NewFloat(123)},
+				},
+			},
+		},
+		"wrong-type": {
+			receiver:    tupleType,
+			input:       tftypes.NewValue(tftypes.String, "wrong"),
+			expectedErr: `expected tftypes.Tuple[tftypes.String, tftypes.Number], got tftypes.String`,
+		},
+		"wrong-element-types": {
+			receiver: tupleType,
+			input: tftypes.NewValue(tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.Number, tftypes.String},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, 123),
+				tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			expectedErr: `expected tftypes.Tuple[tftypes.String, tftypes.Number], got tftypes.Tuple[tftypes.Number, tftypes.String]`,
+		},
+		"nil-type": {
+			receiver: tupleType,
+			input:    tftypes.NewValue(nil, nil),
+			expected: Tuple{
+				ElemTypes: []attr.Type{StringType, NumberType},
+				Null:      true,
+			},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotErr := test.receiver.ValueFromTerraform(context.Background(), test.input)
+			if gotErr != nil {
+				if test.expectedErr == "" {
+					t.Errorf("Unexpected error: %s", gotErr.Error())
+					return
+				}
+				if gotErr.Error() != test.expectedErr {
+					t.Errorf("Expected error to be %q, got %q", test.expectedErr, gotErr.Error())
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Errorf("Expected error to be %q, got nil", test.expectedErr)
+				return
+			}
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		receiver TupleType
+		input    attr.Type
+		expected bool
+	}
+	tests := map[string]testCase{
+		"equal": {
+			receiver: TupleType{ElemTypes: []attr.Type{StringType, BoolType}},
+			input:    TupleType{ElemTypes: []attr.Type{StringType, BoolType}},
+			expected: true,
+		},
+		"diff-order": {
+			receiver: TupleType{ElemTypes: []attr.Type{StringType, BoolType}},
+			input:    TupleType{ElemTypes: []attr.Type{BoolType, StringType}},
+			expected: false,
+		},
+		"diff-length": {
+			receiver: TupleType{ElemTypes: []attr.Type{StringType, BoolType}},
+			input:    TupleType{ElemTypes: []attr.Type{StringType}},
+			expected: false,
+		},
+		"wrong-type": {
+			receiver: TupleType{ElemTypes: []attr.Type{StringType}},
+			input:    ListType{ElemType: StringType},
+			expected: false,
+		},
+		"nil-elem-type": {
+			receiver: TupleType{ElemTypes: []attr.Type{nil}},
+			input:    TupleType{ElemTypes: []attr.Type{StringType}},
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.receiver.Equal(test.input)
+			if test.expected != got {
+				t.Errorf("Expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestTupleTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	tupleType := TupleType{ElemTypes: []attr.Type{StringType, BoolType}}
+
+	type testCase struct {
+		step        tftypes.AttributePathStep
+		expected    interface{}
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"element-key-int": {
+			step:     tftypes.ElementKeyInt(1),
+			expected: BoolType,
+		},
+		"element-key-int-out-of-range": {
+			step:        tftypes.ElementKeyInt(2),
+			expectedErr: "no element 2 in TupleType with 2 elements",
+		},
+		"attribute-name": {
+			step:        tftypes.AttributeName("test"),
+			expectedErr: "cannot apply step tftypes.AttributeName to TupleType",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tupleType.ApplyTerraform5AttributePathStep(test.step)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err.Error())
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Errorf("Expected error %q, got nil", test.expectedErr)
+				return
+			}
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleElementsAs_struct(t *testing.T) {
+	t.Parallel()
+
+	type tupleStruct struct {
+		Name    string
+		Count   int64
+		Enabled Bool
+		Ignored string `tfsdk:"-"`
+	}
+
+	var target tupleStruct
+	expected := tupleStruct{
+		Name:    "hello",
+		Count:   123,
+		Enabled: Bool{Value: true},
+	}
+
+	diags := (Tuple{
+		ElemTypes: []attr.Type{StringType, Int64Type, BoolType},
+		Elems: []attr.Value{
+			String{Value: "hello"},
+			Int64{Value: 123},
+			Bool{Value: true},
+		}}).ElementsAs(context.Background(), &target, false)
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	if diff := cmp.Diff(target, expected); diff != "" {
+		t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+	}
+}
+
+func TestTupleElementsAs_attributeValueSlice(t *testing.T) {
+	t.Parallel()
+
+	var target []attr.Value
+	expected := []attr.Value{
+		String{Value: "hello"},
+		Int64{Value: 123},
+	}
+
+	diags := (Tuple{
+		ElemTypes: []attr.Type{StringType, Int64Type},
+		Elems: []attr.Value{
+			String{Value: "hello"},
+			Int64{Value: 123},
+		}}).ElementsAs(context.Background(), &target, false)
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	if diff := cmp.Diff(target, expected); diff != "" {
+		t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+	}
+}
+
+func TestTupleElementsAs_stringSlice(t *testing.T) {
+	t.Parallel()
+
+	var target []string
+	expected := []string{"hello", "world"}
+
+	diags := (Tuple{
+		ElemTypes: []attr.Type{StringType, StringType},
+		Elems: []attr.Value{
+			String{Value: "hello"},
+			String{Value: "world"},
+		}}).ElementsAs(context.Background(), &target, false)
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	if diff := cmp.Diff(target, expected); diff != "" {
+		t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+	}
+}
+
+func TestTupleToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	tfTupleType := tftypes.Tuple{
+		ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool},
+	}
+
+	type testCase struct {
+		input       Tuple
+		expectation tftypes.Value
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"value": {
+			input: Tuple{
+				ElemTypes: []attr.Type{StringType, BoolType},
+				Elems: []attr.Value{
+					String{Value: "hello"},
+					Bool{Value: true},
+				},
+			},
+			expectation: tftypes.NewValue(tfTupleType, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.Bool, true),
+			}),
+		},
+		"unknown": {
+			input: Tuple{
+				ElemTypes: []attr.Type{StringType, BoolType},
+				Unknown:   true,
+			},
+			expectation: tftypes.NewValue(tfTupleType, tftypes.UnknownValue),
+		},
+		"null": {
+			input: Tuple{
+				ElemTypes: []attr.Type{StringType, BoolType},
+				Null:      true,
+			},
+			expectation: tftypes.NewValue(tfTupleType, nil),
+		},
+		"wrong-element-type": {
+			input: Tuple{
+				ElemTypes: []attr.Type{StringType, BoolType},
+				Elems: []attr.Value{
+					Bool{Value: true},
+					String{Value: "hello"},
+				},
+			},
+			expectation: tftypes.NewValue(tfTupleType, tftypes.UnknownValue),
+			expectedErr: "ElementKeyInt(0): can't use tftypes.Bool as tftypes.String",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotErr := test.input.ToTerraformValue(context.Background())
+
+			if test.expectedErr == "" && gotErr != nil {
+				t.Errorf("Unexpected error: %s", gotErr)
+				return
+			}
+
+			if test.expectedErr != "" {
+				if gotErr == nil {
+					t.Errorf("Expected error to be %q, got none", test.expectedErr)
+					return
+				}
+
+				if test.expectedErr != gotErr.Error() {
+					t.Errorf("Expected error to be %q, got %q", test.expectedErr, gotErr.Error())
+					return
+				}
+			}
+
+			if diff := cmp.Diff(test.expectation, got); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleEqual(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		receiver Tuple
+		input    attr.Value
+		expected bool
+	}
+	tests := map[string]testCase{
+		"equal": {
+			receiver: Tuple{
+				ElemTypes: []attr.Type{StringType, BoolType},
+				Elems:     []attr.Value{String{Value: "hello"}, Bool{Value: true}},
+			},
+			input: Tuple{
+				ElemTypes: []attr.Type{StringType, BoolType},
+				Elems:     []attr.Value{String{Value: "hello"}, Bool{Value: true}},
+			},
+			expected: true,
+		},
+		"diff-elems": {
+			receiver: Tuple{
+				ElemTypes: []attr.Type{StringType, BoolType},
+				Elems:     []attr.Value{String{Value: "hello"}, Bool{Value: true}},
+			},
+			input: Tuple{
+				ElemTypes: []attr.Type{StringType, BoolType},
+				Elems:     []attr.Value{String{Value: "hello"}, Bool{Value: false}},
+			},
+			expected: false,
+		},
+		"diff-types": {
+			receiver: Tuple{
+				ElemTypes: []attr.Type{StringType, BoolType},
+				Null:      true,
+			},
+			input: Tuple{
+				ElemTypes: []attr.Type{BoolType, StringType},
+				Null:      true,
+			},
+			expected: false,
+		},
+		"diff-unknown": {
+			receiver: Tuple{
+				ElemTypes: []attr.Type{StringType},
+				Unknown:   true,
+			},
+			input: Tuple{
+				ElemTypes: []attr.Type{StringType},
+				Null:      true,
+			},
+			expected: false,
+		},
+		"wrong-type": {
+			receiver: Tuple{
+				ElemTypes: []attr.Type{StringType},
+				Elems:     []attr.Value{String{Value: "hello"}},
+			},
+			input: List{
+				ElemType: StringType,
+				Elems:    []attr.Value{String{Value: "hello"}},
+			},
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.receiver.Equal(test.input)
+			if test.expected != got {
+				t.Errorf("Expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestTupleValue(t *testing.T) {
+	t.Parallel()
+
+	elemTypes := []attr.Type{StringType, BoolType}
+
+	testCases := map[string]struct {
+		elemTypes     []attr.Type
+		elems         []attr.Value
+		expected      Tuple
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			elemTypes: elemTypes,
+			elems:     []attr.Value{StringValue("a"), BoolUnknown()},
+			expected: Tuple{
+				ElemTypes: elemTypes,
+				Elems:     []attr.Value{String{Value: "a"}, Bool{Unknown: true}},
+			},
+		},
+		"invalid-element-count": {
+			elemTypes: elemTypes,
+			elems:     []attr.Value{StringValue("a")},
+			expected:  TupleUnknown(elemTypes),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Tuple Element Count",
+					"While creating a Tuple value, a mismatched number of elements was detected. "+
+						"A Tuple must contain exactly one element for each element type. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Tuple Element Types: 2\nTuple Elements: 1",
+				),
+			},
+		},
+		"missing-element": {
+			elemTypes: elemTypes,
+			elems:     []attr.Value{StringValue("a"), nil},
+			expected:  TupleUnknown(elemTypes),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Tuple Element",
+					"While creating a Tuple value, a missing element or element type was detected. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Tuple Index: 1",
+				),
+			},
+		},
+		"invalid-element-type": {
+			elemTypes: elemTypes,
+			elems:     []attr.Value{BoolValue(true), BoolValue(true)},
+			expected:  TupleUnknown(elemTypes),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Tuple Element Type",
+					"While creating a Tuple value, an element with an invalid type was detected. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Tuple Index (0) Expected Type: types.StringType\nTuple Index (0) Given Type: types.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := TupleValue(tc.elemTypes, tc.elems)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-expected, +got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected result (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
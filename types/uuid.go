@@ -0,0 +1,115 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ attr.ValueWithSemanticEquals = UUID{}
+)
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func parseUUID(s string) (interface{}, error) {
+	if !uuidRegexp.MatchString(s) {
+		return nil, errors.New("expected 32 hexadecimal digits in 8-4-4-4-12 format")
+	}
+
+	return strings.ToLower(s), nil
+}
+
+func uuidEqual(a, b interface{}) bool {
+	return a.(string) == b.(string)
+}
+
+// UUID represents a string containing a UUID, such as
+// "f81d4fae-7dec-11d0-a765-00a0c91e6bf6". UUIDs which differ only in letter
+// case are semantically equal.
+type UUID struct {
+	// Unknown will be true if the value is not yet known.
+	Unknown bool
+
+	// Null will be true if the value was not set, or was explicitly set to
+	// null.
+	Null bool
+
+	// Value contains the set value, as long as Unknown and Null are both
+	// false.
+	Value string
+}
+
+// Type returns a UUIDType.
+func (u UUID) Type(_ context.Context) attr.Type {
+	return UUIDType
+}
+
+// ToTerraformValue returns the data contained in the UUID as a
+// tftypes.Value.
+func (u UUID) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	return formattedStringToTerraformValue(u.Unknown, u.Null, u.Value)
+}
+
+// Equal returns true if `other` is a UUID and has the same value as `u`.
+func (u UUID) Equal(other attr.Value) bool {
+	o, ok := other.(UUID)
+	if !ok {
+		return false
+	}
+	if u.Unknown != o.Unknown {
+		return false
+	}
+	if u.Null != o.Null {
+		return false
+	}
+	return u.Value == o.Value
+}
+
+// SemanticEquals returns true if `prior` is a UUID containing the same UUID as
+// `u`, ignoring letter case.
+func (u UUID) SemanticEquals(_ context.Context, prior attr.Value) (bool, diag.Diagnostics) {
+	o, ok := prior.(UUID)
+	if !ok {
+		return false, nil
+	}
+	return formattedStringSemanticEquals(u.Value, o.Value, parseUUID, uuidEqual), nil
+}
+
+// IsNull returns true if the UUID represents a null value.
+func (u UUID) IsNull() bool {
+	return u.Null
+}
+
+// IsUnknown returns true if the UUID represents an unknown value.
+func (u UUID) IsUnknown() bool {
+	return u.Unknown
+}
+
+// UUIDNull returns a UUID with a null value.
+func UUIDNull() UUID {
+	return UUID{Null: true}
+}
+
+// UUIDUnknown returns a UUID with an unknown value.
+func UUIDUnknown() UUID {
+	return UUID{Unknown: true}
+}
+
+// UUIDValue returns a UUID with a known value. An error diagnostic is
+// returned, along with an unknown value, if the value is not a valid
+// UUID.
+func UUIDValue(value string) (UUID, diag.Diagnostics) {
+	diags := formattedStringValidateValue(UUIDType, value)
+
+	if diags.HasError() {
+		return UUIDUnknown(), diags
+	}
+
+	return UUID{Value: value}, diags
+}
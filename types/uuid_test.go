@@ -0,0 +1,56 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+func TestUUIDSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    UUID
+		prior    attr.Value
+		expected bool
+	}{
+		"equal-case": {
+			value:    UUID{Value: "F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6"},
+			prior:    UUID{Value: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+			expected: true,
+		},
+		"different-uuid": {
+			value:    UUID{Value: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+			prior:    UUID{Value: "f81d4fae-7dec-11d0-a765-00a0c91e6bf7"},
+			expected: false,
+		},
+		"invalid": {
+			value:    UUID{Value: "{f81d4fae-7dec-11d0-a765-00a0c91e6bf6}"},
+			prior:    UUID{Value: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+			expected: false,
+		},
+		"different-type": {
+			value:    UUID{Value: "F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6"},
+			prior:    String{Value: "F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6"},
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tc.value.SemanticEquals(context.Background(), tc.prior)
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			if got != tc.expected {
+				t.Errorf("Expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.AttributeValidator = attributeCombinationValidator{}
+
+// AlsoRequires returns an AttributeValidator which ensures that if the
+// attribute is configured, the attributes matching the given expressions are
+// also configured. Relative expressions are resolved from the current
+// attribute, such as path.MatchRelative().AtParent().AtName("other") for a
+// sibling attribute.
+func AlsoRequires(expressions ...path.Expression) tfsdk.AttributeValidator {
+	return attributeCombinationValidator{
+		expressions: expressions,
+		mode:        combinationRequiredTogether,
+	}
+}
+
+// AtLeastOneOf returns an AttributeValidator which ensures that at least one
+// of the attribute and the attributes matching the given expressions is
+// configured. Relative expressions are resolved from the current attribute.
+func AtLeastOneOf(expressions ...path.Expression) tfsdk.AttributeValidator {
+	return attributeCombinationValidator{
+		expressions: expressions,
+		mode:        combinationAtLeastOneOf,
+	}
+}
+
+// ConflictsWith returns an AttributeValidator which ensures that if the
+// attribute is configured, none of the attributes matching the given
+// expressions are configured. Relative expressions are resolved from the
+// current attribute.
+func ConflictsWith(expressions ...path.Expression) tfsdk.AttributeValidator {
+	return attributeCombinationValidator{
+		expressions: expressions,
+		mode:        combinationConflicting,
+	}
+}
+
+// ExactlyOneOf returns an AttributeValidator which ensures that exactly one
+// of the attribute and the attributes matching the given expressions is
+// configured. Relative expressions are resolved from the current attribute.
+func ExactlyOneOf(expressions ...path.Expression) tfsdk.AttributeValidator {
+	return attributeCombinationValidator{
+		expressions: expressions,
+		mode:        combinationExactlyOneOf,
+	}
+}
+
+// attributeCombinationValidator validates the combination of an attribute
+// with other attributes in the configuration.
+type attributeCombinationValidator struct {
+	expressions path.Expressions
+	mode        combinationMode
+}
+
+// Description describes the validation in plain text formatting.
+func (v attributeCombinationValidator) Description(_ context.Context) string {
+	return v.mode.description(fmt.Sprintf("this attribute and the attributes %s", v.expressions))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v attributeCombinationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+//
+// Unknown values may become either null or known values later, so they
+// never cause an error on their own.
+func (v attributeCombinationValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	if req.AttributeConfig == nil {
+		return
+	}
+
+	expressions := req.AttributePath.Expression().MergeExpressions(v.expressions...)
+
+	values, diags := configValues(ctx, req.Config, expressions)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
+	}
+
+	self := configValue{
+		path:  req.AttributePath,
+		value: req.AttributeConfig,
+	}
+
+	others := make([]configValue, 0, len(values))
+
+	for _, value := range values {
+		if value.path.Equal(req.AttributePath) {
+			continue
+		}
+
+		others = append(others, value)
+	}
+
+	// The attribute itself is included in messages about the collection.
+	collection := path.Expressions{req.AttributePath.Expression()}
+	collection.Append(expressions...)
+
+	switch v.mode {
+	case combinationAtLeastOneOf:
+		if !self.value.IsNull() {
+			return
+		}
+
+		for _, other := range others {
+			if !other.value.IsNull() {
+				return
+			}
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("At least one attribute out of %s must be specified", collection),
+		)
+	case combinationConflicting:
+		if !self.configured() {
+			return
+		}
+
+		for _, other := range others {
+			if !other.configured() {
+				continue
+			}
+
+			resp.Diagnostics.AddAttributeError(
+				req.AttributePath,
+				"Invalid Attribute Combination",
+				fmt.Sprintf("Attribute %q cannot be specified when %q is specified", other.path, req.AttributePath),
+			)
+		}
+	case combinationExactlyOneOf:
+		var configuredCount, unknownCount int
+
+		for _, value := range append(others, self) {
+			switch {
+			case value.value.IsUnknown():
+				unknownCount++
+			case !value.value.IsNull():
+				configuredCount++
+			}
+		}
+
+		if configuredCount == 0 && unknownCount == 0 {
+			resp.Diagnostics.AddAttributeError(
+				req.AttributePath,
+				"Invalid Attribute Combination",
+				fmt.Sprintf("No attribute specified when one (and only one) of %s is required", collection),
+			)
+		}
+
+		if configuredCount > 1 {
+			resp.Diagnostics.AddAttributeError(
+				req.AttributePath,
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%d attributes specified when one (and only one) of %s is required", configuredCount, collection),
+			)
+		}
+	case combinationRequiredTogether:
+		if !self.configured() {
+			return
+		}
+
+		for _, other := range others {
+			if !other.value.IsNull() {
+				continue
+			}
+
+			resp.Diagnostics.AddAttributeError(
+				req.AttributePath,
+				"Invalid Attribute Combination",
+				fmt.Sprintf("Attribute %q must be specified when %q is specified", other.path, req.AttributePath),
+			)
+		}
+	}
+}
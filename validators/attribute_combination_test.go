@@ -0,0 +1,287 @@
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAttributeCombinationValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator       tfsdk.AttributeValidator
+		attributeConfig attr.Value
+		config          tfsdk.Config
+		expectedDiags   diag.Diagnostics
+	}{
+		"also-requires-null": {
+			validator:       validators.AlsoRequires(path.MatchRelative().AtParent().AtName("two")),
+			attributeConfig: types.String{Null: true},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, nil),
+					"two":   tftypes.NewValue(tftypes.String, nil),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		},
+		"also-requires-unknown": {
+			validator:       validators.AlsoRequires(path.MatchRelative().AtParent().AtName("two")),
+			attributeConfig: types.String{Unknown: true},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"two":   tftypes.NewValue(tftypes.String, nil),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		},
+		"also-requires-valid": {
+			validator:       validators.AlsoRequires(path.MatchRelative().AtParent().AtName("two")),
+			attributeConfig: types.String{Value: "a"},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, "a"),
+					"two":   tftypes.NewValue(tftypes.String, "b"),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		},
+		"also-requires-other-unknown": {
+			validator:       validators.AlsoRequires(path.MatchRelative().AtParent().AtName("two")),
+			attributeConfig: types.String{Value: "a"},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, "a"),
+					"two":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		},
+		"also-requires-invalid": {
+			validator:       validators.AlsoRequires(path.MatchRelative().AtParent().AtName("two")),
+			attributeConfig: types.String{Value: "a"},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, "a"),
+					"two":   tftypes.NewValue(tftypes.String, nil),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("one"),
+					"Invalid Attribute Combination",
+					"Attribute \"two\" must be specified when \"one\" is specified",
+				),
+			},
+		},
+		"at-least-one-of-valid-self": {
+			validator:       validators.AtLeastOneOf(path.MatchRelative().AtParent().AtName("two")),
+			attributeConfig: types.String{Value: "a"},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, "a"),
+					"two":   tftypes.NewValue(tftypes.String, nil),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		},
+		"at-least-one-of-valid-other": {
+			validator:       validators.AtLeastOneOf(path.MatchRelative().AtParent().AtName("two")),
+			attributeConfig: types.String{Null: true},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, nil),
+					"two":   tftypes.NewValue(tftypes.String, "b"),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		},
+		"at-least-one-of-unknown": {
+			validator:       validators.AtLeastOneOf(path.MatchRelative().AtParent().AtName("two")),
+			attributeConfig: types.String{Null: true},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, nil),
+					"two":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		},
+		"at-least-one-of-invalid": {
+			validator:       validators.AtLeastOneOf(path.MatchRelative().AtParent().AtName("two")),
+			attributeConfig: types.String{Null: true},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, nil),
+					"two":   tftypes.NewValue(tftypes.String, nil),
+					"three": tftypes.NewValue(tftypes.String, "c"),
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("one"),
+					"Invalid Attribute Combination",
+					"At least one attribute out of [one,two] must be specified",
+				),
+			},
+		},
+		"conflicts-with-null": {
+			validator:       validators.ConflictsWith(path.MatchRelative().AtParent().AtName("two")),
+			attributeConfig: types.String{Null: true},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, nil),
+					"two":   tftypes.NewValue(tftypes.String, "b"),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		},
+		"conflicts-with-valid": {
+			validator:       validators.ConflictsWith(path.MatchRelative().AtParent().AtName("two")),
+			attributeConfig: types.String{Value: "a"},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, "a"),
+					"two":   tftypes.NewValue(tftypes.String, nil),
+					"three": tftypes.NewValue(tftypes.String, "c"),
+				}),
+			},
+		},
+		"conflicts-with-unknown": {
+			validator:       validators.ConflictsWith(path.MatchRelative().AtParent().AtName("two")),
+			attributeConfig: types.String{Value: "a"},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, "a"),
+					"two":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		},
+		"conflicts-with-invalid": {
+			validator:       validators.ConflictsWith(path.MatchRelative().AtParent().AtName("two")),
+			attributeConfig: types.String{Value: "a"},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, "a"),
+					"two":   tftypes.NewValue(tftypes.String, "b"),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("one"),
+					"Invalid Attribute Combination",
+					"Attribute \"two\" cannot be specified when \"one\" is specified",
+				),
+			},
+		},
+		"exactly-one-of-valid": {
+			validator:       validators.ExactlyOneOf(path.MatchRelative().AtParent().AtName("two")),
+			attributeConfig: types.String{Value: "a"},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, "a"),
+					"two":   tftypes.NewValue(tftypes.String, nil),
+					"three": tftypes.NewValue(tftypes.String, "c"),
+				}),
+			},
+		},
+		"exactly-one-of-unknown": {
+			validator:       validators.ExactlyOneOf(path.MatchRelative().AtParent().AtName("two")),
+			attributeConfig: types.String{Null: true},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, nil),
+					"two":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		},
+		"exactly-one-of-invalid-none": {
+			validator:       validators.ExactlyOneOf(path.MatchRelative().AtParent().AtName("two")),
+			attributeConfig: types.String{Null: true},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, nil),
+					"two":   tftypes.NewValue(tftypes.String, nil),
+					"three": tftypes.NewValue(tftypes.String, "c"),
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("one"),
+					"Invalid Attribute Combination",
+					"No attribute specified when one (and only one) of [one,two] is required",
+				),
+			},
+		},
+		"exactly-one-of-invalid-multiple": {
+			validator:       validators.ExactlyOneOf(path.MatchRelative().AtParent().AtName("two"), path.MatchRoot("three")),
+			attributeConfig: types.String{Value: "a"},
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, "a"),
+					"two":   tftypes.NewValue(tftypes.String, "b"),
+					"three": tftypes.NewValue(tftypes.String, "c"),
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("one"),
+					"Invalid Attribute Combination",
+					"3 attributes specified when one (and only one) of [one,two,three] is required",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := tfsdk.ValidateAttributeRequest{
+				AttributePath:   path.Root("one"),
+				AttributeConfig: testCase.attributeConfig,
+				Config:          testCase.config,
+			}
+			resp := &tfsdk.ValidateAttributeResponse{}
+
+			testCase.validator.Validate(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var (
+	_ tfsdk.DataSourceConfigValidator = dataSourceConfigCombinationValidator{}
+	_ tfsdk.ProviderConfigValidator   = providerConfigCombinationValidator{}
+	_ tfsdk.ResourceConfigValidator   = resourceConfigCombinationValidator{}
+)
+
+// combinationMode is the kind of check performed across a combination of
+// attributes.
+type combinationMode int
+
+const (
+	// combinationAtLeastOneOf requires at least one attribute to be
+	// configured.
+	combinationAtLeastOneOf combinationMode = iota
+
+	// combinationConflicting requires at most one attribute to be
+	// configured.
+	combinationConflicting
+
+	// combinationExactlyOneOf requires exactly one attribute to be
+	// configured.
+	combinationExactlyOneOf
+
+	// combinationRequiredTogether requires all or none of the attributes to
+	// be configured.
+	combinationRequiredTogether
+)
+
+// description describes the check of the given attributes, such as
+// "the attributes [one,two] must be configured together".
+func (m combinationMode) description(attributes string) string {
+	switch m {
+	case combinationAtLeastOneOf:
+		return fmt.Sprintf("at least one of %s must be configured", attributes)
+	case combinationConflicting:
+		return fmt.Sprintf("%s cannot be configured together", attributes)
+	case combinationExactlyOneOf:
+		return fmt.Sprintf("exactly one of %s must be configured", attributes)
+	case combinationRequiredTogether:
+		return fmt.Sprintf("%s must be configured together", attributes)
+	default:
+		return ""
+	}
+}
+
+// DataSourceAtLeastOneOf returns a DataSourceConfigValidator which ensures
+// that at least one of the attributes matching the given expressions is
+// configured.
+func DataSourceAtLeastOneOf(expressions ...path.Expression) tfsdk.DataSourceConfigValidator {
+	return dataSourceConfigCombinationValidator{newConfigCombinationValidator(combinationAtLeastOneOf, expressions)}
+}
+
+// DataSourceConflicting returns a DataSourceConfigValidator which ensures
+// that at most one of the attributes matching the given expressions is
+// configured.
+func DataSourceConflicting(expressions ...path.Expression) tfsdk.DataSourceConfigValidator {
+	return dataSourceConfigCombinationValidator{newConfigCombinationValidator(combinationConflicting, expressions)}
+}
+
+// DataSourceExactlyOneOf returns a DataSourceConfigValidator which ensures
+// that exactly one of the attributes matching the given expressions is
+// configured.
+func DataSourceExactlyOneOf(expressions ...path.Expression) tfsdk.DataSourceConfigValidator {
+	return dataSourceConfigCombinationValidator{newConfigCombinationValidator(combinationExactlyOneOf, expressions)}
+}
+
+// DataSourceRequiredTogether returns a DataSourceConfigValidator which
+// ensures that either all or none of the attributes matching the given
+// expressions are configured.
+func DataSourceRequiredTogether(expressions ...path.Expression) tfsdk.DataSourceConfigValidator {
+	return dataSourceConfigCombinationValidator{newConfigCombinationValidator(combinationRequiredTogether, expressions)}
+}
+
+// ProviderAtLeastOneOf returns a ProviderConfigValidator which ensures that
+// at least one of the attributes matching the given expressions is
+// configured.
+func ProviderAtLeastOneOf(expressions ...path.Expression) tfsdk.ProviderConfigValidator {
+	return providerConfigCombinationValidator{newConfigCombinationValidator(combinationAtLeastOneOf, expressions)}
+}
+
+// ProviderConflicting returns a ProviderConfigValidator which ensures that at
+// most one of the attributes matching the given expressions is configured.
+func ProviderConflicting(expressions ...path.Expression) tfsdk.ProviderConfigValidator {
+	return providerConfigCombinationValidator{newConfigCombinationValidator(combinationConflicting, expressions)}
+}
+
+// ProviderExactlyOneOf returns a ProviderConfigValidator which ensures that
+// exactly one of the attributes matching the given expressions is
+// configured.
+func ProviderExactlyOneOf(expressions ...path.Expression) tfsdk.ProviderConfigValidator {
+	return providerConfigCombinationValidator{newConfigCombinationValidator(combinationExactlyOneOf, expressions)}
+}
+
+// ProviderRequiredTogether returns a ProviderConfigValidator which ensures
+// that either all or none of the attributes matching the given expressions
+// are configured.
+func ProviderRequiredTogether(expressions ...path.Expression) tfsdk.ProviderConfigValidator {
+	return providerConfigCombinationValidator{newConfigCombinationValidator(combinationRequiredTogether, expressions)}
+}
+
+// ResourceAtLeastOneOf returns a ResourceConfigValidator which ensures that
+// at least one of the attributes matching the given expressions is
+// configured.
+func ResourceAtLeastOneOf(expressions ...path.Expression) tfsdk.ResourceConfigValidator {
+	return resourceConfigCombinationValidator{newConfigCombinationValidator(combinationAtLeastOneOf, expressions)}
+}
+
+// ResourceConflicting returns a ResourceConfigValidator which ensures that at
+// most one of the attributes matching the given expressions is configured.
+func ResourceConflicting(expressions ...path.Expression) tfsdk.ResourceConfigValidator {
+	return resourceConfigCombinationValidator{newConfigCombinationValidator(combinationConflicting, expressions)}
+}
+
+// ResourceExactlyOneOf returns a ResourceConfigValidator which ensures that
+// exactly one of the attributes matching the given expressions is
+// configured.
+func ResourceExactlyOneOf(expressions ...path.Expression) tfsdk.ResourceConfigValidator {
+	return resourceConfigCombinationValidator{newConfigCombinationValidator(combinationExactlyOneOf, expressions)}
+}
+
+// ResourceRequiredTogether returns a ResourceConfigValidator which ensures
+// that either all or none of the attributes matching the given expressions
+// are configured.
+func ResourceRequiredTogether(expressions ...path.Expression) tfsdk.ResourceConfigValidator {
+	return resourceConfigCombinationValidator{newConfigCombinationValidator(combinationRequiredTogether, expressions)}
+}
+
+// configCombinationValidator implements the validation logic shared by the
+// data source, provider, and resource configuration validators.
+type configCombinationValidator struct {
+	expressions path.Expressions
+	mode        combinationMode
+}
+
+// newConfigCombinationValidator returns a configCombinationValidator with
+// duplicate expressions removed.
+func newConfigCombinationValidator(mode combinationMode, expressions []path.Expression) configCombinationValidator {
+	var v configCombinationValidator
+
+	v.expressions.Append(expressions...)
+	v.mode = mode
+
+	return v
+}
+
+// Description describes the validation in plain text formatting.
+func (v configCombinationValidator) Description(_ context.Context) string {
+	return v.mode.description(fmt.Sprintf("the attributes %s", v.expressions))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v configCombinationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// validate performs the validation against the given configuration.
+//
+// Unknown values may become either null or known values later, so they
+// never cause an error on their own. For example, two attributes conflict
+// only when both are known and not null.
+func (v configCombinationValidator) validate(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	values, diags := configValues(ctx, config, v.expressions)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	var configuredCount, nullCount, unknownCount int
+
+	for _, value := range values {
+		switch {
+		case value.value.IsUnknown():
+			unknownCount++
+		case value.value.IsNull():
+			nullCount++
+		default:
+			configuredCount++
+		}
+	}
+
+	var invalid bool
+
+	switch v.mode {
+	case combinationAtLeastOneOf:
+		invalid = configuredCount == 0 && unknownCount == 0
+	case combinationConflicting:
+		invalid = configuredCount > 1
+	case combinationExactlyOneOf:
+		invalid = configuredCount > 1 || (configuredCount == 0 && unknownCount == 0)
+	case combinationRequiredTogether:
+		invalid = configuredCount > 0 && nullCount > 0
+	}
+
+	if invalid {
+		diags.AddError(
+			"Invalid Attribute Combination",
+			sentence(v.Description(ctx)),
+		)
+	}
+
+	return diags
+}
+
+// dataSourceConfigCombinationValidator implements
+// tfsdk.DataSourceConfigValidator.
+type dataSourceConfigCombinationValidator struct {
+	configCombinationValidator
+}
+
+// Validate performs the validation.
+func (v dataSourceConfigCombinationValidator) Validate(ctx context.Context, req tfsdk.ValidateDataSourceConfigRequest, resp *tfsdk.ValidateDataSourceConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+// providerConfigCombinationValidator implements
+// tfsdk.ProviderConfigValidator.
+type providerConfigCombinationValidator struct {
+	configCombinationValidator
+}
+
+// Validate performs the validation.
+func (v providerConfigCombinationValidator) Validate(ctx context.Context, req tfsdk.ValidateProviderConfigRequest, resp *tfsdk.ValidateProviderConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+// resourceConfigCombinationValidator implements
+// tfsdk.ResourceConfigValidator.
+type resourceConfigCombinationValidator struct {
+	configCombinationValidator
+}
+
+// Validate performs the validation.
+func (v resourceConfigCombinationValidator) Validate(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
@@ -0,0 +1,313 @@
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	testCombinationSchema = tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"one": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"two": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"three": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testCombinationType = tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"one":   tftypes.String,
+			"two":   tftypes.String,
+			"three": tftypes.String,
+		},
+	}
+)
+
+func TestResourceConfigCombinationValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator     tfsdk.ResourceConfigValidator
+		config        tfsdk.Config
+		expectedDiags diag.Diagnostics
+	}{
+		"at-least-one-of-valid": {
+			validator: validators.ResourceAtLeastOneOf(path.MatchRoot("one"), path.MatchRoot("two")),
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, "a"),
+					"two":   tftypes.NewValue(tftypes.String, nil),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		},
+		"at-least-one-of-unknown": {
+			validator: validators.ResourceAtLeastOneOf(path.MatchRoot("one"), path.MatchRoot("two")),
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, nil),
+					"two":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		},
+		"at-least-one-of-invalid": {
+			validator: validators.ResourceAtLeastOneOf(path.MatchRoot("one"), path.MatchRoot("two")),
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, nil),
+					"two":   tftypes.NewValue(tftypes.String, nil),
+					"three": tftypes.NewValue(tftypes.String, "c"),
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Combination",
+					"At least one of the attributes [one,two] must be configured.",
+				),
+			},
+		},
+		"conflicting-valid": {
+			validator: validators.ResourceConflicting(path.MatchRoot("one"), path.MatchRoot("two")),
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, "a"),
+					"two":   tftypes.NewValue(tftypes.String, nil),
+					"three": tftypes.NewValue(tftypes.String, "c"),
+				}),
+			},
+		},
+		"conflicting-unknown": {
+			validator: validators.ResourceConflicting(path.MatchRoot("one"), path.MatchRoot("two")),
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, "a"),
+					"two":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		},
+		"conflicting-invalid": {
+			validator: validators.ResourceConflicting(path.MatchRoot("one"), path.MatchRoot("two")),
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, "a"),
+					"two":   tftypes.NewValue(tftypes.String, "b"),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Combination",
+					"The attributes [one,two] cannot be configured together.",
+				),
+			},
+		},
+		"exactly-one-of-valid": {
+			validator: validators.ResourceExactlyOneOf(path.MatchRoot("one"), path.MatchRoot("two")),
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, nil),
+					"two":   tftypes.NewValue(tftypes.String, "b"),
+					"three": tftypes.NewValue(tftypes.String, "c"),
+				}),
+			},
+		},
+		"exactly-one-of-unknown": {
+			validator: validators.ResourceExactlyOneOf(path.MatchRoot("one"), path.MatchRoot("two")),
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, nil),
+					"two":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		},
+		"exactly-one-of-invalid-none": {
+			validator: validators.ResourceExactlyOneOf(path.MatchRoot("one"), path.MatchRoot("two")),
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, nil),
+					"two":   tftypes.NewValue(tftypes.String, nil),
+					"three": tftypes.NewValue(tftypes.String, "c"),
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Combination",
+					"Exactly one of the attributes [one,two] must be configured.",
+				),
+			},
+		},
+		"exactly-one-of-invalid-multiple": {
+			validator: validators.ResourceExactlyOneOf(path.MatchRoot("one"), path.MatchRoot("two")),
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, "a"),
+					"two":   tftypes.NewValue(tftypes.String, "b"),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Combination",
+					"Exactly one of the attributes [one,two] must be configured.",
+				),
+			},
+		},
+		"required-together-valid-all": {
+			validator: validators.ResourceRequiredTogether(path.MatchRoot("one"), path.MatchRoot("two")),
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, "a"),
+					"two":   tftypes.NewValue(tftypes.String, "b"),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		},
+		"required-together-valid-none": {
+			validator: validators.ResourceRequiredTogether(path.MatchRoot("one"), path.MatchRoot("two")),
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, nil),
+					"two":   tftypes.NewValue(tftypes.String, nil),
+					"three": tftypes.NewValue(tftypes.String, "c"),
+				}),
+			},
+		},
+		"required-together-unknown": {
+			validator: validators.ResourceRequiredTogether(path.MatchRoot("one"), path.MatchRoot("two")),
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, nil),
+					"two":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		},
+		"required-together-invalid": {
+			validator: validators.ResourceRequiredTogether(path.MatchRoot("one"), path.MatchRoot("two")),
+			config: tfsdk.Config{
+				Schema: testCombinationSchema,
+				Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+					"one":   tftypes.NewValue(tftypes.String, "a"),
+					"two":   tftypes.NewValue(tftypes.String, nil),
+					"three": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Combination",
+					"The attributes [one,two] must be configured together.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := tfsdk.ValidateResourceConfigRequest{
+				Config: testCase.config,
+			}
+			resp := &tfsdk.ValidateResourceConfigResponse{}
+
+			testCase.validator.Validate(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDataSourceConfigCombinationValidators(t *testing.T) {
+	t.Parallel()
+
+	req := tfsdk.ValidateDataSourceConfigRequest{
+		Config: tfsdk.Config{
+			Schema: testCombinationSchema,
+			Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+				"one":   tftypes.NewValue(tftypes.String, "a"),
+				"two":   tftypes.NewValue(tftypes.String, "b"),
+				"three": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+	}
+	resp := &tfsdk.ValidateDataSourceConfigResponse{}
+
+	validators.DataSourceConflicting(path.MatchRoot("one"), path.MatchRoot("two")).Validate(context.Background(), req, resp)
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Invalid Attribute Combination",
+			"The attributes [one,two] cannot be configured together.",
+		),
+	}
+
+	if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
+
+func TestProviderConfigCombinationValidators(t *testing.T) {
+	t.Parallel()
+
+	req := tfsdk.ValidateProviderConfigRequest{
+		Config: tfsdk.Config{
+			Schema: testCombinationSchema,
+			Raw: tftypes.NewValue(testCombinationType, map[string]tftypes.Value{
+				"one":   tftypes.NewValue(tftypes.String, "a"),
+				"two":   tftypes.NewValue(tftypes.String, nil),
+				"three": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+	}
+	resp := &tfsdk.ValidateProviderConfigResponse{}
+
+	validators.ProviderRequiredTogether(path.MatchRoot("one"), path.MatchRoot("two")).Validate(context.Background(), req, resp)
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Invalid Attribute Combination",
+			"The attributes [one,two] must be configured together.",
+		),
+	}
+
+	if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
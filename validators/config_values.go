@@ -0,0 +1,65 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// configValue is a configuration value at a path matched by an expression.
+type configValue struct {
+	path  path.Path
+	value attr.Value
+}
+
+// configured returns true if the value is known and not null.
+func (v configValue) configured() bool {
+	return !v.value.IsNull() && !v.value.IsUnknown()
+}
+
+// configValues returns the configuration values at all paths matching the
+// given expressions. Each matched path is only returned once.
+func configValues(ctx context.Context, config tfsdk.Config, expressions path.Expressions) ([]configValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var matchedPaths path.Paths
+
+	for _, expression := range expressions {
+		paths, matchesDiags := config.PathMatches(ctx, expression)
+
+		diags.Append(matchesDiags...)
+
+		if matchesDiags.HasError() {
+			continue
+		}
+
+		matchedPaths.Append(paths...)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	values := make([]configValue, 0, len(matchedPaths))
+
+	for _, matchedPath := range matchedPaths {
+		var value attr.Value
+
+		getDiags := config.GetAttribute(ctx, matchedPath, &value)
+
+		diags.Append(getDiags...)
+
+		if getDiags.HasError() {
+			continue
+		}
+
+		values = append(values, configValue{
+			path:  matchedPath,
+			value: value,
+		})
+	}
+
+	return values, diags
+}
@@ -0,0 +1,54 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// boundsDescription returns a description of a validator enforcing a
+// minimum, maximum, or both, such as "string length must be at least 1". An
+// empty min or max signals that the bound is not enforced.
+func boundsDescription(subject string, min string, max string) string {
+	switch {
+	case min != "" && max != "":
+		return fmt.Sprintf("%s must be between %s and %s", subject, min, max)
+	case min != "":
+		return fmt.Sprintf("%s must be at least %s", subject, min)
+	case max != "":
+		return fmt.Sprintf("%s must be at most %s", subject, max)
+	default:
+		return ""
+	}
+}
+
+// quotedList returns the given values quoted and separated by commas. If
+// markdown is true, each value is additionally wrapped in backticks.
+func quotedList(values []string, markdown bool) string {
+	quoted := make([]string, 0, len(values))
+
+	for _, value := range values {
+		if markdown {
+			quoted = append(quoted, fmt.Sprintf("`%q`", value))
+
+			continue
+		}
+
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+// sentence returns the given description as a sentence for diagnostic
+// details, with an uppercase first letter and a trailing period.
+func sentence(description string) string {
+	if description == "" {
+		return description
+	}
+
+	r, size := utf8.DecodeRuneInString(description)
+
+	return string(unicode.ToUpper(r)) + description[size:] + "."
+}
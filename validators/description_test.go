@@ -0,0 +1,98 @@
+package validators_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators"
+)
+
+func TestValidatorDescriptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator                   tfsdk.AttributeValidator
+		expectedDescription         string
+		expectedMarkdownDescription string
+	}{
+		"AlsoRequires": {
+			validator:                   validators.AlsoRequires(path.MatchRoot("other")),
+			expectedDescription:         "this attribute and the attributes [other] must be configured together",
+			expectedMarkdownDescription: "this attribute and the attributes [other] must be configured together",
+		},
+		"AtLeastOneOf": {
+			validator:                   validators.AtLeastOneOf(path.MatchRoot("other")),
+			expectedDescription:         "at least one of this attribute and the attributes [other] must be configured",
+			expectedMarkdownDescription: "at least one of this attribute and the attributes [other] must be configured",
+		},
+		"ConflictsWith": {
+			validator:                   validators.ConflictsWith(path.MatchRoot("other")),
+			expectedDescription:         "this attribute and the attributes [other] cannot be configured together",
+			expectedMarkdownDescription: "this attribute and the attributes [other] cannot be configured together",
+		},
+		"ExactlyOneOf": {
+			validator:                   validators.ExactlyOneOf(path.MatchRoot("other")),
+			expectedDescription:         "exactly one of this attribute and the attributes [other] must be configured",
+			expectedMarkdownDescription: "exactly one of this attribute and the attributes [other] must be configured",
+		},
+		"Float64Between": {
+			validator:                   validators.Float64Between(0.5, 1.5),
+			expectedDescription:         "value must be between 0.5 and 1.5",
+			expectedMarkdownDescription: "value must be between 0.5 and 1.5",
+		},
+		"Int64AtLeast": {
+			validator:                   validators.Int64AtLeast(1),
+			expectedDescription:         "value must be at least 1",
+			expectedMarkdownDescription: "value must be at least 1",
+		},
+		"ListSizeAtMost": {
+			validator:                   validators.ListSizeAtMost(3),
+			expectedDescription:         "list size must be at most 3",
+			expectedMarkdownDescription: "list size must be at most 3",
+		},
+		"ListUniqueValues": {
+			validator:                   validators.ListUniqueValues(),
+			expectedDescription:         "all list elements must be unique",
+			expectedMarkdownDescription: "all list elements must be unique",
+		},
+		"StringIsURL": {
+			validator:                   validators.StringIsURL("https"),
+			expectedDescription:         `value must be a valid URL with scheme: "https"`,
+			expectedMarkdownDescription: "value must be a valid URL with scheme: `\"https\"`",
+		},
+		"StringLengthBetween": {
+			validator:                   validators.StringLengthBetween(1, 3),
+			expectedDescription:         "string length must be between 1 and 3",
+			expectedMarkdownDescription: "string length must be between 1 and 3",
+		},
+		"StringOneOf": {
+			validator:                   validators.StringOneOf("one", "two"),
+			expectedDescription:         `value must be one of: "one", "two"`,
+			expectedMarkdownDescription: "value must be one of: `\"one\"`, `\"two\"`",
+		},
+		"StringRegexMatches": {
+			validator:                   validators.StringRegexMatches(regexp.MustCompile(`^[a-z]+$`), ""),
+			expectedDescription:         "value must match regular expression '^[a-z]+$'",
+			expectedMarkdownDescription: "value must match regular expression `^[a-z]+$`",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.validator.Description(context.Background()); got != testCase.expectedDescription {
+				t.Errorf("expected description %q, got: %q", testCase.expectedDescription, got)
+			}
+
+			if got := testCase.validator.MarkdownDescription(context.Background()); got != testCase.expectedMarkdownDescription {
+				t.Errorf("expected markdown description %q, got: %q", testCase.expectedMarkdownDescription, got)
+			}
+		})
+	}
+}
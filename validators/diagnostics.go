@@ -0,0 +1,32 @@
+package validators
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// invalidValueDiagnostic returns an error diagnostic for an attribute value
+// which did not pass validation. The description should describe the
+// expectation, such as "value must be at least 1", and the value should
+// describe what was received.
+func invalidValueDiagnostic(attributePath path.Path, summary string, description string, value string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		summary,
+		fmt.Sprintf("Attribute %s %s, got: %s", attributePath, description, value),
+	)
+}
+
+// valueConversionDiagnostic returns an error diagnostic for an attribute value
+// which could not be converted into the type expected by the validator.
+func valueConversionDiagnostic(attributePath path.Path, err error) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Value Conversion Error",
+		"An unexpected error was encountered trying to convert the attribute value for validation. "+
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"The validator is likely being used with an incompatible attribute type: "+err.Error(),
+	)
+}
@@ -0,0 +1,25 @@
+// Package validators contains common tfsdk.AttributeValidator
+// implementations, such as string length, numeric range, and collection size
+// validation.
+//
+// Each validator skips null and unknown values, as validation of those values
+// is either handled by the schema, such as the Required field, or must wait
+// until the value is known during a later Terraform phase.
+//
+// Validators are added to the Validators field of an Attribute:
+//
+//	"name": {
+//		Type:     types.StringType,
+//		Required: true,
+//		Validators: []tfsdk.AttributeValidator{
+//			validators.StringLengthBetween(1, 64),
+//		},
+//	},
+//
+// Validators for combinations of attributes, such as ConflictsWith, accept
+// path expressions. At the attribute level, relative expressions are resolved
+// from the current attribute, for example path.MatchRelative().AtParent() to
+// refer to a sibling attribute. The same checks are available for entire data
+// source, provider, and resource configurations, such as ResourceConflicting,
+// for use with the ConfigValidators methods.
+package validators
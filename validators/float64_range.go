@@ -0,0 +1,84 @@
+package validators
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.AttributeValidator = float64RangeValidator{}
+
+// Float64AtLeast returns an AttributeValidator which ensures that any configured
+// number value is a float64 greater than or equal to the given minimum. Null and
+// unknown values are skipped.
+func Float64AtLeast(min float64) tfsdk.AttributeValidator {
+	return float64RangeValidator{
+		min: &min,
+	}
+}
+
+// Float64AtMost returns an AttributeValidator which ensures that any configured
+// number value is a float64 less than or equal to the given maximum. Null and
+// unknown values are skipped.
+func Float64AtMost(max float64) tfsdk.AttributeValidator {
+	return float64RangeValidator{
+		max: &max,
+	}
+}
+
+// Float64Between returns an AttributeValidator which ensures that any configured
+// number value is a float64 between the given minimum and maximum, inclusive.
+// Null and unknown values are skipped.
+func Float64Between(min float64, max float64) tfsdk.AttributeValidator {
+	return float64RangeValidator{
+		min: &min,
+		max: &max,
+	}
+}
+
+// float64RangeValidator validates the range of float64 values.
+type float64RangeValidator struct {
+	min *float64
+	max *float64
+}
+
+// Description describes the validation in plain text formatting.
+func (v float64RangeValidator) Description(_ context.Context) string {
+	var min, max string
+
+	if v.min != nil {
+		min = strconv.FormatFloat(*v.min, 'f', -1, 64)
+	}
+
+	if v.max != nil {
+		max = strconv.FormatFloat(*v.max, 'f', -1, 64)
+	}
+
+	return boundsDescription("value", min, max)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v float64RangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v float64RangeValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := float64Value(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if (v.min == nil || value >= *v.min) && (v.max == nil || value <= *v.max) {
+		return
+	}
+
+	resp.Diagnostics.Append(invalidValueDiagnostic(
+		req.AttributePath,
+		"Invalid Attribute Value",
+		v.Description(ctx),
+		strconv.FormatFloat(value, 'f', -1, 64),
+	))
+}
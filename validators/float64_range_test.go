@@ -0,0 +1,102 @@
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators"
+)
+
+func TestFloat64RangeValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator     tfsdk.AttributeValidator
+		value         attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			validator: validators.Float64AtLeast(1.5),
+			value:     types.Float64{Null: true},
+		},
+		"unknown": {
+			validator: validators.Float64AtLeast(1.5),
+			value:     types.Float64{Unknown: true},
+		},
+		"at-least-valid": {
+			validator: validators.Float64AtLeast(1.5),
+			value:     types.Float64{Value: 1.5},
+		},
+		"at-least-invalid": {
+			validator: validators.Float64AtLeast(1.5),
+			value:     types.Float64{Value: 1.4},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must be at least 1.5, got: 1.4",
+				),
+			},
+		},
+		"at-most-valid": {
+			validator: validators.Float64AtMost(1.5),
+			value:     types.Float64{Value: -1},
+		},
+		"at-most-invalid": {
+			validator: validators.Float64AtMost(1.5),
+			value:     types.Float64{Value: 1.6},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must be at most 1.5, got: 1.6",
+				),
+			},
+		},
+		"between-valid": {
+			validator: validators.Float64Between(0.5, 1.5),
+			value:     types.Float64{Value: 1},
+		},
+		"between-invalid": {
+			validator: validators.Float64Between(0.5, 1.5),
+			value:     types.Float64{Value: 0.25},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must be between 0.5 and 1.5, got: 0.25",
+				),
+			},
+		},
+		"int64": {
+			validator: validators.Float64AtLeast(1.5),
+			value:     types.Int64{Value: 2},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := tfsdk.ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: testCase.value,
+			}
+			resp := &tfsdk.ValidateAttributeResponse{}
+
+			testCase.validator.Validate(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
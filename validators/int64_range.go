@@ -0,0 +1,84 @@
+package validators
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.AttributeValidator = int64RangeValidator{}
+
+// Int64AtLeast returns an AttributeValidator which ensures that any configured
+// number value is an int64 greater than or equal to the given minimum. Null and
+// unknown values are skipped.
+func Int64AtLeast(min int64) tfsdk.AttributeValidator {
+	return int64RangeValidator{
+		min: &min,
+	}
+}
+
+// Int64AtMost returns an AttributeValidator which ensures that any configured
+// number value is an int64 less than or equal to the given maximum. Null and
+// unknown values are skipped.
+func Int64AtMost(max int64) tfsdk.AttributeValidator {
+	return int64RangeValidator{
+		max: &max,
+	}
+}
+
+// Int64Between returns an AttributeValidator which ensures that any configured
+// number value is an int64 between the given minimum and maximum, inclusive.
+// Null and unknown values are skipped.
+func Int64Between(min int64, max int64) tfsdk.AttributeValidator {
+	return int64RangeValidator{
+		min: &min,
+		max: &max,
+	}
+}
+
+// int64RangeValidator validates the range of int64 values.
+type int64RangeValidator struct {
+	min *int64
+	max *int64
+}
+
+// Description describes the validation in plain text formatting.
+func (v int64RangeValidator) Description(_ context.Context) string {
+	var min, max string
+
+	if v.min != nil {
+		min = strconv.FormatInt(*v.min, 10)
+	}
+
+	if v.max != nil {
+		max = strconv.FormatInt(*v.max, 10)
+	}
+
+	return boundsDescription("value", min, max)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v int64RangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v int64RangeValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := int64Value(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if (v.min == nil || value >= *v.min) && (v.max == nil || value <= *v.max) {
+		return
+	}
+
+	resp.Diagnostics.Append(invalidValueDiagnostic(
+		req.AttributePath,
+		"Invalid Attribute Value",
+		v.Description(ctx),
+		strconv.FormatInt(value, 10),
+	))
+}
@@ -0,0 +1,112 @@
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators"
+	"math/big"
+)
+
+func TestInt64RangeValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator     tfsdk.AttributeValidator
+		value         attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			validator: validators.Int64AtLeast(1),
+			value:     types.Int64{Null: true},
+		},
+		"unknown": {
+			validator: validators.Int64AtLeast(1),
+			value:     types.Int64{Unknown: true},
+		},
+		"at-least-valid": {
+			validator: validators.Int64AtLeast(1),
+			value:     types.Int64{Value: 1},
+		},
+		"at-least-invalid": {
+			validator: validators.Int64AtLeast(1),
+			value:     types.Int64{Value: 0},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must be at least 1, got: 0",
+				),
+			},
+		},
+		"at-most-valid": {
+			validator: validators.Int64AtMost(1),
+			value:     types.Int64{Value: -1},
+		},
+		"at-most-invalid": {
+			validator: validators.Int64AtMost(1),
+			value:     types.Int64{Value: 2},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must be at most 1, got: 2",
+				),
+			},
+		},
+		"between-valid": {
+			validator: validators.Int64Between(1, 3),
+			value:     types.Int64{Value: 3},
+		},
+		"between-invalid": {
+			validator: validators.Int64Between(1, 3),
+			value:     types.Int64{Value: 4},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must be between 1 and 3, got: 4",
+				),
+			},
+		},
+		"number-not-integer": {
+			validator: validators.Int64AtLeast(1),
+			value:     types.Number{Value: big.NewFloat(1.5)},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the attribute value for validation. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The validator is likely being used with an incompatible attribute type: 1.5 is not an integer",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := tfsdk.ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: testCase.value,
+			}
+			resp := &tfsdk.ValidateAttributeResponse{}
+
+			testCase.validator.Validate(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
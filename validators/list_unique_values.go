@@ -0,0 +1,70 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.AttributeValidator = listUniqueValuesValidator{}
+
+// ListUniqueValues returns an AttributeValidator which ensures that any
+// configured list value does not contain duplicate elements. Null and unknown
+// list values are skipped, as are elements which are not fully known.
+// An error diagnostic is returned for each duplicate element.
+//
+// Set values are unique by definition and do not require this validator.
+func ListUniqueValues() tfsdk.AttributeValidator {
+	return listUniqueValuesValidator{}
+}
+
+// listUniqueValuesValidator validates that list values contain no duplicate
+// elements.
+type listUniqueValuesValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v listUniqueValuesValidator) Description(_ context.Context) string {
+	return "all list elements must be unique"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v listUniqueValuesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v listUniqueValuesValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	elements, ok := elementsValue(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	duplicates := make(map[int]struct{})
+
+	for i, element := range elements {
+		if _, ok := duplicates[i]; ok {
+			continue
+		}
+
+		if !element.IsFullyKnown() {
+			continue
+		}
+
+		for j := i + 1; j < len(elements); j++ {
+			if !elements[j].Equal(element) {
+				continue
+			}
+
+			duplicates[j] = struct{}{}
+
+			resp.Diagnostics.Append(invalidValueDiagnostic(
+				req.AttributePath,
+				"Duplicate List Element",
+				v.Description(ctx),
+				fmt.Sprintf("element at index %d is a duplicate of element at index %d", j, i),
+			))
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators"
+)
+
+func TestListUniqueValuesValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator     tfsdk.AttributeValidator
+		value         attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			validator: validators.ListUniqueValues(),
+			value:     types.List{ElemType: types.StringType, Null: true},
+		},
+		"unknown": {
+			validator: validators.ListUniqueValues(),
+			value:     types.List{ElemType: types.StringType, Unknown: true},
+		},
+		"unique": {
+			validator: validators.ListUniqueValues(),
+			value:     types.List{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "a"}, types.String{Value: "b"}}},
+		},
+		"unknown-elements": {
+			validator: validators.ListUniqueValues(),
+			value:     types.List{ElemType: types.StringType, Elems: []attr.Value{types.String{Unknown: true}, types.String{Unknown: true}}},
+		},
+		"duplicate": {
+			validator: validators.ListUniqueValues(),
+			value:     types.List{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "a"}, types.String{Value: "b"}, types.String{Value: "a"}}},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Duplicate List Element",
+					"Attribute test all list elements must be unique, got: element at index 2 is a duplicate of element at index 0",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := tfsdk.ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: testCase.value,
+			}
+			resp := &tfsdk.ValidateAttributeResponse{}
+
+			testCase.validator.Validate(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
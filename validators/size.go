@@ -0,0 +1,170 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.AttributeValidator = sizeValidator{}
+
+// ListSizeAtLeast returns an AttributeValidator which ensures that any
+// configured list value contains at least the given minimum number of
+// elements. Null and unknown values are skipped.
+func ListSizeAtLeast(min int) tfsdk.AttributeValidator {
+	return sizeValidator{
+		collection: "list",
+		min:        &min,
+	}
+}
+
+// ListSizeAtMost returns an AttributeValidator which ensures that any
+// configured list value contains at most the given maximum number of
+// elements. Null and unknown values are skipped.
+func ListSizeAtMost(max int) tfsdk.AttributeValidator {
+	return sizeValidator{
+		collection: "list",
+		max:        &max,
+	}
+}
+
+// ListSizeBetween returns an AttributeValidator which ensures that any
+// configured list value contains a number of elements between the given
+// minimum and maximum, inclusive. Null and unknown values are skipped.
+func ListSizeBetween(min int, max int) tfsdk.AttributeValidator {
+	return sizeValidator{
+		collection: "list",
+		min:        &min,
+		max:        &max,
+	}
+}
+
+// SetSizeAtLeast returns an AttributeValidator which ensures that any
+// configured set value contains at least the given minimum number of
+// elements. Null and unknown values are skipped.
+func SetSizeAtLeast(min int) tfsdk.AttributeValidator {
+	return sizeValidator{
+		collection: "set",
+		min:        &min,
+	}
+}
+
+// SetSizeAtMost returns an AttributeValidator which ensures that any
+// configured set value contains at most the given maximum number of
+// elements. Null and unknown values are skipped.
+func SetSizeAtMost(max int) tfsdk.AttributeValidator {
+	return sizeValidator{
+		collection: "set",
+		max:        &max,
+	}
+}
+
+// SetSizeBetween returns an AttributeValidator which ensures that any
+// configured set value contains a number of elements between the given
+// minimum and maximum, inclusive. Null and unknown values are skipped.
+func SetSizeBetween(min int, max int) tfsdk.AttributeValidator {
+	return sizeValidator{
+		collection: "set",
+		min:        &min,
+		max:        &max,
+	}
+}
+
+// MapSizeAtLeast returns an AttributeValidator which ensures that any
+// configured map value contains at least the given minimum number of
+// entries. Null and unknown values are skipped.
+func MapSizeAtLeast(min int) tfsdk.AttributeValidator {
+	return sizeValidator{
+		collection: "map",
+		min:        &min,
+	}
+}
+
+// MapSizeAtMost returns an AttributeValidator which ensures that any
+// configured map value contains at most the given maximum number of
+// entries. Null and unknown values are skipped.
+func MapSizeAtMost(max int) tfsdk.AttributeValidator {
+	return sizeValidator{
+		collection: "map",
+		max:        &max,
+	}
+}
+
+// MapSizeBetween returns an AttributeValidator which ensures that any
+// configured map value contains a number of entries between the given
+// minimum and maximum, inclusive. Null and unknown values are skipped.
+func MapSizeBetween(min int, max int) tfsdk.AttributeValidator {
+	return sizeValidator{
+		collection: "map",
+		min:        &min,
+		max:        &max,
+	}
+}
+
+// sizeValidator validates the number of elements in list, set, and map
+// values.
+type sizeValidator struct {
+	// collection is the kind of collection being validated, such as "list",
+	// for descriptions and determining how to count elements.
+	collection string
+
+	min *int
+	max *int
+}
+
+// Description describes the validation in plain text formatting.
+func (v sizeValidator) Description(_ context.Context) string {
+	var min, max string
+
+	if v.min != nil {
+		min = strconv.Itoa(*v.min)
+	}
+
+	if v.max != nil {
+		max = strconv.Itoa(*v.max)
+	}
+
+	return boundsDescription(v.collection+" size", min, max)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sizeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v sizeValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var size int
+
+	switch v.collection {
+	case "map":
+		elements, ok := mapValue(ctx, req, resp)
+
+		if !ok {
+			return
+		}
+
+		size = len(elements)
+	default:
+		elements, ok := elementsValue(ctx, req, resp)
+
+		if !ok {
+			return
+		}
+
+		size = len(elements)
+	}
+
+	if (v.min == nil || size >= *v.min) && (v.max == nil || size <= *v.max) {
+		return
+	}
+
+	resp.Diagnostics.Append(invalidValueDiagnostic(
+		req.AttributePath,
+		"Invalid Attribute Value",
+		v.Description(ctx),
+		fmt.Sprintf("%d", size),
+	))
+}
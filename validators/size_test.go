@@ -0,0 +1,136 @@
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators"
+)
+
+func TestSizeValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator     tfsdk.AttributeValidator
+		value         attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"list-null": {
+			validator: validators.ListSizeAtLeast(1),
+			value:     types.List{ElemType: types.StringType, Null: true},
+		},
+		"list-unknown": {
+			validator: validators.ListSizeAtLeast(1),
+			value:     types.List{ElemType: types.StringType, Unknown: true},
+		},
+		"list-at-least-valid": {
+			validator: validators.ListSizeAtLeast(1),
+			value:     types.List{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "a"}}},
+		},
+		"list-at-least-invalid": {
+			validator: validators.ListSizeAtLeast(2),
+			value:     types.List{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "a"}}},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test list size must be at least 2, got: 1",
+				),
+			},
+		},
+		"list-at-most-invalid": {
+			validator: validators.ListSizeAtMost(1),
+			value:     types.List{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "a"}, types.String{Value: "b"}}},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test list size must be at most 1, got: 2",
+				),
+			},
+		},
+		"list-between-valid": {
+			validator: validators.ListSizeBetween(1, 2),
+			value:     types.List{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "a"}, types.String{Value: "b"}}},
+		},
+		"set-null": {
+			validator: validators.SetSizeAtLeast(1),
+			value:     types.Set{ElemType: types.StringType, Null: true},
+		},
+		"set-at-least-valid": {
+			validator: validators.SetSizeAtLeast(1),
+			value:     types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "a"}}},
+		},
+		"set-at-most-valid": {
+			validator: validators.SetSizeAtMost(1),
+			value:     types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "a"}}},
+		},
+		"set-between-invalid": {
+			validator: validators.SetSizeBetween(2, 3),
+			value:     types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "a"}}},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test set size must be between 2 and 3, got: 1",
+				),
+			},
+		},
+		"map-unknown": {
+			validator: validators.MapSizeAtLeast(1),
+			value:     types.Map{ElemType: types.StringType, Unknown: true},
+		},
+		"map-at-least-invalid": {
+			validator: validators.MapSizeAtLeast(1),
+			value:     types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{}},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test map size must be at least 1, got: 0",
+				),
+			},
+		},
+		"map-at-most-valid": {
+			validator: validators.MapSizeAtMost(2),
+			value:     types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{"a": types.String{Value: "a"}, "b": types.String{Value: "b"}}},
+		},
+		"map-between-invalid": {
+			validator: validators.MapSizeBetween(1, 2),
+			value:     types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{"a": types.String{Value: "a"}, "b": types.String{Value: "b"}, "c": types.String{Value: "c"}}},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test map size must be between 1 and 2, got: 3",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := tfsdk.ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: testCase.value,
+			}
+			resp := &tfsdk.ValidateAttributeResponse{}
+
+			testCase.validator.Validate(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
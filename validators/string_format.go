@@ -0,0 +1,149 @@
+package validators
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.AttributeValidator = stringFormatValidator{}
+
+// StringIsJSON returns an AttributeValidator which ensures that any
+// configured string value is valid JSON. Null and unknown values are skipped.
+func StringIsJSON() tfsdk.AttributeValidator {
+	return stringFormatValidator{
+		description: "value must be valid JSON",
+		check: func(value string) error {
+			var v interface{}
+
+			return json.Unmarshal([]byte(value), &v)
+		},
+	}
+}
+
+// StringIsRFC3339 returns an AttributeValidator which ensures that any
+// configured string value is a valid RFC 3339 timestamp, such as
+// "2006-01-02T15:04:05Z". Null and unknown values are skipped.
+func StringIsRFC3339() tfsdk.AttributeValidator {
+	return stringFormatValidator{
+		description: "value must be a valid RFC 3339 timestamp",
+		check: func(value string) error {
+			_, err := time.Parse(time.RFC3339, value)
+
+			return err
+		},
+	}
+}
+
+// StringIsCIDR returns an AttributeValidator which ensures that any
+// configured string value is a valid IPv4 or IPv6 CIDR notation network, such
+// as "192.0.2.0/24". Null and unknown values are skipped.
+func StringIsCIDR() tfsdk.AttributeValidator {
+	return stringFormatValidator{
+		description: "value must be a valid CIDR network",
+		check: func(value string) error {
+			_, _, err := net.ParseCIDR(value)
+
+			return err
+		},
+	}
+}
+
+// StringIsURL returns an AttributeValidator which ensures that any configured
+// string value is an absolute URL, including a scheme and host, such as
+// "https://example.com/path". If schemes are given, the URL scheme must be
+// one of them. Null and unknown values are skipped.
+func StringIsURL(schemes ...string) tfsdk.AttributeValidator {
+	description := "value must be a valid URL"
+	markdownDescription := description
+
+	if len(schemes) > 0 {
+		description += " with scheme: " + quotedList(schemes, false)
+		markdownDescription += " with scheme: " + quotedList(schemes, true)
+	}
+
+	return stringFormatValidator{
+		description:         description,
+		markdownDescription: markdownDescription,
+		check: func(value string) error {
+			u, err := url.Parse(value)
+
+			if err != nil {
+				return err
+			}
+
+			if u.Scheme == "" {
+				return errors.New("missing scheme")
+			}
+
+			if u.Host == "" {
+				return errors.New("missing host")
+			}
+
+			if len(schemes) == 0 {
+				return nil
+			}
+
+			for _, scheme := range schemes {
+				if u.Scheme == scheme {
+					return nil
+				}
+			}
+
+			return fmt.Errorf("unexpected scheme %q", u.Scheme)
+		},
+	}
+}
+
+// stringFormatValidator validates that string values are in a specific
+// format, such as JSON.
+type stringFormatValidator struct {
+	// check returns an error describing why the value is not in the
+	// expected format.
+	check func(string) error
+
+	description         string
+	markdownDescription string
+}
+
+// Description describes the validation in plain text formatting.
+func (v stringFormatValidator) Description(_ context.Context) string {
+	return v.description
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v stringFormatValidator) MarkdownDescription(_ context.Context) string {
+	if v.markdownDescription != "" {
+		return v.markdownDescription
+	}
+
+	return v.description
+}
+
+// Validate performs the validation.
+func (v stringFormatValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := stringValue(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	err := v.check(value)
+
+	if err == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(invalidValueDiagnostic(
+		req.AttributePath,
+		"Invalid Attribute Value Format",
+		v.Description(ctx),
+		fmt.Sprintf("%q (%s)", value, err),
+	))
+}
@@ -0,0 +1,143 @@
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators"
+)
+
+func TestStringFormatValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator     tfsdk.AttributeValidator
+		value         attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"json-null": {
+			validator: validators.StringIsJSON(),
+			value:     types.String{Null: true},
+		},
+		"json-unknown": {
+			validator: validators.StringIsJSON(),
+			value:     types.String{Unknown: true},
+		},
+		"json-valid": {
+			validator: validators.StringIsJSON(),
+			value:     types.String{Value: `{"key": "value"}`},
+		},
+		"json-invalid": {
+			validator: validators.StringIsJSON(),
+			value:     types.String{Value: "{"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Format",
+					`Attribute test value must be valid JSON, got: "{" (unexpected end of JSON input)`,
+				),
+			},
+		},
+		"rfc3339-valid": {
+			validator: validators.StringIsRFC3339(),
+			value:     types.String{Value: "2006-01-02T15:04:05Z"},
+		},
+		"rfc3339-invalid": {
+			validator: validators.StringIsRFC3339(),
+			value:     types.String{Value: "2006-01-02"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Format",
+					`Attribute test value must be a valid RFC 3339 timestamp, got: "2006-01-02" (parsing time "2006-01-02" as "2006-01-02T15:04:05Z07:00": cannot parse "" as "T")`,
+				),
+			},
+		},
+		"cidr-valid-ipv4": {
+			validator: validators.StringIsCIDR(),
+			value:     types.String{Value: "192.0.2.0/24"},
+		},
+		"cidr-valid-ipv6": {
+			validator: validators.StringIsCIDR(),
+			value:     types.String{Value: "2001:db8::/32"},
+		},
+		"cidr-invalid": {
+			validator: validators.StringIsCIDR(),
+			value:     types.String{Value: "192.0.2.0"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Format",
+					`Attribute test value must be a valid CIDR network, got: "192.0.2.0" (invalid CIDR address: 192.0.2.0)`,
+				),
+			},
+		},
+		"url-valid": {
+			validator: validators.StringIsURL(),
+			value:     types.String{Value: "https://example.com/path"},
+		},
+		"url-invalid-missing-scheme": {
+			validator: validators.StringIsURL(),
+			value:     types.String{Value: "example.com"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Format",
+					`Attribute test value must be a valid URL, got: "example.com" (missing scheme)`,
+				),
+			},
+		},
+		"url-invalid-missing-host": {
+			validator: validators.StringIsURL(),
+			value:     types.String{Value: "file:///tmp"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Format",
+					`Attribute test value must be a valid URL, got: "file:///tmp" (missing host)`,
+				),
+			},
+		},
+		"url-schemes-valid": {
+			validator: validators.StringIsURL("http", "https"),
+			value:     types.String{Value: "http://example.com"},
+		},
+		"url-schemes-invalid": {
+			validator: validators.StringIsURL("http", "https"),
+			value:     types.String{Value: "ftp://example.com"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Format",
+					`Attribute test value must be a valid URL with scheme: "http", "https", got: "ftp://example.com" (unexpected scheme "ftp")`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := tfsdk.ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: testCase.value,
+			}
+			resp := &tfsdk.ValidateAttributeResponse{}
+
+			testCase.validator.Validate(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
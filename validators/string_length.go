@@ -0,0 +1,88 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.AttributeValidator = stringLengthValidator{}
+
+// StringLengthAtLeast returns an AttributeValidator which ensures that any
+// configured string value has a length, in UTF-8 characters, greater than or
+// equal to the given minimum. Null and unknown values are skipped.
+func StringLengthAtLeast(min int) tfsdk.AttributeValidator {
+	return stringLengthValidator{
+		min: &min,
+	}
+}
+
+// StringLengthAtMost returns an AttributeValidator which ensures that any
+// configured string value has a length, in UTF-8 characters, less than or
+// equal to the given maximum. Null and unknown values are skipped.
+func StringLengthAtMost(max int) tfsdk.AttributeValidator {
+	return stringLengthValidator{
+		max: &max,
+	}
+}
+
+// StringLengthBetween returns an AttributeValidator which ensures that any
+// configured string value has a length, in UTF-8 characters, between the
+// given minimum and maximum, inclusive. Null and unknown values are skipped.
+func StringLengthBetween(min int, max int) tfsdk.AttributeValidator {
+	return stringLengthValidator{
+		min: &min,
+		max: &max,
+	}
+}
+
+// stringLengthValidator validates the length of string values.
+type stringLengthValidator struct {
+	min *int
+	max *int
+}
+
+// Description describes the validation in plain text formatting.
+func (v stringLengthValidator) Description(_ context.Context) string {
+	var min, max string
+
+	if v.min != nil {
+		min = strconv.Itoa(*v.min)
+	}
+
+	if v.max != nil {
+		max = strconv.Itoa(*v.max)
+	}
+
+	return boundsDescription("string length", min, max)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v stringLengthValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v stringLengthValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := stringValue(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	length := utf8.RuneCountInString(value)
+
+	if (v.min == nil || length >= *v.min) && (v.max == nil || length <= *v.max) {
+		return
+	}
+
+	resp.Diagnostics.Append(invalidValueDiagnostic(
+		req.AttributePath,
+		"Invalid Attribute Value Length",
+		v.Description(ctx),
+		fmt.Sprintf("%d", length),
+	))
+}
@@ -0,0 +1,115 @@
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators"
+)
+
+func TestStringLengthValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator     tfsdk.AttributeValidator
+		value         attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			validator: validators.StringLengthAtLeast(1),
+			value:     types.String{Null: true},
+		},
+		"unknown": {
+			validator: validators.StringLengthAtLeast(1),
+			value:     types.String{Unknown: true},
+		},
+		"at-least-valid": {
+			validator: validators.StringLengthAtLeast(1),
+			value:     types.String{Value: "a"},
+		},
+		"at-least-invalid": {
+			validator: validators.StringLengthAtLeast(2),
+			value:     types.String{Value: "a"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Length",
+					"Attribute test string length must be at least 2, got: 1",
+				),
+			},
+		},
+		"at-most-valid": {
+			validator: validators.StringLengthAtMost(2),
+			value:     types.String{Value: "ab"},
+		},
+		"at-most-invalid": {
+			validator: validators.StringLengthAtMost(2),
+			value:     types.String{Value: "abc"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Length",
+					"Attribute test string length must be at most 2, got: 3",
+				),
+			},
+		},
+		"between-valid": {
+			validator: validators.StringLengthBetween(1, 3),
+			value:     types.String{Value: "ab"},
+		},
+		"between-valid-multibyte": {
+			validator: validators.StringLengthBetween(1, 3),
+			value:     types.String{Value: "ééé"},
+		},
+		"between-invalid": {
+			validator: validators.StringLengthBetween(1, 3),
+			value:     types.String{Value: ""},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Length",
+					"Attribute test string length must be between 1 and 3, got: 0",
+				),
+			},
+		},
+		"wrong-type": {
+			validator: validators.StringLengthAtLeast(1),
+			value:     types.Int64{Value: 1},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the attribute value for validation. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The validator is likely being used with an incompatible attribute type: can't unmarshal tftypes.Number into *string, expected string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := tfsdk.ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: testCase.value,
+			}
+			resp := &tfsdk.ValidateAttributeResponse{}
+
+			testCase.validator.Validate(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
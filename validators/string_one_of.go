@@ -0,0 +1,81 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.AttributeValidator = stringOneOfValidator{}
+
+// StringOneOf returns an AttributeValidator which ensures that any configured
+// string value is exactly equal to one of the given values. Null and unknown
+// values are skipped.
+func StringOneOf(values ...string) tfsdk.AttributeValidator {
+	return stringOneOfValidator{
+		values: values,
+	}
+}
+
+// StringOneOfCaseInsensitive returns an AttributeValidator which ensures that
+// any configured string value is equal to one of the given values, ignoring
+// differences in case. Null and unknown values are skipped.
+func StringOneOfCaseInsensitive(values ...string) tfsdk.AttributeValidator {
+	return stringOneOfValidator{
+		caseInsensitive: true,
+		values:          values,
+	}
+}
+
+// stringOneOfValidator validates that string values are one of a set of
+// acceptable values.
+type stringOneOfValidator struct {
+	caseInsensitive bool
+	values          []string
+}
+
+// Description describes the validation in plain text formatting.
+func (v stringOneOfValidator) Description(_ context.Context) string {
+	return v.description(false)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v stringOneOfValidator) MarkdownDescription(_ context.Context) string {
+	return v.description(true)
+}
+
+// Validate performs the validation.
+func (v stringOneOfValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := stringValue(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	for _, acceptableValue := range v.values {
+		if value == acceptableValue {
+			return
+		}
+
+		if v.caseInsensitive && strings.EqualFold(value, acceptableValue) {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(invalidValueDiagnostic(
+		req.AttributePath,
+		"Invalid Attribute Value Match",
+		v.Description(ctx),
+		fmt.Sprintf("%q", value),
+	))
+}
+
+func (v stringOneOfValidator) description(markdown bool) string {
+	if v.caseInsensitive {
+		return "value must be one of (case insensitive): " + quotedList(v.values, markdown)
+	}
+
+	return "value must be one of: " + quotedList(v.values, markdown)
+}
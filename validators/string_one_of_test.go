@@ -0,0 +1,83 @@
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators"
+)
+
+func TestStringOneOfValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator     tfsdk.AttributeValidator
+		value         attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			validator: validators.StringOneOf("one", "two"),
+			value:     types.String{Null: true},
+		},
+		"unknown": {
+			validator: validators.StringOneOf("one", "two"),
+			value:     types.String{Unknown: true},
+		},
+		"valid": {
+			validator: validators.StringOneOf("one", "two"),
+			value:     types.String{Value: "two"},
+		},
+		"invalid": {
+			validator: validators.StringOneOf("one", "two"),
+			value:     types.String{Value: "TWO"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Match",
+					`Attribute test value must be one of: "one", "two", got: "TWO"`,
+				),
+			},
+		},
+		"case-insensitive-valid": {
+			validator: validators.StringOneOfCaseInsensitive("one", "two"),
+			value:     types.String{Value: "TWO"},
+		},
+		"case-insensitive-invalid": {
+			validator: validators.StringOneOfCaseInsensitive("one", "two"),
+			value:     types.String{Value: "three"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Match",
+					`Attribute test value must be one of (case insensitive): "one", "two", got: "three"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := tfsdk.ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: testCase.value,
+			}
+			resp := &tfsdk.ValidateAttributeResponse{}
+
+			testCase.validator.Validate(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.AttributeValidator = stringRegexMatchesValidator{}
+
+// StringRegexMatches returns an AttributeValidator which ensures that any
+// configured string value matches the given regular expression. Null and
+// unknown values are skipped.
+//
+// The optional message replaces the default validator description, which
+// includes the regular expression, in the validator descriptions and
+// diagnostics. This is useful for expressions which are difficult to read.
+func StringRegexMatches(regex *regexp.Regexp, message string) tfsdk.AttributeValidator {
+	return stringRegexMatchesValidator{
+		message: message,
+		regex:   regex,
+	}
+}
+
+// stringRegexMatchesValidator validates that string values match a regular
+// expression.
+type stringRegexMatchesValidator struct {
+	message string
+	regex   *regexp.Regexp
+}
+
+// Description describes the validation in plain text formatting.
+func (v stringRegexMatchesValidator) Description(_ context.Context) string {
+	if v.message != "" {
+		return v.message
+	}
+
+	return fmt.Sprintf("value must match regular expression '%s'", v.regex)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v stringRegexMatchesValidator) MarkdownDescription(_ context.Context) string {
+	if v.message != "" {
+		return v.message
+	}
+
+	return fmt.Sprintf("value must match regular expression `%s`", v.regex)
+}
+
+// Validate performs the validation.
+func (v stringRegexMatchesValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := stringValue(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if v.regex.MatchString(value) {
+		return
+	}
+
+	resp.Diagnostics.Append(invalidValueDiagnostic(
+		req.AttributePath,
+		"Invalid Attribute Value Match",
+		v.Description(ctx),
+		fmt.Sprintf("%q", value),
+	))
+}
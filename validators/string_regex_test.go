@@ -0,0 +1,80 @@
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators"
+	"regexp"
+)
+
+func TestStringRegexMatchesValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator     tfsdk.AttributeValidator
+		value         attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			validator: validators.StringRegexMatches(regexp.MustCompile(`^[a-z]+$`), ""),
+			value:     types.String{Null: true},
+		},
+		"unknown": {
+			validator: validators.StringRegexMatches(regexp.MustCompile(`^[a-z]+$`), ""),
+			value:     types.String{Unknown: true},
+		},
+		"valid": {
+			validator: validators.StringRegexMatches(regexp.MustCompile(`^[a-z]+$`), ""),
+			value:     types.String{Value: "abc"},
+		},
+		"invalid": {
+			validator: validators.StringRegexMatches(regexp.MustCompile(`^[a-z]+$`), ""),
+			value:     types.String{Value: "ABC"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Match",
+					`Attribute test value must match regular expression '^[a-z]+$', got: "ABC"`,
+				),
+			},
+		},
+		"invalid-message": {
+			validator: validators.StringRegexMatches(regexp.MustCompile(`^[a-z]+$`), "value must contain only lowercase letters"),
+			value:     types.String{Value: "ABC"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value Match",
+					`Attribute test value must contain only lowercase letters, got: "ABC"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := tfsdk.ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: testCase.value,
+			}
+			resp := &tfsdk.ValidateAttributeResponse{}
+
+			testCase.validator.Validate(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// terraformValue returns the attribute configuration as a tftypes.Value.
+// Converting into the terraform-plugin-go type, rather than a types package
+// type, ensures validators are compatible with custom types.
+//
+// The returned boolean is false if the value is null or unknown, or if an
+// error diagnostic was added to the response, in which case validation
+// should be skipped.
+func terraformValue(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) (tftypes.Value, bool) {
+	if req.AttributeConfig == nil {
+		return tftypes.Value{}, false
+	}
+
+	tfValue, err := req.AttributeConfig.ToTerraformValue(ctx)
+
+	if err != nil {
+		resp.Diagnostics.Append(valueConversionDiagnostic(req.AttributePath, err))
+
+		return tftypes.Value{}, false
+	}
+
+	if tfValue.IsNull() || !tfValue.IsKnown() {
+		return tftypes.Value{}, false
+	}
+
+	return tfValue, true
+}
+
+// stringValue returns the attribute configuration as a string. The returned
+// boolean is false if validation should be skipped.
+func stringValue(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) (string, bool) {
+	tfValue, ok := terraformValue(ctx, req, resp)
+
+	if !ok {
+		return "", false
+	}
+
+	var value string
+
+	if err := tfValue.As(&value); err != nil {
+		resp.Diagnostics.Append(valueConversionDiagnostic(req.AttributePath, err))
+
+		return "", false
+	}
+
+	return value, true
+}
+
+// numberValue returns the attribute configuration as a *big.Float. The
+// returned boolean is false if validation should be skipped.
+func numberValue(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) (*big.Float, bool) {
+	tfValue, ok := terraformValue(ctx, req, resp)
+
+	if !ok {
+		return nil, false
+	}
+
+	value := big.NewFloat(0)
+
+	if err := tfValue.As(&value); err != nil {
+		resp.Diagnostics.Append(valueConversionDiagnostic(req.AttributePath, err))
+
+		return nil, false
+	}
+
+	return value, true
+}
+
+// int64Value returns the attribute configuration as an int64. The returned
+// boolean is false if validation should be skipped.
+func int64Value(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) (int64, bool) {
+	number, ok := numberValue(ctx, req, resp)
+
+	if !ok {
+		return 0, false
+	}
+
+	if !number.IsInt() {
+		resp.Diagnostics.Append(valueConversionDiagnostic(req.AttributePath, fmt.Errorf("%s is not an integer", number.String())))
+
+		return 0, false
+	}
+
+	value, accuracy := number.Int64()
+
+	if accuracy != big.Exact {
+		resp.Diagnostics.Append(valueConversionDiagnostic(req.AttributePath, fmt.Errorf("%s cannot be represented as a 64-bit integer", number.String())))
+
+		return 0, false
+	}
+
+	return value, true
+}
+
+// float64Value returns the attribute configuration as a float64. The
+// returned boolean is false if validation should be skipped.
+func float64Value(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) (float64, bool) {
+	number, ok := numberValue(ctx, req, resp)
+
+	if !ok {
+		return 0, false
+	}
+
+	value, _ := number.Float64()
+
+	return value, true
+}
+
+// elementsValue returns the elements of a list, set, or tuple attribute
+// configuration. The returned boolean is false if validation should be
+// skipped.
+func elementsValue(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) ([]tftypes.Value, bool) {
+	tfValue, ok := terraformValue(ctx, req, resp)
+
+	if !ok {
+		return nil, false
+	}
+
+	var elements []tftypes.Value
+
+	if err := tfValue.As(&elements); err != nil {
+		resp.Diagnostics.Append(valueConversionDiagnostic(req.AttributePath, err))
+
+		return nil, false
+	}
+
+	return elements, true
+}
+
+// mapValue returns the elements of a map or object attribute configuration.
+// The returned boolean is false if validation should be skipped.
+func mapValue(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) (map[string]tftypes.Value, bool) {
+	tfValue, ok := terraformValue(ctx, req, resp)
+
+	if !ok {
+		return nil, false
+	}
+
+	var elements map[string]tftypes.Value
+
+	if err := tfValue.As(&elements); err != nil {
+		resp.Diagnostics.Append(valueConversionDiagnostic(req.AttributePath, err))
+
+		return nil, false
+	}
+
+	return elements, true
+}